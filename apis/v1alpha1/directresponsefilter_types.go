@@ -0,0 +1,133 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=drfilter
+// +kubebuilder:printcolumn:name="Status",type=integer,JSONPath=`.spec.statusCode`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DirectResponseFilter is a filter that responds to requests directly with a fixed status code and body,
+// without proxying them to a backend. It can be referenced by HTTPRoute rules that don't have any backendRefs.
+type DirectResponseFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the DirectResponseFilter.
+	Spec DirectResponseFilterSpec `json:"spec"`
+
+	// Status defines the state of the DirectResponseFilter.
+	Status DirectResponseFilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectResponseFilterList contains a list of DirectResponseFilters.
+type DirectResponseFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectResponseFilter `json:"items"`
+}
+
+// DirectResponseFilterSpec defines the desired state of the DirectResponseFilter.
+type DirectResponseFilterSpec struct {
+	// ContentType is the value of the Content-Type header of the response.
+	// Default: text/plain.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+-]+(;\s*[a-zA-Z0-9_-]+=[a-zA-Z0-9_.-]+)*$`
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Body is the body of the response. If not set, the response has an empty body.
+	//
+	// +optional
+	Body *DirectResponseBody `json:"body,omitempty"`
+
+	// StatusCode is the status code of the response.
+	// Redirect status codes (301, 302, 303, 307 and 308) are not supported. Use the RequestRedirect filter instead.
+	//
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	// +kubebuilder:validation:XValidation:message="redirect status codes are not supported",rule="!(self in [301, 302, 303, 307, 308])"
+	//nolint:lll
+	StatusCode int32 `json:"statusCode"`
+}
+
+// DirectResponseBody is the body of a direct response.
+// Exactly one of Inline or ConfigMap must be set.
+// The body must not contain '$'.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of inline or configMap must be set",rule="[has(self.inline), has(self.configMap)].filter(x, x).size() == 1"
+//
+//nolint:lll
+type DirectResponseBody struct {
+	// Inline is the body of the response.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMap references a key of a ConfigMap that holds the body of the response.
+	// The ConfigMap must be in the same namespace as the DirectResponseFilter.
+	//
+	// +optional
+	ConfigMap *DirectResponseConfigMapRef `json:"configMap,omitempty"`
+}
+
+// DirectResponseConfigMapRef references a key of a ConfigMap.
+type DirectResponseConfigMapRef struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the key in the data or binaryData of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+}
+
+// DirectResponseFilterStatus defines the state of DirectResponseFilter.
+type DirectResponseFilterStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the DirectResponseFilter
+	// and the status of the DirectResponseFilter with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// DirectResponseFilterConditionType is a type of condition associated with DirectResponseFilter.
+type DirectResponseFilterConditionType string
+
+// DirectResponseFilterConditionReason is a reason for a DirectResponseFilter condition type.
+type DirectResponseFilterConditionReason string
+
+const (
+	// DirectResponseFilterConditionTypeAccepted indicates that the DirectResponseFilter is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid.
+	DirectResponseFilterConditionTypeAccepted DirectResponseFilterConditionType = "Accepted"
+
+	// DirectResponseFilterConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	DirectResponseFilterConditionReasonAccepted DirectResponseFilterConditionReason = "Accepted"
+
+	// DirectResponseFilterConditionReasonInvalid is used with the Accepted condition type when
+	// DirectResponseFilter is invalid.
+	DirectResponseFilterConditionReasonInvalid DirectResponseFilterConditionReason = "Invalid"
+)
@@ -0,0 +1,178 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=eppolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ErrorPagePolicy is an Inherited Attached Policy. It provides a way to replace the error responses that
+// NGINX returns to clients with custom error pages.
+type ErrorPagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ErrorPagePolicy.
+	Spec ErrorPagePolicySpec `json:"spec"`
+
+	// Status defines the state of the ErrorPagePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ErrorPagePolicyList contains a list of ErrorPagePolicies.
+type ErrorPagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ErrorPagePolicy `json:"items"`
+}
+
+// ErrorPagePolicySpec defines the desired state of the ErrorPagePolicy.
+type ErrorPagePolicySpec struct {
+	// InterceptUpstreamErrors enables replacing the error responses returned by the backends with the
+	// configured error pages. If disabled, only the errors generated by NGINX itself are replaced.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_intercept_errors.
+	//
+	// +optional
+	InterceptUpstreamErrors *bool `json:"interceptUpstreamErrors,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute.
+	//
+	// If a Gateway and an HTTPRoute attached to it are both targeted, the error pages of the HTTPRoute
+	// take precedence over the error pages of the Gateway for the status codes they both configure.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+
+	// ErrorPages is a list of error pages. A status code can only be configured by a single error page.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	ErrorPages []ErrorPage `json:"errorPages"`
+}
+
+// ErrorPage defines the response that is returned to the client instead of an error response
+// with one of the configured status codes.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of static, redirect or service must be specified",rule="[has(self.static), has(self.redirect), has(self.service)].filter(x, x).size() == 1"
+// +kubebuilder:validation:XValidation:message="responseCode cannot be specified with redirect",rule="!(has(self.responseCode) && has(self.redirect))"
+//
+//nolint:lll
+type ErrorPage struct {
+	// ResponseCode is the status code returned to the client instead of the original status code.
+	// If not specified, the original status code is returned.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	ResponseCode *int32 `json:"responseCode,omitempty"`
+
+	// Static returns a static response body stored in a ConfigMap.
+	//
+	// +optional
+	Static *ErrorPageStatic `json:"static,omitempty"`
+
+	// Redirect redirects the client to another URL.
+	//
+	// +optional
+	Redirect *ErrorPageRedirect `json:"redirect,omitempty"`
+
+	// Service returns the response of a Service.
+	//
+	// +optional
+	Service *ErrorPageService `json:"service,omitempty"`
+
+	// Codes are the status codes of the error responses that are replaced by this error page.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	Codes []ErrorPageStatusCode `json:"codes"`
+}
+
+// ErrorPageStatusCode is an HTTP status code that can be replaced by an error page.
+//
+// +kubebuilder:validation:Minimum=300
+// +kubebuilder:validation:Maximum=599
+type ErrorPageStatusCode int32
+
+// ErrorPageStatic defines a static error page response body stored in a ConfigMap.
+type ErrorPageStatic struct {
+	// ContentType is the value of the Content-Type header of the response.
+	// Default: text/html.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+-]+(;\s*[a-zA-Z0-9_-]+=[a-zA-Z0-9_.-]+)*$`
+	ContentType *string `json:"contentType,omitempty"`
+
+	// ConfigMapName is the name of the ConfigMap that holds the response body.
+	// The ConfigMap must be in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ConfigMapName string `json:"configMapName"`
+
+	// Key is the key in the data of the ConfigMap that holds the response body.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+}
+
+// ErrorPageRedirect defines a redirect to another URL.
+type ErrorPageRedirect struct {
+	// StatusCode is the status code of the redirect response.
+	// Default: 302.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// URL is the URL to redirect the client to. It must be an absolute http or https URL, since NGINX
+	// redirects to a path internally, without sending a Location header to the client.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://[^\s{};"'$\\]+$`
+	URL string `json:"url"`
+}
+
+// ErrorPageService defines a Service that serves the error page.
+type ErrorPageService struct {
+	// Path is the path of the request that is sent to the Service.
+	// Default: /.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[^\s{};"'$\\]*$`
+	Path *string `json:"path,omitempty"`
+
+	// Name is the name of the Service.
+	// The Service must be in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Port is the port of the Service.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
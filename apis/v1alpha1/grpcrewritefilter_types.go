@@ -0,0 +1,98 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=grpcrwfilter
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GRPCRewriteFilter is a filter that rewrites the authority, and the service and method names of gRPC requests
+// before they are proxied to a backend. It can be referenced by GRPCRoute rules.
+type GRPCRewriteFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the GRPCRewriteFilter.
+	Spec GRPCRewriteFilterSpec `json:"spec"`
+
+	// Status defines the state of the GRPCRewriteFilter.
+	Status GRPCRewriteFilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCRewriteFilterList contains a list of GRPCRewriteFilters.
+type GRPCRewriteFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCRewriteFilter `json:"items"`
+}
+
+// GRPCRewriteFilterSpec defines the desired state of the GRPCRewriteFilter.
+//
+// +kubebuilder:validation:XValidation:message="at least one of hostname, service or method must be set",rule="has(self.hostname) || has(self.service) || has(self.method)"
+//
+//nolint:lll
+type GRPCRewriteFilterSpec struct {
+	// Hostname is the value that replaces the authority of the request (the Host header).
+	//
+	// +optional
+	Hostname *gatewayv1.PreciseHostname `json:"hostname,omitempty"`
+
+	// Service is the fully qualified name of the service that replaces the service of the request.
+	// For example, "acme.billing.v2.Billing".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)*$`
+	Service *string `json:"service,omitempty"`
+
+	// Method is the name of the method that replaces the method of the request.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Method *string `json:"method,omitempty"`
+}
+
+// GRPCRewriteFilterStatus defines the state of GRPCRewriteFilter.
+type GRPCRewriteFilterStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the GRPCRewriteFilter
+	// and the status of the GRPCRewriteFilter with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// GRPCRewriteFilterConditionType is a type of condition associated with GRPCRewriteFilter.
+type GRPCRewriteFilterConditionType string
+
+// GRPCRewriteFilterConditionReason is a reason for a GRPCRewriteFilter condition type.
+type GRPCRewriteFilterConditionReason string
+
+const (
+	// GRPCRewriteFilterConditionTypeAccepted indicates that the GRPCRewriteFilter is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid.
+	GRPCRewriteFilterConditionTypeAccepted GRPCRewriteFilterConditionType = "Accepted"
+
+	// GRPCRewriteFilterConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	GRPCRewriteFilterConditionReasonAccepted GRPCRewriteFilterConditionReason = "Accepted"
+
+	// GRPCRewriteFilterConditionReasonInvalid is used with the Accepted condition type when
+	// GRPCRewriteFilter is invalid.
+	GRPCRewriteFilterConditionReasonInvalid GRPCRewriteFilterConditionReason = "Invalid"
+)
@@ -0,0 +1,121 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=grpcwebfilter
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GRPCWebFilter is a filter that enables gRPC-Web translation for GRPCRoute rules. gRPC-Web requests from
+// browser clients are translated to gRPC requests before they are proxied to a backend, and gRPC responses,
+// including their trailers, are translated back to gRPC-Web responses.
+// The text encoding of gRPC-Web (application/grpc-web-text) is not supported.
+// It can be referenced by GRPCRoute rules.
+type GRPCWebFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the GRPCWebFilter.
+	Spec GRPCWebFilterSpec `json:"spec"`
+
+	// Status defines the state of the GRPCWebFilter.
+	Status GRPCWebFilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCWebFilterList contains a list of GRPCWebFilters.
+type GRPCWebFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCWebFilter `json:"items"`
+}
+
+// GRPCWebFilterSpec defines the desired state of the GRPCWebFilter.
+type GRPCWebFilterSpec struct {
+	// CORS configures Cross-Origin Resource Sharing for gRPC-Web requests.
+	// If not set, no CORS headers are added to responses and preflight requests are proxied to the backend.
+	//
+	// +optional
+	CORS *GRPCWebCORS `json:"cors,omitempty"`
+}
+
+// GRPCWebCORS configures Cross-Origin Resource Sharing for gRPC-Web requests.
+type GRPCWebCORS struct {
+	// AllowOrigins is a list of origins that are allowed to make gRPC-Web requests.
+	// The value "*" allows requests from any origin.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	AllowOrigins []GRPCWebOrigin `json:"allowOrigins"`
+
+	// AllowHeaders is a list of request headers that are allowed in gRPC-Web requests, in addition to the
+	// headers used by gRPC-Web clients.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AllowHeaders []GRPCWebHeaderName `json:"allowHeaders,omitempty"`
+
+	// MaxAge is the number of seconds that the result of a preflight request can be cached by the client.
+	// Default is 86400 seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
+	MaxAge *int32 `json:"maxAge,omitempty"`
+}
+
+// GRPCWebOrigin is an origin allowed to make gRPC-Web requests, for example, "https://app.example.com".
+// The value "*" allows requests from any origin.
+//
+// +kubebuilder:validation:MaxLength=253
+// +kubebuilder:validation:Pattern=`^(\*|https?://[a-zA-Z0-9.\-]+(:[0-9]{1,5})?)$`
+type GRPCWebOrigin string
+
+// GRPCWebHeaderName is the name of a request header.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=256
+// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9\-]+$`
+type GRPCWebHeaderName string
+
+// GRPCWebFilterStatus defines the state of GRPCWebFilter.
+type GRPCWebFilterStatus struct {
+	// Controllers is a list of Gateway API controllers that processed the GRPCWebFilter
+	// and the status of the GRPCWebFilter with respect to each controller.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// GRPCWebFilterConditionType is a type of condition associated with GRPCWebFilter.
+type GRPCWebFilterConditionType string
+
+// GRPCWebFilterConditionReason is a reason for a GRPCWebFilter condition type.
+type GRPCWebFilterConditionReason string
+
+const (
+	// GRPCWebFilterConditionTypeAccepted indicates that the GRPCWebFilter is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid.
+	GRPCWebFilterConditionTypeAccepted GRPCWebFilterConditionType = "Accepted"
+
+	// GRPCWebFilterConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	GRPCWebFilterConditionReasonAccepted GRPCWebFilterConditionReason = "Accepted"
+
+	// GRPCWebFilterConditionReasonInvalid is used with the Accepted condition type when
+	// GRPCWebFilter is invalid.
+	GRPCWebFilterConditionReasonInvalid GRPCWebFilterConditionReason = "Invalid"
+)
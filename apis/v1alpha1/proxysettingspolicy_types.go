@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=pspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ProxySettingsPolicy is an Inherited Attached Policy. It provides a way to configure the behavior of the connection
+// between NGINX and the backends, such as the buffering of requests and responses.
+// Settings of a ProxySettingsPolicy attached to a Gateway are inherited by the Routes of the Gateway, unless
+// they are overridden by a ProxySettingsPolicy attached to a Route.
+type ProxySettingsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ProxySettingsPolicy.
+	Spec ProxySettingsPolicySpec `json:"spec"`
+
+	// Status defines the state of the ProxySettingsPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProxySettingsPolicyList contains a list of ProxySettingsPolicies.
+type ProxySettingsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProxySettingsPolicy `json:"items"`
+}
+
+// ProxySettingsPolicySpec defines the desired state of ProxySettingsPolicy.
+type ProxySettingsPolicySpec struct {
+	// Buffering defines the settings for buffering responses from the backends.
+	//
+	// +optional
+	Buffering *ProxyBuffering `json:"buffering,omitempty"`
+
+	// RequestBuffering defines the settings for buffering client request bodies.
+	//
+	// +optional
+	RequestBuffering *ProxyRequestBuffering `json:"requestBuffering,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// ProxyBuffering contains the settings for buffering responses from the backends.
+// BufferSize, Buffers, BusyBuffersSize, and MaxTempFileSize are configured together: when a policy sets any of
+// them, the ones it doesn't set take their defaults rather than the values of a policy attached to the Gateway.
+type ProxyBuffering struct {
+	// Disable disables buffering of responses from the backends. When buffering is disabled, a response is
+	// passed to the client as soon as it is received, which is required for server-sent events and
+	// other streaming responses.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffering.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// BufferSize sets the size of the buffer used for reading the first part of a response from the backend,
+	// which usually contains the response headers. Backends that send large headers need a larger buffer.
+	// It must be less than or equal to the total size of the Buffers minus one buffer.
+	// Default: 4k.
+	//
+	// +optional
+	BufferSize *Size `json:"bufferSize,omitempty"`
+
+	// Buffers sets the number and size of the buffers used for reading a response from the backend.
+	// Default: 8 buffers of 4k.
+	//
+	// +optional
+	Buffers *ProxyBuffers `json:"buffers,omitempty"`
+
+	// BusyBuffersSize limits the total size of the buffers that can be busy sending a response to the client
+	// while the response is not yet fully read. It must be at least as large as the BufferSize and the size of
+	// one of the Buffers, and less than or equal to the total size of the Buffers minus one buffer.
+	// Default: twice the larger of the BufferSize and the size of one of the Buffers, limited to the total size
+	// of the Buffers minus one buffer.
+	//
+	// +optional
+	BusyBuffersSize *Size `json:"busyBuffersSize,omitempty"`
+
+	// MaxTempFileSize sets the maximum size of a temporary file that a response is buffered to when it doesn't
+	// fit into the buffers. Setting the size to 0 disables buffering of responses to temporary files.
+	// Otherwise, it must be at least as large as the BufferSize and the size of one of the Buffers.
+	// Default: 1g.
+	//
+	// +optional
+	MaxTempFileSize *Size `json:"maxTempFileSize,omitempty"`
+}
+
+// ProxyBuffers contains the number and size of the buffers used for reading a response from the backend.
+type ProxyBuffers struct {
+	// Number is the number of buffers.
+	//
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=256
+	Number int32 `json:"number"`
+
+	// Size is the size of each buffer.
+	Size Size `json:"size"`
+}
+
+// ProxyRequestBuffering contains the settings for buffering client request bodies.
+type ProxyRequestBuffering struct {
+	// Disable disables buffering of client request bodies. When buffering is disabled, a request body is
+	// sent to the backend as soon as it is received, which is required for streaming uploads.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_request_buffering.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+}
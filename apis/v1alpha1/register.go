@@ -38,10 +38,24 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ObservabilityPolicyList{},
 		&ClientSettingsPolicy{},
 		&ClientSettingsPolicyList{},
+		&ErrorPagePolicy{},
+		&ErrorPagePolicyList{},
 		&SnippetsFilter{},
 		&SnippetsFilterList{},
+		&DirectResponseFilter{},
+		&DirectResponseFilterList{},
+		&GRPCRewriteFilter{},
+		&GRPCRewriteFilterList{},
+		&GRPCWebFilter{},
+		&GRPCWebFilterList{},
 		&UpstreamSettingsPolicy{},
 		&UpstreamSettingsPolicyList{},
+		&ProxySettingsPolicy{},
+		&ProxySettingsPolicyList{},
+		&TrafficSplitPolicy{},
+		&TrafficSplitPolicyList{},
+		&WebSocketPolicy{},
+		&WebSocketPolicyList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
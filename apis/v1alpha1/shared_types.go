@@ -26,3 +26,48 @@ type SpanAttribute struct {
 	// +kubebuilder:validation:Pattern=`^([^"$\\]|\\[^$])*$`
 	Value string `json:"value"`
 }
+
+// SplitKey defines the key that NGINX uses to split traffic between the backends of a routing rule
+// that has more than one backend. Requests with the same key are sent to the same backend as long as
+// the weights of the backends don't change.
+//
+// +kubebuilder:validation:XValidation:message="name is required when type is Cookie or Header, and must not be set otherwise",rule="(self.type == 'Cookie' || self.type == 'Header') == has(self.name)"
+// +kubebuilder:validation:XValidation:message="cookie name must only contain alphanumeric characters or '_'",rule="self.type != 'Cookie' || self.name.matches('^[a-zA-Z0-9_]+$')"
+//
+//nolint:lll
+type SplitKey struct {
+	// Type is the type of the split key.
+	Type SplitKeyType `json:"type"`
+
+	// Name is the name of the cookie or the request header that holds the split key.
+	// Required when Type is Cookie or Header. Requests without the cookie or the header
+	// are sent to the same backend.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	Name *string `json:"name,omitempty"`
+}
+
+// SplitKeyType is the type of the key used to split traffic.
+//
+// +kubebuilder:validation:Enum=RequestID;ClientIP;Cookie;Header
+type SplitKeyType string
+
+const (
+	// SplitKeyTypeRequestID splits traffic by the unique ID of the request. Every request is sent to
+	// a randomly selected backend. This is the default.
+	SplitKeyTypeRequestID SplitKeyType = "RequestID"
+
+	// SplitKeyTypeClientIP splits traffic by the IP address of the client.
+	SplitKeyTypeClientIP SplitKeyType = "ClientIP"
+
+	// SplitKeyTypeCookie splits traffic by the value of a cookie. Requests without the cookie are split by
+	// their request ID.
+	SplitKeyTypeCookie SplitKeyType = "Cookie"
+
+	// SplitKeyTypeHeader splits traffic by the value of a request header. Requests without the header are split by
+	// their request ID.
+	SplitKeyTypeHeader SplitKeyType = "Header"
+)
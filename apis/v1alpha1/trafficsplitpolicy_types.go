@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=tspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// TrafficSplitPolicy is a Direct Attached Policy. It provides a way to configure how NGINX splits traffic
+// between the weighted backends of the rules of an HTTPRoute or a GRPCRoute.
+type TrafficSplitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the TrafficSplitPolicy.
+	Spec TrafficSplitPolicySpec `json:"spec"`
+
+	// Status defines the state of the TrafficSplitPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrafficSplitPolicyList contains a list of TrafficSplitPolicies.
+type TrafficSplitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficSplitPolicy `json:"items"`
+}
+
+// TrafficSplitPolicySpec defines the desired state of the TrafficSplitPolicy.
+type TrafficSplitPolicySpec struct {
+	// SplitKey is the key that NGINX uses to split traffic between the backends of the rules of the
+	// targeted Routes. It overrides the split key of the NginxProxy of the Gateway.
+	SplitKey SplitKey `json:"splitKey"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute, GRPCRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: HTTPRoute or GRPCRoute",rule="self.all(t, t.kind=='HTTPRoute' || t.kind=='GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
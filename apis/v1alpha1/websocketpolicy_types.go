@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=wspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// WebSocketPolicy is a Direct Attached Policy. It provides a way to configure how NGINX handles
+// WebSocket and other long-lived connections for the rules of an HTTPRoute.
+type WebSocketPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the WebSocketPolicy.
+	Spec WebSocketPolicySpec `json:"spec"`
+
+	// Status defines the state of the WebSocketPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebSocketPolicyList contains a list of WebSocketPolicies.
+type WebSocketPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebSocketPolicy `json:"items"`
+}
+
+// WebSocketPolicySpec defines the desired state of the WebSocketPolicy.
+type WebSocketPolicySpec struct {
+	// Upgrade enables the handling of connection upgrades, such as WebSocket, for the targeted HTTPRoutes.
+	// When enabled, the Upgrade and Connection headers of a request are passed to the backend, even if
+	// keep-alive connections are enabled for the upstream. When disabled, requests are never upgraded.
+	// Default: true.
+	//
+	// +optional
+	Upgrade *bool `json:"upgrade,omitempty"`
+
+	// ReadTimeout defines a timeout for reading a response from the backend. The timeout is set only between
+	// two successive read operations, so it defines how long an idle connection is kept open.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_read_timeout.
+	//
+	// +optional
+	ReadTimeout *Duration `json:"readTimeout,omitempty"`
+
+	// SendTimeout defines a timeout for transmitting a request to the backend. The timeout is set only between
+	// two successive write operations.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_send_timeout.
+	//
+	// +optional
+	SendTimeout *Duration `json:"sendTimeout,omitempty"`
+
+	// Buffering enables buffering of requests and responses. Disabling buffering passes streamed data,
+	// such as server-sent events, to the client and the backend as soon as it is received.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffering.
+	//
+	// +optional
+	Buffering *bool `json:"buffering,omitempty"`
+
+	// TargetRefs identifies API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: HTTPRoute",rule="self.all(t, t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
@@ -5,8 +5,9 @@
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
@@ -180,7 +181,7 @@ func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -197,6 +198,587 @@ func (in *ControllerStatus) DeepCopy() *ControllerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseBody) DeepCopyInto(out *DirectResponseBody) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(DirectResponseConfigMapRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseBody.
+func (in *DirectResponseBody) DeepCopy() *DirectResponseBody {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseConfigMapRef) DeepCopyInto(out *DirectResponseConfigMapRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseConfigMapRef.
+func (in *DirectResponseConfigMapRef) DeepCopy() *DirectResponseConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseFilter) DeepCopyInto(out *DirectResponseFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseFilter.
+func (in *DirectResponseFilter) DeepCopy() *DirectResponseFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectResponseFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseFilterList) DeepCopyInto(out *DirectResponseFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DirectResponseFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseFilterList.
+func (in *DirectResponseFilterList) DeepCopy() *DirectResponseFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DirectResponseFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseFilterSpec) DeepCopyInto(out *DirectResponseFilterSpec) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(DirectResponseBody)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseFilterSpec.
+func (in *DirectResponseFilterSpec) DeepCopy() *DirectResponseFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseFilterStatus) DeepCopyInto(out *DirectResponseFilterStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseFilterStatus.
+func (in *DirectResponseFilterStatus) DeepCopy() *DirectResponseFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPage) DeepCopyInto(out *ErrorPage) {
+	*out = *in
+	if in.ResponseCode != nil {
+		in, out := &in.ResponseCode, &out.ResponseCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Static != nil {
+		in, out := &in.Static, &out.Static
+		*out = new(ErrorPageStatic)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(ErrorPageRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ErrorPageService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]ErrorPageStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPage.
+func (in *ErrorPage) DeepCopy() *ErrorPage {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicy) DeepCopyInto(out *ErrorPagePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicy.
+func (in *ErrorPagePolicy) DeepCopy() *ErrorPagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicyList) DeepCopyInto(out *ErrorPagePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ErrorPagePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicyList.
+func (in *ErrorPagePolicyList) DeepCopy() *ErrorPagePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicySpec) DeepCopyInto(out *ErrorPagePolicySpec) {
+	*out = *in
+	if in.InterceptUpstreamErrors != nil {
+		in, out := &in.InterceptUpstreamErrors, &out.InterceptUpstreamErrors
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ErrorPages != nil {
+		in, out := &in.ErrorPages, &out.ErrorPages
+		*out = make([]ErrorPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicySpec.
+func (in *ErrorPagePolicySpec) DeepCopy() *ErrorPagePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageRedirect) DeepCopyInto(out *ErrorPageRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageRedirect.
+func (in *ErrorPageRedirect) DeepCopy() *ErrorPageRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageService) DeepCopyInto(out *ErrorPageService) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageService.
+func (in *ErrorPageService) DeepCopy() *ErrorPageService {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageStatic) DeepCopyInto(out *ErrorPageStatic) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageStatic.
+func (in *ErrorPageStatic) DeepCopy() *ErrorPageStatic {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageStatic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRewriteFilter) DeepCopyInto(out *GRPCRewriteFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRewriteFilter.
+func (in *GRPCRewriteFilter) DeepCopy() *GRPCRewriteFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRewriteFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCRewriteFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRewriteFilterList) DeepCopyInto(out *GRPCRewriteFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GRPCRewriteFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRewriteFilterList.
+func (in *GRPCRewriteFilterList) DeepCopy() *GRPCRewriteFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRewriteFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCRewriteFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRewriteFilterSpec) DeepCopyInto(out *GRPCRewriteFilterSpec) {
+	*out = *in
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(v1.PreciseHostname)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRewriteFilterSpec.
+func (in *GRPCRewriteFilterSpec) DeepCopy() *GRPCRewriteFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRewriteFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCRewriteFilterStatus) DeepCopyInto(out *GRPCRewriteFilterStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRewriteFilterStatus.
+func (in *GRPCRewriteFilterStatus) DeepCopy() *GRPCRewriteFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCRewriteFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCWebCORS) DeepCopyInto(out *GRPCWebCORS) {
+	*out = *in
+	if in.AllowOrigins != nil {
+		in, out := &in.AllowOrigins, &out.AllowOrigins
+		*out = make([]GRPCWebOrigin, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowHeaders != nil {
+		in, out := &in.AllowHeaders, &out.AllowHeaders
+		*out = make([]GRPCWebHeaderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCWebCORS.
+func (in *GRPCWebCORS) DeepCopy() *GRPCWebCORS {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCWebCORS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCWebFilter) DeepCopyInto(out *GRPCWebFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCWebFilter.
+func (in *GRPCWebFilter) DeepCopy() *GRPCWebFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCWebFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCWebFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCWebFilterList) DeepCopyInto(out *GRPCWebFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GRPCWebFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCWebFilterList.
+func (in *GRPCWebFilterList) DeepCopy() *GRPCWebFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCWebFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCWebFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCWebFilterSpec) DeepCopyInto(out *GRPCWebFilterSpec) {
+	*out = *in
+	if in.CORS != nil {
+		in, out := &in.CORS, &out.CORS
+		*out = new(GRPCWebCORS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCWebFilterSpec.
+func (in *GRPCWebFilterSpec) DeepCopy() *GRPCWebFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCWebFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCWebFilterStatus) DeepCopyInto(out *GRPCWebFilterStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCWebFilterStatus.
+func (in *GRPCWebFilterStatus) DeepCopy() *GRPCWebFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCWebFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Logging) DeepCopyInto(out *Logging) {
 	*out = *in
@@ -237,7 +819,108 @@ func (in *NginxGateway) DeepCopy() *NginxGateway {
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGateway) DeepCopyObject() runtime.Object {
+func (in *NginxGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NginxGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
+func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+	*out = *in
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
+func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
+func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
+func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -245,31 +928,31 @@ func (in *NginxGateway) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NginxGateway, len(*in))
+		*out = make([]ObservabilityPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
-func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
+func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayList)
+	out := new(ObservabilityPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
+func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -277,49 +960,109 @@ func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
 	*out = *in
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(Logging)
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
-func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
+func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewaySpec)
+	out := new(ObservabilityPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
+func (in *ProxyBuffering) DeepCopyInto(out *ProxyBuffering) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Buffers != nil {
+		in, out := &in.Buffers, &out.Buffers
+		*out = new(ProxyBuffers)
+		**out = **in
+	}
+	if in.BusyBuffersSize != nil {
+		in, out := &in.BusyBuffersSize, &out.BusyBuffersSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.MaxTempFileSize != nil {
+		in, out := &in.MaxTempFileSize, &out.MaxTempFileSize
+		*out = new(Size)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
-func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyBuffering.
+func (in *ProxyBuffering) DeepCopy() *ProxyBuffering {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayStatus)
+	out := new(ProxyBuffering)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
+func (in *ProxyBuffers) DeepCopyInto(out *ProxyBuffers) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyBuffers.
+func (in *ProxyBuffers) DeepCopy() *ProxyBuffers {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyBuffers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyRequestBuffering) DeepCopyInto(out *ProxyRequestBuffering) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyRequestBuffering.
+func (in *ProxyRequestBuffering) DeepCopy() *ProxyRequestBuffering {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyRequestBuffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySettingsPolicy) DeepCopyInto(out *ProxySettingsPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -327,18 +1070,18 @@ func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
-func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicy.
+func (in *ProxySettingsPolicy) DeepCopy() *ProxySettingsPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicy)
+	out := new(ProxySettingsPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
+func (in *ProxySettingsPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -346,31 +1089,31 @@ func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
+func (in *ProxySettingsPolicyList) DeepCopyInto(out *ProxySettingsPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ObservabilityPolicy, len(*in))
+		*out = make([]ProxySettingsPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
-func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicyList.
+func (in *ProxySettingsPolicyList) DeepCopy() *ProxySettingsPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicyList)
+	out := new(ProxySettingsPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
+func (in *ProxySettingsPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -378,11 +1121,16 @@ func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
+func (in *ProxySettingsPolicySpec) DeepCopyInto(out *ProxySettingsPolicySpec) {
 	*out = *in
-	if in.Tracing != nil {
-		in, out := &in.Tracing, &out.Tracing
-		*out = new(Tracing)
+	if in.Buffering != nil {
+		in, out := &in.Buffering, &out.Buffering
+		*out = new(ProxyBuffering)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestBuffering != nil {
+		in, out := &in.RequestBuffering, &out.RequestBuffering
+		*out = new(ProxyRequestBuffering)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TargetRefs != nil {
@@ -394,12 +1142,12 @@ func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
-func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicySpec.
+func (in *ProxySettingsPolicySpec) DeepCopy() *ProxySettingsPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicySpec)
+	out := new(ProxySettingsPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -535,6 +1283,26 @@ func (in *SpanAttribute) DeepCopy() *SpanAttribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitKey) DeepCopyInto(out *SplitKey) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitKey.
+func (in *SplitKey) DeepCopy() *SplitKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tracing) DeepCopyInto(out *Tracing) {
 	*out = *in
@@ -570,6 +1338,88 @@ func (in *Tracing) DeepCopy() *Tracing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitPolicy) DeepCopyInto(out *TrafficSplitPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitPolicy.
+func (in *TrafficSplitPolicy) DeepCopy() *TrafficSplitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficSplitPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitPolicyList) DeepCopyInto(out *TrafficSplitPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficSplitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitPolicyList.
+func (in *TrafficSplitPolicyList) DeepCopy() *TrafficSplitPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficSplitPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitPolicySpec) DeepCopyInto(out *TrafficSplitPolicySpec) {
+	*out = *in
+	in.SplitKey.DeepCopyInto(&out.SplitKey)
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitPolicySpec.
+func (in *TrafficSplitPolicySpec) DeepCopy() *TrafficSplitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpstreamKeepAlive) DeepCopyInto(out *UpstreamKeepAlive) {
 	*out = *in
@@ -695,3 +1545,104 @@ func (in *UpstreamSettingsPolicySpec) DeepCopy() *UpstreamSettingsPolicySpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebSocketPolicy) DeepCopyInto(out *WebSocketPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebSocketPolicy.
+func (in *WebSocketPolicy) DeepCopy() *WebSocketPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WebSocketPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebSocketPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebSocketPolicyList) DeepCopyInto(out *WebSocketPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebSocketPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebSocketPolicyList.
+func (in *WebSocketPolicyList) DeepCopy() *WebSocketPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebSocketPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebSocketPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebSocketPolicySpec) DeepCopyInto(out *WebSocketPolicySpec) {
+	*out = *in
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReadTimeout != nil {
+		in, out := &in.ReadTimeout, &out.ReadTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.SendTimeout != nil {
+		in, out := &in.SendTimeout, &out.SendTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Buffering != nil {
+		in, out := &in.Buffering, &out.Buffering
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebSocketPolicySpec.
+func (in *WebSocketPolicySpec) DeepCopy() *WebSocketPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebSocketPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -1,8 +1,12 @@
 package v1alpha2
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 )
@@ -67,17 +71,244 @@ type NginxProxySpec struct {
 	//
 	// +optional
 	NginxPlus *NginxPlus `json:"nginxPlus,omitempty"`
+	// SplitKey is the key that NGINX uses to split traffic between the backends of a routing rule that has more
+	// than one backend. It can be overridden for a Route by a TrafficSplitPolicy.
+	// Default is RequestID, meaning every request is sent to a randomly selected backend.
+	//
+	// +optional
+	SplitKey *v1alpha1.SplitKey `json:"splitKey,omitempty"`
+	// HTTPMatchMode defines how NGINX evaluates the method, header and query parameter matches of routing rules.
+	// Default is NJS.
+	//
+	// +optional
+	HTTPMatchMode *HTTPMatchModeType `json:"httpMatchMode,omitempty"`
 	// DisableHTTP2 defines if http2 should be disabled for all servers.
 	// If not specified, or set to false, http2 will be enabled for all servers.
 	//
 	// +optional
 	DisableHTTP2 *bool `json:"disableHTTP2,omitempty"`
+	// HTTP3 defines the configuration of HTTP/3 (QUIC) for the servers of HTTPS listeners.
+	// HTTP/3 requires NGINX 1.25.0 or later, built with the ngx_http_v3_module.
+	//
+	// +optional
+	HTTP3 *HTTP3 `json:"http3,omitempty"`
+	// TLS defines the default TLS settings for the servers of HTTPS listeners. The settings can be overridden
+	// for a listener with the TLS options of the listener.
+	//
+	// +optional
+	TLS *NginxTLS `json:"tls,omitempty"`
+	// MergeGateways defines if the Gateways that use this configuration are merged onto a shared data plane.
+	// Gateways are merged when they are in the same namespace and reference the same NginxProxy (or none),
+	// in which case they are served by the NGINX Deployment and Service of the oldest Gateway. Listeners of
+	// the merged Gateways that conflict with each other are marked as invalid.
+	// Default is false, meaning every Gateway gets its own data plane.
+	//
+	// +optional
+	MergeGateways *bool `json:"mergeGateways,omitempty"`
+	// StaticDataPlane configures the Gateways that use this configuration to be served by NGINX instances that are
+	// not provisioned by NGINX Gateway Fabric, such as NGINX running on virtual machines or in pre-provisioned Pods.
+	// When set, NGINX Gateway Fabric does not create the NGINX Deployment and Service of a Gateway. Instead, the
+	// NGINX agents of the external instances connect to the control plane with a token tied to the Gateway,
+	// and receive the configuration of the Gateway.
+	//
+	// +optional
+	StaticDataPlane *StaticDataPlane `json:"staticDataPlane,omitempty"`
 	// Kubernetes contains the configuration for the NGINX Deployment and Service Kubernetes objects.
 	//
 	// +optional
 	Kubernetes *KubernetesSpec `json:"kubernetes,omitempty"`
 }
 
+// StaticDataPlane defines the configuration of NGINX instances that are not provisioned by NGINX Gateway Fabric.
+type StaticDataPlane struct {
+	// TokenSecretName is the name of the Secret that holds the token that the NGINX agents of the Gateway
+	// authenticate with, under the "token" key. The Secret must be in the namespace of the Gateway.
+	// The agents must also report the Gateway they serve, in the format "namespace/name", with the "gateway" label
+	// of the agent configuration.
+	//
+	// +kubebuilder:validation:MinLength=1
+	TokenSecretName string `json:"tokenSecretName"`
+}
+
+// HTTP3 defines the configuration of HTTP/3 (QUIC).
+type HTTP3 struct {
+	// Enable enables HTTP/3 for the servers of HTTPS listeners. When enabled, NGINX accepts QUIC connections
+	// on the UDP ports of the HTTPS listeners, which are also exposed on the NGINX Service, and advertises
+	// HTTP/3 to clients in the Alt-Svc response header.
+	// Default is false.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// AltSvcMaxAge is the number of seconds that clients can remember that HTTP/3 is available,
+	// which is set as the "ma" parameter of the Alt-Svc response header.
+	// Default is 86400 seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	AltSvcMaxAge *int32 `json:"altSvcMaxAge,omitempty"`
+}
+
+// NginxTLS defines the TLS settings for the servers of HTTPS listeners.
+//
+// The settings can be overridden for a listener with the following keys of the TLS options of the listener:
+//
+// * nginx.org/ssl-protocols: a space-separated list of TLS protocols, for example "TLSv1.2 TLSv1.3".
+// * nginx.org/ssl-ciphers: the enabled ciphers in the format of the OpenSSL library.
+// * nginx.org/ssl-prefer-server-ciphers: "true" or "false".
+type NginxTLS struct {
+	// Protocols is the list of enabled TLS protocols.
+	// Default: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_protocols.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=4
+	Protocols []TLSProtocolType `json:"protocols,omitempty"`
+
+	// Ciphers is the list of enabled ciphers for TLSv1.2 and earlier, in the format of the OpenSSL library,
+	// for example "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256".
+	// Default: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_ciphers.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_+!@=:.,\-]+$`
+	Ciphers *string `json:"ciphers,omitempty"`
+
+	// PreferServerCiphers specifies that the ciphers of the server are preferred over the ciphers of the client
+	// when TLSv1.2 and earlier are used.
+	// Default: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_prefer_server_ciphers.
+	//
+	// +optional
+	PreferServerCiphers *bool `json:"preferServerCiphers,omitempty"`
+
+	// SessionCache defines the cache of TLS sessions that clients can resume without a full handshake.
+	//
+	// +optional
+	SessionCache *TLSSessionCache `json:"sessionCache,omitempty"`
+
+	// SessionTickets defines the resumption of TLS sessions with session tickets.
+	//
+	// +optional
+	SessionTickets *TLSSessionTickets `json:"sessionTickets,omitempty"`
+
+	// OCSPStapling defines the stapling of OCSP responses, which saves clients a request to the OCSP responder
+	// of the certificate authority. The certificate of the issuer must be included in the certificate chain of
+	// the listener Secrets.
+	//
+	// +optional
+	OCSPStapling *OCSPStapling `json:"ocspStapling,omitempty"`
+}
+
+// TLSSessionCache defines the cache of TLS sessions. If set, and not disabled, a cache shared between the
+// NGINX worker processes is enabled.
+type TLSSessionCache struct {
+	// Disable disables the cache of TLS sessions.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// Size is the size of the cache that is shared between the NGINX worker processes.
+	// One megabyte can store about 4000 sessions.
+	// Default: 10m.
+	//
+	// +optional
+	Size *v1alpha1.Size `json:"size,omitempty"`
+
+	// Timeout is the time during which a client can reuse the parameters of a TLS session.
+	// Default: https://nginx.org/en/docs/http/ngx_http_ssl_module.html#ssl_session_timeout.
+	//
+	// +optional
+	Timeout *v1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+// TLSSessionTickets defines the resumption of TLS sessions with session tickets.
+type TLSSessionTickets struct {
+	// Disable disables the resumption of TLS sessions with session tickets.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// KeysSecretName is the name of a Secret in the namespace of the Gateway that holds the keys used to
+	// encrypt and decrypt session tickets. Sharing the keys lets clients resume their sessions with any NGINX
+	// replica. Each key of the Secret holds a key of 80 or 48 bytes, for example, created with
+	// "openssl rand 80". All keys are used to decrypt session tickets, and the last key, in the lexicographical
+	// order of the key names, is used to encrypt them. To rotate the keys, add a new key with a name that comes
+	// last, such as a timestamp, and remove the oldest key after the session timeout.
+	// If not set, or the Secret is invalid, every NGINX replica generates its own keys.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	KeysSecretName *string `json:"keysSecretName,omitempty"`
+}
+
+// OCSPStapling defines the stapling of OCSP responses.
+type OCSPStapling struct {
+	// Enable enables the stapling of OCSP responses.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// Resolver defines the DNS servers that are used to resolve the hostnames of the OCSP responders.
+	// Required when stapling is enabled.
+	//
+	// +optional
+	Resolver *DNSResolver `json:"resolver,omitempty"`
+}
+
+// DNSResolver defines the DNS servers that NGINX uses to resolve hostnames.
+type DNSResolver struct {
+	// Addresses are the IP addresses of the DNS servers, optionally with a port, for example, "10.0.0.10",
+	// "10.0.0.10:53" or "[fd00::10]:53".
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Addresses []string `json:"addresses"`
+
+	// Valid overrides the time that NGINX caches the DNS responses.
+	// Default: the TTL value of the DNS responses.
+	//
+	// +optional
+	Valid *v1alpha1.Duration `json:"valid,omitempty"`
+
+	// Timeout is the timeout for resolving a hostname.
+	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#resolver_timeout.
+	//
+	// +optional
+	Timeout *v1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+// TLSProtocolType is a TLS protocol.
+//
+// +kubebuilder:validation:Enum=TLSv1;TLSv1.1;TLSv1.2;TLSv1.3
+type TLSProtocolType string
+
+const (
+	// TLSProtocolV1 is the TLSv1 protocol.
+	TLSProtocolV1 TLSProtocolType = "TLSv1"
+
+	// TLSProtocolV11 is the TLSv1.1 protocol.
+	TLSProtocolV11 TLSProtocolType = "TLSv1.1"
+
+	// TLSProtocolV12 is the TLSv1.2 protocol.
+	TLSProtocolV12 TLSProtocolType = "TLSv1.2"
+
+	// TLSProtocolV13 is the TLSv1.3 protocol.
+	TLSProtocolV13 TLSProtocolType = "TLSv1.3"
+)
+
+const (
+	// TLSOptionSSLProtocols is the key of the listener TLS option that overrides the enabled TLS protocols.
+	TLSOptionSSLProtocols = "nginx.org/ssl-protocols"
+
+	// TLSOptionSSLCiphers is the key of the listener TLS option that overrides the enabled ciphers.
+	TLSOptionSSLCiphers = "nginx.org/ssl-ciphers"
+
+	// TLSOptionSSLPreferServerCiphers is the key of the listener TLS option that overrides whether the ciphers
+	// of the server are preferred.
+	TLSOptionSSLPreferServerCiphers = "nginx.org/ssl-prefer-server-ciphers"
+)
+
 // Telemetry specifies the OpenTelemetry configuration.
 type Telemetry struct {
 	// DisabledFeatures specifies OpenTelemetry features to be disabled.
@@ -162,6 +393,141 @@ type Metrics struct {
 	//
 	// +optional
 	Disable *bool `json:"disable,omitempty"`
+
+	// PodMonitor configures a Prometheus Operator PodMonitor that scrapes the metrics of the NGINX Pods.
+	// The PodMonitor is only created if the PodMonitor CRD (podmonitors.monitoring.coreos.com)
+	// is installed in the cluster.
+	//
+	// +optional
+	PodMonitor *PodMonitor `json:"podMonitor,omitempty"`
+}
+
+// PodMonitor defines the configuration of the Prometheus Operator PodMonitor for a Gateway.
+type PodMonitor struct {
+	// Enable creates a PodMonitor for each Gateway.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// Labels are added to the PodMonitor. Use them to match the podMonitorSelector of a Prometheus instance.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Interval at which the metrics are scraped. If no unit is specified, seconds are used.
+	// Default: the scrape interval of the Prometheus instance.
+	//
+	// +optional
+	Interval *v1alpha1.Duration `json:"interval,omitempty"`
+
+	// ScrapeTimeout is the timeout for scraping the metrics. If no unit is specified, seconds are used.
+	// Default: the scrape timeout of the Prometheus instance.
+	//
+	// +optional
+	ScrapeTimeout *v1alpha1.Duration `json:"scrapeTimeout,omitempty"`
+
+	// Relabelings are applied to the target's labels before scraping.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Relabelings []RelabelConfig `json:"relabelings,omitempty"`
+
+	// MetricRelabelings are applied to the scraped samples before ingestion.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	MetricRelabelings []RelabelConfig `json:"metricRelabelings,omitempty"`
+
+	// TLS configures scraping the metrics over HTTPS.
+	//
+	// +optional
+	TLS *PodMonitorTLS `json:"tls,omitempty"`
+}
+
+// RelabelConfig is a Prometheus relabeling rule.
+// See https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+type RelabelConfig struct {
+	// SourceLabels select values from existing labels.
+	//
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// Separator placed between concatenated source label values.
+	//
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+
+	// TargetLabel is the label to which the resulting value is written in a replace action.
+	//
+	// +optional
+	TargetLabel *string `json:"targetLabel,omitempty"`
+
+	// Regex against which the extracted value is matched.
+	//
+	// +optional
+	Regex *string `json:"regex,omitempty"`
+
+	// Replacement value against which a regex replace is performed if the regular expression matches.
+	//
+	// +optional
+	Replacement *string `json:"replacement,omitempty"`
+
+	// Action to perform based on the regex matching.
+	// Default: replace
+	//
+	// +optional
+	Action *RelabelAction `json:"action,omitempty"`
+}
+
+// RelabelAction is the action of a Prometheus relabeling rule.
+//
+// +kubebuilder:validation:Enum=replace;keep;drop;hashmod;labelmap;labeldrop;labelkeep;lowercase;uppercase;keepequal;dropequal
+//
+//nolint:lll
+type RelabelAction string
+
+const (
+	// RelabelActionReplace replaces the target label with the replacement if the regex matches.
+	RelabelActionReplace RelabelAction = "replace"
+	// RelabelActionKeep drops targets for which the regex does not match.
+	RelabelActionKeep RelabelAction = "keep"
+	// RelabelActionDrop drops targets for which the regex matches.
+	RelabelActionDrop RelabelAction = "drop"
+	// RelabelActionHashMod sets the target label to the modulus of a hash of the source labels.
+	RelabelActionHashMod RelabelAction = "hashmod"
+	// RelabelActionLabelMap copies the values of labels matching the regex to new label names.
+	RelabelActionLabelMap RelabelAction = "labelmap"
+	// RelabelActionLabelDrop removes the labels matching the regex.
+	RelabelActionLabelDrop RelabelAction = "labeldrop"
+	// RelabelActionLabelKeep removes the labels not matching the regex.
+	RelabelActionLabelKeep RelabelAction = "labelkeep"
+	// RelabelActionLowercase sets the target label to the lowercased source labels.
+	RelabelActionLowercase RelabelAction = "lowercase"
+	// RelabelActionUppercase sets the target label to the uppercased source labels.
+	RelabelActionUppercase RelabelAction = "uppercase"
+	// RelabelActionKeepEqual drops targets for which the source labels do not equal the target label.
+	RelabelActionKeepEqual RelabelAction = "keepequal"
+	// RelabelActionDropEqual drops targets for which the source labels equal the target label.
+	RelabelActionDropEqual RelabelAction = "dropequal"
+)
+
+// PodMonitorTLS defines the TLS settings used by Prometheus to scrape the metrics.
+type PodMonitorTLS struct {
+	// CASecretName is the name of the Secret, in the namespace of the Gateway, that contains the CA certificate
+	// used to verify the metrics endpoint. The certificate must be stored in the "ca.crt" key.
+	//
+	// +optional
+	CASecretName *string `json:"caSecretName,omitempty"`
+
+	// ServerName is used to verify the hostname of the metrics endpoint.
+	//
+	// +optional
+	ServerName *string `json:"serverName,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the metrics endpoint's certificate.
+	//
+	// +optional
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // RewriteClientIP specifies the configuration for rewriting the client's IP address.
@@ -219,6 +585,27 @@ const (
 	RewriteClientIPModeXForwardedFor RewriteClientIPModeType = "XForwardedFor"
 )
 
+// HTTPMatchModeType defines how NGINX evaluates the method, header and query parameter matches of routing rules.
+//
+// +kubebuilder:validation:Enum=NJS;Native
+type HTTPMatchModeType string
+
+const (
+	// HTTPMatchModeNJS evaluates the matches with the NGINX JavaScript module, which redirects the request
+	// to the location of the first matching rule.
+	HTTPMatchModeNJS HTTPMatchModeType = "NJS"
+
+	// HTTPMatchModeNative compiles the matches into NGINX map blocks, so that NGINX selects the location of
+	// the first matching rule without running JavaScript. This reduces the per-request overhead of the matching.
+	// The following differences apply:
+	// - Query parameter names are matched case-insensitively, and the values are matched without being decoded.
+	// - Header values of repeated headers are matched against the combined value of the headers.
+	// Rules with header names that contain characters other than letters, digits and hyphens, or with query
+	// parameter names that contain characters other than letters, digits and underscores, are still evaluated
+	// with the NGINX JavaScript module.
+	HTTPMatchModeNative HTTPMatchModeType = "Native"
+)
+
 // IPFamilyType specifies the IP family to be used by NGINX.
 //
 // +kubebuilder:validation:Enum=dual;ipv4;ipv6
@@ -379,15 +766,105 @@ type KubernetesSpec struct {
 	//
 	// +optional
 	Service *ServiceSpec `json:"service,omitempty"`
+
+	// PodDisruptionBudget is the configuration for the PodDisruptionBudget of the NGINX Pods.
+	// If not set, no PodDisruptionBudget is created.
+	//
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// NetworkPolicy is the configuration for the NetworkPolicy of the NGINX Pods.
+	//
+	// +optional
+	NetworkPolicy *NetworkPolicySpec `json:"networkPolicy,omitempty"`
+
+	// CostAllocation defines the labels that attribute the cost of the NGINX data plane to a team
+	// or cost center. The labels are added to every object provisioned for a Gateway, including the NGINX Pods.
+	//
+	// +optional
+	CostAllocation *CostAllocationSpec `json:"costAllocation,omitempty"`
+
+	// Quota limits the total resources of the NGINX data planes of the Gateways in each namespace.
+	// A data plane that would exceed the quota of its namespace is not created or updated, and the
+	// Programmed condition of its Gateway is set to false. The data planes are admitted from the oldest
+	// Gateway to the newest one.
+	// The quota is only honored on the NginxProxy referenced by the GatewayClass, and is ignored
+	// on the NginxProxy referenced by a Gateway.
+	//
+	// +optional
+	Quota *QuotaSpec `json:"quota,omitempty"`
+}
+
+// CostAllocationSpec defines the labels that attribute the cost of the NGINX data plane.
+type CostAllocationSpec struct {
+	// Team is the value of the "gateway.nginx.org/team" label.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`
+	Team *string `json:"team,omitempty"`
+
+	// CostCenter is the value of the "gateway.nginx.org/cost-center" label.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`
+	CostCenter *string `json:"costCenter,omitempty"`
+}
+
+// QuotaSpec defines the limits of the NGINX data planes in a namespace.
+type QuotaSpec struct {
+	// MaxReplicas is the maximum total number of NGINX Pods in a namespace. For a Deployment with
+	// autoscaling enabled, the maximum replicas of the HorizontalPodAutoscaler are counted.
+	// The number of Pods of a DaemonSet depends on the number of nodes, so DaemonSets can't be used
+	// when a quota is set.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// MaxCPU is the maximum total CPU requested by the NGINX Pods in a namespace.
+	// When set, the containers of the NGINX Pods must specify CPU requests or limits.
+	//
+	// +optional
+	MaxCPU *resource.Quantity `json:"maxCPU,omitempty"`
+
+	// MaxMemory is the maximum total memory requested by the NGINX Pods in a namespace.
+	// When set, the containers of the NGINX Pods must specify memory requests or limits.
+	//
+	// +optional
+	MaxMemory *resource.Quantity `json:"maxMemory,omitempty"`
 }
 
 // Deployment is the configuration for the NGINX Deployment.
 type DeploymentSpec struct {
 	// Number of desired Pods.
+	// If autoscaling is enabled, the number of Pods is managed by the HorizontalPodAutoscaler instead,
+	// and this field is ignored.
 	//
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// Autoscaling defines the HorizontalPodAutoscaler for the NGINX Deployment.
+	//
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+
+	// Strategy is the strategy used to replace the NGINX Pods with new ones, for example, the maxSurge and
+	// maxUnavailable of a rolling update. Defaults to a rolling update with 25% maxSurge and 25% maxUnavailable.
+	//
+	// +optional
+	Strategy *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
+
+	// ImageUpgradeStrategy is the strategy used to upgrade the NGINX Pods when the image of the NGINX container
+	// changes. With the BlueGreen strategy, the Pods with the new image are created next to the existing Pods,
+	// and receive traffic only once their agents have connected and applied the configuration. The NGINX Deployment
+	// is then updated while these Pods serve the traffic, and they are removed once the NGINX Deployment is ready.
+	// Default: Rolling.
+	//
+	// +optional
+	ImageUpgradeStrategy *ImageUpgradeStrategyType `json:"imageUpgradeStrategy,omitempty"`
+
 	// Pod defines Pod-specific fields.
 	//
 	// +optional
@@ -399,6 +876,108 @@ type DeploymentSpec struct {
 	Container ContainerSpec `json:"container"`
 }
 
+// ImageUpgradeStrategyType is the strategy used to upgrade the NGINX Pods to a new image.
+//
+// +kubebuilder:validation:Enum=Rolling;BlueGreen
+type ImageUpgradeStrategyType string
+
+const (
+	// ImageUpgradeStrategyRolling replaces the NGINX Pods using the Strategy of the Deployment.
+	ImageUpgradeStrategyRolling ImageUpgradeStrategyType = "Rolling"
+
+	// ImageUpgradeStrategyBlueGreen creates a staged Deployment with the new image, shifts the traffic to it once
+	// its agents have applied the configuration, and only then replaces the Pods of the NGINX Deployment. If the new
+	// Pods of the NGINX Deployment don't apply the configuration, it's rolled back to the previous image. The traffic
+	// is then shifted back to the NGINX Deployment and the staged Deployment is removed.
+	ImageUpgradeStrategyBlueGreen ImageUpgradeStrategyType = "BlueGreen"
+)
+
+// AutoscalingSpec is the configuration for the HorizontalPodAutoscaler of the NGINX Deployment.
+//
+// +kubebuilder:validation:XValidation:message="minReplicas must be less than or equal to maxReplicas",rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas"
+//
+//nolint:lll
+type AutoscalingSpec struct {
+	// Enable enables the HorizontalPodAutoscaler.
+	Enable bool `json:"enable"`
+
+	// MinReplicas is the lower limit for the number of Pods.
+	// Default: 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of Pods.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of the Pods, as a percentage
+	// of the requested CPU. The NGINX container must set CPU requests.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetMemoryUtilizationPercentage is the target average memory utilization of the Pods, as a percentage
+	// of the requested memory. The NGINX container must set memory requests.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Metrics are additional metrics, such as custom or external metrics, used to calculate the number of Pods.
+	// If neither metrics nor utilization targets are set, the HorizontalPodAutoscaler targets an average
+	// CPU utilization of 80%.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures the scaling behavior in both the up and down directions.
+	//
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// PodDisruptionBudgetSpec is the configuration for the PodDisruptionBudget of the NGINX Pods.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of minAvailable or maxUnavailable must be set",rule="has(self.minAvailable) != has(self.maxUnavailable)"
+//
+//nolint:lll
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of NGINX Pods that must remain available during a voluntary
+	// disruption, such as a node drain.
+	//
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of NGINX Pods that can be unavailable during a voluntary
+	// disruption, such as a node drain.
+	//
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// NetworkPolicySpec is the configuration for the NetworkPolicy of the NGINX Pods.
+type NetworkPolicySpec struct {
+	// Enable creates a NetworkPolicy for the NGINX Pods of each Gateway. The NetworkPolicy allows:
+	// - ingress traffic on the listener ports, from the loadBalancerSourceRanges of the Service if set.
+	// - ingress traffic on the metrics port from any source, if metrics are enabled.
+	// - egress traffic to the Pods of the Services referenced by the Routes attached to the Gateway.
+	// - egress traffic to the control plane and to DNS.
+	// - egress traffic to the port of the telemetry exporter endpoint, if set.
+	// - egress traffic to the OCSP responders on port 80, and to the OCSP stapling resolvers, if OCSP stapling
+	//   is enabled.
+	// Services without a selector, such as ExternalName Services, are not included.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+}
+
 // DaemonSet is the configuration for the NGINX DaemonSet.
 type DaemonSetSpec struct {
 	// Pod defines Pod-specific fields.
@@ -453,6 +1032,42 @@ type PodSpec struct {
 	//
 	// +optional
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PriorityClassName is the name of the PriorityClass of the pod.
+	//
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+
+	// SecurityContext holds pod-level security attributes and common container settings.
+	// Fields that are not set keep their defaults: fsGroup 1001 and runAsNonRoot true.
+	//
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+
+	// HostNetwork runs the pod in the host's network namespace.
+	// The ports of the Gateway listeners must be available on the nodes.
+	//
+	// +optional
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+
+	// DNSPolicy is the DNS policy of the pod. Defaults to ClusterFirst, or ClusterFirstWithHostNet
+	// if hostNetwork is enabled.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	DNSPolicy *corev1.DNSPolicy `json:"dnsPolicy,omitempty"`
+
+	// InitContainers are additional init containers of the pod. They run after the init container
+	// that bootstraps the NGINX configuration. The names "init" and "nginx" are reserved.
+	//
+	// +optional
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+
+	// Containers are additional sidecar containers of the pod, which run alongside the NGINX container.
+	// The names "init" and "nginx" are reserved.
+	//
+	// +optional
+	Containers []corev1.Container `json:"containers,omitempty"`
 }
 
 // ContainerSpec defines container fields for the NGINX container.
@@ -483,6 +1098,21 @@ type ContainerSpec struct {
 	//
 	// +optional
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
+	// ReadinessProbe is the readiness probe of the NGINX container.
+	//
+	// +optional
+	ReadinessProbe *corev1.Probe `json:"readinessProbe,omitempty"`
+
+	// LivenessProbe is the liveness probe of the NGINX container.
+	//
+	// +optional
+	LivenessProbe *corev1.Probe `json:"livenessProbe,omitempty"`
+
+	// StartupProbe is the startup probe of the NGINX container.
+	//
+	// +optional
+	StartupProbe *corev1.Probe `json:"startupProbe,omitempty"`
 }
 
 // Image is the NGINX image to use.
@@ -557,8 +1187,92 @@ type ServiceSpec struct {
 	//
 	// +optional
 	NodePorts []NodePort `json:"nodePorts,omitempty"`
+
+	// Annotations are the annotations of the NGINX data plane Service. If an annotation is also set in the
+	// Gateway infrastructure, the Gateway value takes precedence.
+	//
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels are the labels of the NGINX data plane Service. If a label is also set in the
+	// Gateway infrastructure, the Gateway value takes precedence.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ExternalIPs are the IP addresses for which nodes in the cluster also accept traffic for the Service.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ExternalIPs []string `json:"externalIPs,omitempty"`
+
+	// SessionAffinity enables client IP based session affinity for the Service.
+	//
+	// +optional
+	SessionAffinity *SessionAffinity `json:"sessionAffinity,omitempty"`
+
+	// IPFamilyPolicy is the dual-stack policy of the Service.
+	// Defaults to PreferDualStack. If ipFamily is set to ipv4 or ipv6, SingleStack is always used.
+	//
+	// +optional
+	IPFamilyPolicy *IPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
+
+	// InternalService is the configuration for an additional ClusterIP Service for the NGINX data plane,
+	// for example, for traffic from inside the cluster.
+	//
+	// +optional
+	InternalService *InternalServiceSpec `json:"internalService,omitempty"`
+}
+
+// InternalServiceSpec is the configuration for the internal ClusterIP Service of the NGINX data plane.
+// The Service is named after the NGINX data plane Service with an "-internal" suffix and exposes
+// the same ports.
+type InternalServiceSpec struct {
+	// Enable creates the internal Service.
+	//
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+
+	// Annotations are the annotations of the internal Service. If an annotation is also set in the
+	// Gateway infrastructure, the Gateway value takes precedence.
+	//
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels are the labels of the internal Service. If a label is also set in the
+	// Gateway infrastructure, the Gateway value takes precedence.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
+// SessionAffinity is the session affinity of the Service.
+// +kubebuilder:validation:Enum=None;ClientIP
+type SessionAffinity corev1.ServiceAffinity
+
+const (
+	// SessionAffinityNone disables session affinity.
+	SessionAffinityNone SessionAffinity = SessionAffinity(corev1.ServiceAffinityNone)
+
+	// SessionAffinityClientIP routes the connections from the same client IP address to the same Pod.
+	SessionAffinityClientIP SessionAffinity = SessionAffinity(corev1.ServiceAffinityClientIP)
+)
+
+// IPFamilyPolicy is the dual-stack policy of the Service.
+// +kubebuilder:validation:Enum=SingleStack;PreferDualStack;RequireDualStack
+type IPFamilyPolicy corev1.IPFamilyPolicy
+
+const (
+	// IPFamilyPolicySingleStack assigns a single IP family to the Service.
+	IPFamilyPolicySingleStack IPFamilyPolicy = IPFamilyPolicy(corev1.IPFamilyPolicySingleStack)
+
+	// IPFamilyPolicyPreferDualStack assigns both IP families to the Service if the cluster supports it.
+	IPFamilyPolicyPreferDualStack IPFamilyPolicy = IPFamilyPolicy(corev1.IPFamilyPolicyPreferDualStack)
+
+	// IPFamilyPolicyRequireDualStack requires both IP families for the Service.
+	IPFamilyPolicyRequireDualStack IPFamilyPolicy = IPFamilyPolicy(corev1.IPFamilyPolicyRequireDualStack)
+)
+
 // ServiceType describes ingress method for the Service.
 // +kubebuilder:validation:Enum=ClusterIP;LoadBalancer;NodePort
 type ServiceType corev1.ServiceType
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +22,8 @@ import (
 	ngfConfig "github.com/nginx/nginx-gateway-fabric/internal/controller/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/licensing"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/grpc"
+	grpcContext "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/grpc/context"
 	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/provisioner"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state"
@@ -70,6 +73,8 @@ type eventHandlerConfig struct {
 	statusQueue *status.Queue
 	// nginxDeployments contains a map of all nginx Deployments, and data about them.
 	nginxDeployments *agent.DeploymentStore
+	// staticTokens contains the tokens that the agents of static data planes authenticate with.
+	staticTokens *agentgrpc.StaticTokenStore
 	// logger is the logger for the event handler.
 	logger logr.Logger
 	// gatewayPodConfig contains information about this Pod.
@@ -177,6 +182,8 @@ func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logg
 		return
 	}
 
+	h.updateStaticTokens(gr)
+
 	if len(gr.Gateways) == 0 {
 		// still need to update GatewayClass status
 		obj := &status.QueueObject{
@@ -202,13 +209,19 @@ func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logg
 			return
 		}
 
+		// The configuration of a merged Gateway is sent as part of the configuration of the Gateway
+		// that owns its data plane, which also updates the status of the merged Gateway.
+		if gw.MergedInto != nil {
+			continue
+		}
+
 		stopCh := make(chan struct{})
 		deployment := h.cfg.nginxDeployments.GetOrStore(ctx, gw.DeploymentName, stopCh)
 		if deployment == nil {
 			panic("expected deployment, got nil")
 		}
 
-		cfg := dataplane.BuildConfiguration(ctx, gr, gw, h.cfg.serviceResolver, h.cfg.plus)
+		cfg := h.buildConfiguration(ctx, gr, gw)
 		depCtx, getErr := h.getDeploymentContext(ctx)
 		if getErr != nil {
 			logger.Error(getErr, "error getting deployment context for usage reporting")
@@ -221,6 +234,14 @@ func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logg
 		h.updateNginxConf(deployment, cfg)
 		deployment.FileLock.Unlock()
 
+		// The staged Deployment of a blue/green image upgrade applies the same configuration, so that it can
+		// take over the traffic of the data plane.
+		if staged := h.cfg.nginxDeployments.GetStaged(gw.DeploymentName); staged != nil {
+			staged.FileLock.Lock()
+			h.updateNginxConf(staged, cfg)
+			staged.FileLock.Unlock()
+		}
+
 		configErr := deployment.GetLatestConfigError()
 		upstreamErr := deployment.GetLatestUpstreamError()
 		err := errors.Join(configErr, upstreamErr)
@@ -234,6 +255,88 @@ func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logg
 	}
 }
 
+// updateStaticTokens updates the tokens that the agents of static data planes authenticate with,
+// so that only the agents of the Gateways that currently have a static data plane can connect.
+func (h *eventHandlerImpl) updateStaticTokens(gr *graph.Graph) {
+	if h.cfg.staticTokens == nil {
+		return
+	}
+
+	tokens := make(map[string]grpcContext.StaticDataPlane)
+	for gwNsName, gw := range gr.Gateways {
+		if !gw.Valid || gw.AgentTokenSecret == nil {
+			continue
+		}
+
+		secret, ok := gr.ReferencedSecrets[*gw.AgentTokenSecret]
+		if !ok || secret.Source == nil {
+			continue
+		}
+
+		tokens[string(secret.Source.Data[graph.AgentTokenKey])] = grpcContext.StaticDataPlane{
+			Gateway:    gwNsName,
+			Deployment: gw.DeploymentName,
+		}
+	}
+
+	h.cfg.staticTokens.Set(tokens)
+}
+
+// buildConfiguration builds the Configuration for the data plane of the Gateway, which includes the configuration
+// of the Gateways merged onto it.
+func (h *eventHandlerImpl) buildConfiguration(
+	ctx context.Context,
+	gr *graph.Graph,
+	gw *graph.Gateway,
+) dataplane.Configuration {
+	cfg := dataplane.BuildConfiguration(ctx, gr, gw, h.cfg.serviceResolver, h.cfg.plus)
+	if len(gw.MergedGateways) == 0 {
+		return cfg
+	}
+
+	mergedCfgs := make([]dataplane.Configuration, 0, len(gw.MergedGateways))
+	for _, mergedGw := range gw.MergedGateways {
+		graphGw, ok := gr.Gateways[client.ObjectKeyFromObject(mergedGw)]
+		if !ok || !graphGw.Valid {
+			continue
+		}
+
+		mergedCfgs = append(
+			mergedCfgs,
+			dataplane.BuildConfiguration(ctx, gr, graphGw, h.cfg.serviceResolver, h.cfg.plus),
+		)
+	}
+
+	return dataplane.MergeConfigurations(cfg, mergedCfgs...)
+}
+
+// gatewaysForDeployment returns the Gateways served by the nginx Deployment. A Deployment serves more than one
+// Gateway when Gateways are merged onto it. The Gateway that owns the Deployment is returned first.
+func gatewaysForDeployment(gr *graph.Graph, deployment types.NamespacedName) []*graph.Gateway {
+	if deployment.Name == "" {
+		return nil
+	}
+
+	var gws []*graph.Gateway
+	for _, gw := range gr.Gateways {
+		if gw.DeploymentName == deployment {
+			gws = append(gws, gw)
+		}
+	}
+
+	slices.SortFunc(gws, func(a, b *graph.Gateway) int {
+		if (a.MergedInto == nil) != (b.MergedInto == nil) {
+			if a.MergedInto == nil {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Source.Name, b.Source.Name)
+	})
+
+	return gws
+}
+
 func (h *eventHandlerImpl) waitForStatusUpdates(ctx context.Context) {
 	for {
 		item := h.cfg.statusQueue.Dequeue(ctx)
@@ -247,32 +350,31 @@ func (h *eventHandlerImpl) waitForStatusUpdates(ctx context.Context) {
 		}
 
 		var nginxReloadRes graph.NginxReloadResult
-		var gw *graph.Gateway
-		if item.Deployment.Name != "" {
-			gwNSName := types.NamespacedName{
-				Namespace: item.Deployment.Namespace,
-				Name:      strings.TrimSuffix(item.Deployment.Name, fmt.Sprintf("-%s", h.cfg.gatewayClassName)),
-			}
-
-			gw = gr.Gateways[gwNSName]
-		}
+		gws := gatewaysForDeployment(gr, item.Deployment)
 
 		switch {
 		case item.Error != nil:
 			h.cfg.logger.Error(item.Error, "Failed to update NGINX configuration")
 			nginxReloadRes.Error = item.Error
-		case gw != nil:
+		case len(gws) > 0:
 			h.cfg.logger.Info("NGINX configuration was successfully updated")
 		}
-		if gw != nil {
+		for _, gw := range gws {
 			gw.LatestReloadResult = nginxReloadRes
+
+			// the data plane of a merged Gateway is upgraded with the data plane of the Gateway it is merged into
+			dataPlaneGateway := client.ObjectKeyFromObject(gw.Source)
+			if gw.MergedInto != nil {
+				dataPlaneGateway = *gw.MergedInto
+			}
+			gw.LatestDataPlaneUpgrade = h.cfg.nginxProvisioner.LatestDataPlaneUpgrade(dataPlaneGateway)
 		}
 
 		switch item.UpdateType {
 		case status.UpdateAll:
-			h.updateStatuses(ctx, gr, gw)
+			h.updateStatuses(ctx, gr, gws)
 		case status.UpdateGateway:
-			if gw == nil {
+			if len(gws) == 0 {
 				continue
 			}
 
@@ -280,7 +382,7 @@ func (h *eventHandlerImpl) waitForStatusUpdates(ctx context.Context) {
 				ctx,
 				h.cfg.k8sClient,
 				item.GatewayService,
-				gw,
+				gws[0],
 				h.cfg.gatewayClassName,
 			)
 			if err != nil {
@@ -298,12 +400,15 @@ func (h *eventHandlerImpl) waitForStatusUpdates(ctx context.Context) {
 
 			transitionTime := metav1.Now()
 
-			gatewayStatuses := status.PrepareGatewayRequests(
-				gw,
-				transitionTime,
-				gwAddresses,
-				gw.LatestReloadResult,
-			)
+			var gatewayStatuses []status.UpdateRequest
+			for _, gw := range gws {
+				gatewayStatuses = append(gatewayStatuses, status.PrepareGatewayRequests(
+					gw,
+					transitionTime,
+					gwAddresses,
+					gw.LatestReloadResult,
+				)...)
+			}
 			h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gatewayStatuses...)
 		default:
 			panic(fmt.Sprintf("unknown event type %T", item.UpdateType))
@@ -311,15 +416,19 @@ func (h *eventHandlerImpl) waitForStatusUpdates(ctx context.Context) {
 	}
 }
 
-func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph, gw *graph.Gateway) {
+// updateStatuses updates the statuses of the resources of the graph. gws are the Gateways served by the nginx
+// Deployment that was updated, starting with the Gateway that owns the Deployment.
+func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph, gws []*graph.Gateway) {
 	transitionTime := metav1.Now()
 	gcReqs := status.PrepareGatewayClassRequests(gr.GatewayClass, gr.IgnoredGatewayClasses, transitionTime)
 
-	if gw == nil {
+	if len(gws) == 0 {
 		h.cfg.statusUpdater.UpdateGroup(ctx, groupAllExceptGateways, gcReqs...)
 		return
 	}
 
+	gw := gws[0]
+
 	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, nil, gw, h.cfg.gatewayClassName)
 	if err != nil {
 		msg := "error getting Gateway Service IP address"
@@ -348,28 +457,50 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph,
 		transitionTime,
 		h.cfg.gatewayCtlrName,
 	)
+	directResponseFilterReqs := status.PrepareDirectResponseFilterRequests(
+		gr.DirectResponseFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	grpcRewriteFilterReqs := status.PrepareGRPCRewriteFilterRequests(
+		gr.GRPCRewriteFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	grpcWebFilterReqs := status.PrepareGRPCWebFilterRequests(
+		gr.GRPCWebFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
 
 	reqs := make(
 		[]status.UpdateRequest,
 		0,
-		len(gcReqs)+len(routeReqs)+len(polReqs)+len(ngfPolReqs)+len(snippetsFilterReqs),
+		len(gcReqs)+len(routeReqs)+len(polReqs)+len(ngfPolReqs)+len(snippetsFilterReqs)+
+			len(directResponseFilterReqs)+len(grpcRewriteFilterReqs)+len(grpcWebFilterReqs),
 	)
 	reqs = append(reqs, gcReqs...)
 	reqs = append(reqs, routeReqs...)
 	reqs = append(reqs, polReqs...)
 	reqs = append(reqs, ngfPolReqs...)
 	reqs = append(reqs, snippetsFilterReqs...)
+	reqs = append(reqs, directResponseFilterReqs...)
+	reqs = append(reqs, grpcRewriteFilterReqs...)
+	reqs = append(reqs, grpcWebFilterReqs...)
 
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupAllExceptGateways, reqs...)
 
 	// We put Gateway status updates separately from the rest of the statuses because we want to be able
 	// to update them separately from the rest of the graph whenever the public IP of NGF changes.
-	gwReqs := status.PrepareGatewayRequests(
-		gw,
-		transitionTime,
-		gwAddresses,
-		gw.LatestReloadResult,
-	)
+	var gwReqs []status.UpdateRequest
+	for _, gw := range gws {
+		gwReqs = append(gwReqs, status.PrepareGatewayRequests(
+			gw,
+			transitionTime,
+			gwAddresses,
+			gw.LatestReloadResult,
+		)...)
+	}
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gwReqs...)
 }
 
@@ -464,13 +595,18 @@ func getGatewayAddresses(
 	gateway *graph.Gateway,
 	gatewayClassName string,
 ) ([]gatewayv1.GatewayStatusAddress, error) {
-	if gateway == nil {
+	// the NGINX instances of a static data plane are not exposed by a Service that NGINX Gateway Fabric knows about
+	if gateway == nil || graph.StaticDataPlaneEnabledForNginxProxy(gateway.EffectiveNginxProxy) {
 		return nil, nil
 	}
 
 	var gwSvc v1.Service
 	if svc == nil {
 		svcName := controller.CreateNginxResourceName(gateway.Source.GetName(), gatewayClassName)
+		// a merged Gateway is exposed by the Service of the Gateway that owns its data plane
+		if gateway.MergedInto != nil {
+			svcName = gateway.DeploymentName.Name
+		}
 		key := types.NamespacedName{Name: svcName, Namespace: gateway.Source.GetNamespace()}
 
 		pollCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
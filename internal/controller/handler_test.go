@@ -606,6 +606,42 @@ var _ = Describe("getGatewayAddresses", func() {
 	})
 })
 
+var _ = Describe("gatewaysForDeployment", func() {
+	It("returns the Gateways served by the Deployment, starting with the owner", func() {
+		deployment := types.NamespacedName{Namespace: "test", Name: "owner-nginx"}
+
+		createGateway := func(name string, mergedInto *types.NamespacedName, depName string) *graph.Gateway {
+			return &graph.Gateway{
+				Source: &gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+				},
+				MergedInto:     mergedInto,
+				DeploymentName: types.NamespacedName{Namespace: "test", Name: depName},
+			}
+		}
+
+		owner := createGateway("owner", nil, "owner-nginx")
+		ownerNsName := types.NamespacedName{Namespace: "test", Name: "owner"}
+		memberA := createGateway("a-member", &ownerNsName, "owner-nginx")
+		memberB := createGateway("b-member", &ownerNsName, "owner-nginx")
+		other := createGateway("other", nil, "other-nginx")
+
+		gr := &graph.Graph{
+			Gateways: map[types.NamespacedName]*graph.Gateway{
+				{Namespace: "test", Name: "owner"}:    owner,
+				{Namespace: "test", Name: "a-member"}: memberA,
+				{Namespace: "test", Name: "b-member"}: memberB,
+				{Namespace: "test", Name: "other"}:    other,
+			},
+		}
+
+		Expect(gatewaysForDeployment(gr, deployment)).To(Equal([]*graph.Gateway{owner, memberA, memberB}))
+		Expect(gatewaysForDeployment(gr, types.NamespacedName{Namespace: "test", Name: "other-nginx"})).
+			To(Equal([]*graph.Gateway{other}))
+		Expect(gatewaysForDeployment(gr, types.NamespacedName{})).To(BeEmpty())
+	})
+})
+
 var _ = Describe("getDeploymentContext", func() {
 	When("nginx plus is false", func() {
 		It("doesn't set the deployment context", func() {
@@ -12,8 +12,11 @@ import (
 	"google.golang.org/grpc"
 	appsv1 "k8s.io/api/apps/v1"
 	authv1 "k8s.io/api/authentication/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -46,8 +49,12 @@ import (
 	ngxcfg "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/clientsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/errorpage"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/observability"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/proxysettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/trafficsplit"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/upstreamsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/websocket"
 	ngxvalidation "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/validation"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/provisioner"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state"
@@ -91,6 +98,9 @@ func init() {
 	utilruntime.Must(ngfAPIv1alpha2.AddToScheme(scheme))
 	utilruntime.Must(apiext.AddToScheme(scheme))
 	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(autoscalingv2.AddToScheme(scheme))
+	utilruntime.Must(policyv1.AddToScheme(scheme))
+	utilruntime.Must(networkingv1.AddToScheme(scheme))
 	utilruntime.Must(authv1.AddToScheme(scheme))
 	utilruntime.Must(rbacv1.AddToScheme(scheme))
 }
@@ -193,6 +203,7 @@ func StartManager(cfg config.Config) error {
 		},
 		mgr.GetClient(),
 		tokenAudience,
+		nginxUpdater.StaticTokens,
 		resetConnChan,
 	)
 
@@ -215,6 +226,7 @@ func StartManager(cfg config.Config) error {
 			Plus:                   cfg.Plus,
 			NginxDockerSecretNames: cfg.NginxDockerSecretNames,
 			PlusUsageConfig:        &cfg.UsageReportConfig,
+			GRPCServerPort:         grpcServerPort,
 		},
 	)
 	if err != nil {
@@ -252,6 +264,7 @@ func StartManager(cfg config.Config) error {
 		plus:                    cfg.Plus,
 		statusQueue:             statusQueue,
 		nginxDeployments:        nginxUpdater.NginxDeployments,
+		staticTokens:            nginxUpdater.StaticTokens,
 	})
 
 	objects, objectLists := prepareFirstEventBatchPreparerArgs(cfg)
@@ -318,14 +331,30 @@ func createPolicyManager(
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ClientSettingsPolicy{}),
 			Validator: clientsettings.NewValidator(validator),
 		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ErrorPagePolicy{}),
+			Validator: errorpage.NewValidator(validator),
+		},
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha2.ObservabilityPolicy{}),
 			Validator: observability.NewValidator(validator),
 		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ProxySettingsPolicy{}),
+			Validator: proxysettings.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.TrafficSplitPolicy{}),
+			Validator: trafficsplit.NewValidator(),
+		},
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.UpstreamSettingsPolicy{}),
 			Validator: upstreamsettings.NewValidator(validator),
 		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.WebSocketPolicy{}),
+			Validator: websocket.NewValidator(validator),
+		},
 	}
 
 	return policies.NewManager(mustExtractGVK, cfgs...)
@@ -473,7 +502,12 @@ func registerControllers(
 			options: []controller.Option{
 				controller.WithOnlyMetadata(),
 				controller.WithK8sPredicate(
-					predicate.AnnotationPredicate{Annotation: graph.BundleVersionAnnotation},
+					k8spredicate.Or(
+						predicate.AnnotationPredicate{Annotation: graph.BundleVersionAnnotation},
+						k8spredicate.NewPredicateFuncs(func(obj client.Object) bool {
+							return obj.GetName() == graph.PodMonitorCRDName
+						}),
+					),
 				),
 			},
 		},
@@ -495,18 +529,65 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			// FIXME(ciarams87): If possible, use only metadata predicate
+			// https://github.com/nginx/nginx-gateway-fabric/issues/1545
+			objectType: &apiv1.ConfigMap{},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.DirectResponseFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.GRPCRewriteFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.GRPCWebFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.ErrorPagePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha2.ObservabilityPolicy{},
 			options: []controller.Option{
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.ProxySettingsPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.TrafficSplitPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha1.UpstreamSettingsPolicy{},
 			options: []controller.Option{
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.WebSocketPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 	}
 
 	if cfg.ExperimentalFeatures {
@@ -517,11 +598,6 @@ func registerControllers(
 					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 				},
 			},
-			{
-				// FIXME(ciarams87): If possible, use only metadata predicate
-				// https://github.com/nginx/nginx-gateway-fabric/issues/1545
-				objectType: &apiv1.ConfigMap{},
-			},
 			{
 				objectType: &gatewayv1alpha2.TLSRoute{},
 				options: []controller.Option{
@@ -737,14 +813,22 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 		&apiv1.ServiceList{},
 		&apiv1.SecretList{},
 		&apiv1.NamespaceList{},
+		&apiv1.ConfigMapList{},
 		&discoveryV1.EndpointSliceList{},
 		&gatewayv1.HTTPRouteList{},
 		&gatewayv1beta1.ReferenceGrantList{},
 		&ngfAPIv1alpha2.NginxProxyList{},
 		&gatewayv1.GRPCRouteList{},
 		&ngfAPIv1alpha1.ClientSettingsPolicyList{},
+		&ngfAPIv1alpha1.DirectResponseFilterList{},
+		&ngfAPIv1alpha1.ErrorPagePolicyList{},
+		&ngfAPIv1alpha1.GRPCRewriteFilterList{},
+		&ngfAPIv1alpha1.GRPCWebFilterList{},
 		&ngfAPIv1alpha2.ObservabilityPolicyList{},
+		&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+		&ngfAPIv1alpha1.TrafficSplitPolicyList{},
 		&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+		&ngfAPIv1alpha1.WebSocketPolicyList{},
 		partialObjectMetadataList,
 	}
 
@@ -752,7 +836,6 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 		objectLists = append(
 			objectLists,
 			&gatewayv1alpha3.BackendTLSPolicyList{},
-			&apiv1.ConfigMapList{},
 			&gatewayv1alpha2.TLSRouteList{},
 		)
 	}
@@ -58,6 +58,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
+				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1.GatewayList{},
@@ -66,8 +67,15 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&gatewayv1.GRPCRouteList{},
 				partialObjectMetadataList,
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
+				&ngfAPIv1alpha1.DirectResponseFilterList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.GRPCRewriteFilterList{},
+				&ngfAPIv1alpha1.GRPCWebFilterList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.TrafficSplitPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.WebSocketPolicyList{},
 			},
 		},
 		{
@@ -95,8 +103,15 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&gatewayv1alpha2.TLSRouteList{},
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
+				&ngfAPIv1alpha1.DirectResponseFilterList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.GRPCRewriteFilterList{},
+				&ngfAPIv1alpha1.GRPCWebFilterList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.TrafficSplitPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.WebSocketPolicyList{},
 			},
 		},
 		{
@@ -113,6 +128,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
 				&apiv1.NamespaceList{},
+				&apiv1.ConfigMapList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1.GatewayList{},
@@ -121,9 +137,16 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				partialObjectMetadataList,
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
+				&ngfAPIv1alpha1.DirectResponseFilterList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.GRPCRewriteFilterList{},
+				&ngfAPIv1alpha1.GRPCWebFilterList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.SnippetsFilterList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.TrafficSplitPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.WebSocketPolicyList{},
 			},
 		},
 		{
@@ -151,9 +174,16 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&gatewayv1alpha2.TLSRouteList{},
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
+				&ngfAPIv1alpha1.DirectResponseFilterList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.GRPCRewriteFilterList{},
+				&ngfAPIv1alpha1.GRPCWebFilterList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.SnippetsFilterList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.TrafficSplitPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.WebSocketPolicyList{},
 			},
 		},
 	}
@@ -38,6 +38,7 @@ type NginxUpdaterImpl struct {
 	CommandService   *commandService
 	FileService      *fileService
 	NginxDeployments *DeploymentStore
+	StaticTokens     *agentgrpc.StaticTokenStore
 	logger           logr.Logger
 	plus             bool
 	retryTimeout     time.Duration
@@ -68,6 +69,7 @@ func NewNginxUpdater(
 		logger:           logger,
 		plus:             plus,
 		NginxDeployments: nginxDeployments,
+		StaticTokens:     agentgrpc.NewStaticTokenStore(),
 		CommandService:   commandService,
 		FileService:      fileService,
 		retryTimeout:     retryUpstreamTimeout,
@@ -39,6 +39,25 @@ type FakeDeploymentStorer struct {
 	removeArgsForCall []struct {
 		arg1 types.NamespacedName
 	}
+	RemoveStagedStub        func(types.NamespacedName)
+	removeStagedMutex       sync.RWMutex
+	removeStagedArgsForCall []struct {
+		arg1 types.NamespacedName
+	}
+	StoreStagedStub        func(context.Context, types.NamespacedName, types.NamespacedName, chan struct{}) *agent.Deployment
+	storeStagedMutex       sync.RWMutex
+	storeStagedArgsForCall []struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+		arg3 types.NamespacedName
+		arg4 chan struct{}
+	}
+	storeStagedReturns struct {
+		result1 *agent.Deployment
+	}
+	storeStagedReturnsOnCall map[int]struct {
+		result1 *agent.Deployment
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -199,6 +218,102 @@ func (fake *FakeDeploymentStorer) RemoveArgsForCall(i int) types.NamespacedName
 	return argsForCall.arg1
 }
 
+func (fake *FakeDeploymentStorer) RemoveStaged(arg1 types.NamespacedName) {
+	fake.removeStagedMutex.Lock()
+	fake.removeStagedArgsForCall = append(fake.removeStagedArgsForCall, struct {
+		arg1 types.NamespacedName
+	}{arg1})
+	stub := fake.RemoveStagedStub
+	fake.recordInvocation("RemoveStaged", []interface{}{arg1})
+	fake.removeStagedMutex.Unlock()
+	if stub != nil {
+		fake.RemoveStagedStub(arg1)
+	}
+}
+
+func (fake *FakeDeploymentStorer) RemoveStagedCallCount() int {
+	fake.removeStagedMutex.RLock()
+	defer fake.removeStagedMutex.RUnlock()
+	return len(fake.removeStagedArgsForCall)
+}
+
+func (fake *FakeDeploymentStorer) RemoveStagedCalls(stub func(types.NamespacedName)) {
+	fake.removeStagedMutex.Lock()
+	defer fake.removeStagedMutex.Unlock()
+	fake.RemoveStagedStub = stub
+}
+
+func (fake *FakeDeploymentStorer) RemoveStagedArgsForCall(i int) types.NamespacedName {
+	fake.removeStagedMutex.RLock()
+	defer fake.removeStagedMutex.RUnlock()
+	argsForCall := fake.removeStagedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDeploymentStorer) StoreStaged(arg1 context.Context, arg2 types.NamespacedName, arg3 types.NamespacedName, arg4 chan struct{}) *agent.Deployment {
+	fake.storeStagedMutex.Lock()
+	ret, specificReturn := fake.storeStagedReturnsOnCall[len(fake.storeStagedArgsForCall)]
+	fake.storeStagedArgsForCall = append(fake.storeStagedArgsForCall, struct {
+		arg1 context.Context
+		arg2 types.NamespacedName
+		arg3 types.NamespacedName
+		arg4 chan struct{}
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StoreStagedStub
+	fakeReturns := fake.storeStagedReturns
+	fake.recordInvocation("StoreStaged", []interface{}{arg1, arg2, arg3, arg4})
+	fake.storeStagedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeploymentStorer) StoreStagedCallCount() int {
+	fake.storeStagedMutex.RLock()
+	defer fake.storeStagedMutex.RUnlock()
+	return len(fake.storeStagedArgsForCall)
+}
+
+func (fake *FakeDeploymentStorer) StoreStagedCalls(stub func(context.Context, types.NamespacedName, types.NamespacedName, chan struct{}) *agent.Deployment) {
+	fake.storeStagedMutex.Lock()
+	defer fake.storeStagedMutex.Unlock()
+	fake.StoreStagedStub = stub
+}
+
+func (fake *FakeDeploymentStorer) StoreStagedArgsForCall(i int) (context.Context, types.NamespacedName, types.NamespacedName, chan struct{}) {
+	fake.storeStagedMutex.RLock()
+	defer fake.storeStagedMutex.RUnlock()
+	argsForCall := fake.storeStagedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeploymentStorer) StoreStagedReturns(result1 *agent.Deployment) {
+	fake.storeStagedMutex.Lock()
+	defer fake.storeStagedMutex.Unlock()
+	fake.StoreStagedStub = nil
+	fake.storeStagedReturns = struct {
+		result1 *agent.Deployment
+	}{result1}
+}
+
+func (fake *FakeDeploymentStorer) StoreStagedReturnsOnCall(i int, result1 *agent.Deployment) {
+	fake.storeStagedMutex.Lock()
+	defer fake.storeStagedMutex.Unlock()
+	fake.StoreStagedStub = nil
+	if fake.storeStagedReturnsOnCall == nil {
+		fake.storeStagedReturnsOnCall = make(map[int]struct {
+			result1 *agent.Deployment
+		})
+	}
+	fake.storeStagedReturnsOnCall[i] = struct {
+		result1 *agent.Deployment
+	}{result1}
+}
+
 func (fake *FakeDeploymentStorer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -208,6 +323,10 @@ func (fake *FakeDeploymentStorer) Invocations() map[string][][]interface{} {
 	defer fake.getOrStoreMutex.RUnlock()
 	fake.removeMutex.RLock()
 	defer fake.removeMutex.RUnlock()
+	fake.removeStagedMutex.RLock()
+	defer fake.removeStagedMutex.RUnlock()
+	fake.storeStagedMutex.RLock()
+	defer fake.storeStagedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -29,7 +29,13 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/status"
 )
 
-const connectionWaitTimeout = 30 * time.Second
+const (
+	connectionWaitTimeout = 30 * time.Second
+
+	// agentGatewayLabel is the label of the agent configuration that the agents of static data planes report
+	// the Gateway they serve with, in the format "namespace/name".
+	agentGatewayLabel = "gateway"
+)
 
 // commandService handles the connection and subscription to the data plane agent.
 type commandService struct {
@@ -83,10 +89,10 @@ func (cs *commandService) CreateConnection(
 	}
 
 	resource := req.GetResource()
-	podName := resource.GetContainerInfo().GetHostname()
+	podName := getHostname(resource)
 	cs.logger.Info(fmt.Sprintf("Creating connection for nginx pod: %s", podName))
 
-	owner, err := cs.getPodOwner(podName)
+	owner, err := cs.getPodOwner(gi, resource)
 	if err != nil {
 		response := &pb.CreateConnectionResponse{
 			Response: &pb.CommandResponse{
@@ -421,7 +427,26 @@ func buildPlusAPIRequest(action *pb.NGINXPlusAction, instanceID string) *pb.Mana
 	}
 }
 
-func (cs *commandService) getPodOwner(podName string) (types.NamespacedName, error) {
+// getHostname returns the hostname of the agent. Agents running in containers report their Pod name as the
+// hostname, while the agents of static data planes could also run directly on a host, such as a virtual machine.
+func getHostname(resource *pb.Resource) string {
+	if hostname := resource.GetContainerInfo().GetHostname(); hostname != "" {
+		return hostname
+	}
+
+	return resource.GetHostInfo().GetHostname()
+}
+
+// getPodOwner returns the nginx Deployment or DaemonSet that the agent belongs to. For an agent of a static data plane,
+// the owner is resolved from the metadata supplied by the agent, since the agent doesn't run in a Pod provisioned
+// by the control plane. Otherwise, the owner is resolved from the owner references of the agent's Pod.
+func (cs *commandService) getPodOwner(gi grpcContext.GrpcInfo, resource *pb.Resource) (types.NamespacedName, error) {
+	if gi.StaticDataPlane != nil {
+		return getStaticDataPlaneOwner(*gi.StaticDataPlane, resource)
+	}
+
+	podName := getHostname(resource)
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -487,6 +512,45 @@ func (cs *commandService) getPodOwner(podName string) (types.NamespacedName, err
 	return types.NamespacedName{Namespace: pod.Namespace, Name: replicaOwnerRefs[0].Name}, nil
 }
 
+// getStaticDataPlaneOwner returns the nginx Deployment of the Gateway that the agent of a static data plane reports
+// in its labels. The Gateway must be the one that the agent's token is tied to.
+func getStaticDataPlaneOwner(
+	dataPlane grpcContext.StaticDataPlane,
+	resource *pb.Resource,
+) (types.NamespacedName, error) {
+	gateway, ok := getAgentLabel(resource.GetInstances(), agentGatewayLabel)
+	if !ok {
+		return types.NamespacedName{}, fmt.Errorf("agent labels do not contain the %q label", agentGatewayLabel)
+	}
+
+	if gateway != dataPlane.Gateway.String() {
+		return types.NamespacedName{}, fmt.Errorf(
+			"agent reported Gateway %q, but its token belongs to Gateway %q",
+			gateway,
+			dataPlane.Gateway.String(),
+		)
+	}
+
+	return dataPlane.Deployment, nil
+}
+
+// getAgentLabel returns the value of the label from the configuration of the agent instance.
+func getAgentLabel(instances []*pb.Instance, key string) (string, bool) {
+	for _, instance := range instances {
+		if instance.GetInstanceMeta().GetInstanceType() != pb.InstanceMeta_INSTANCE_TYPE_AGENT {
+			continue
+		}
+
+		for _, labels := range instance.GetInstanceConfig().GetAgentConfig().GetLabels() {
+			if value, ok := labels.GetFields()[key]; ok {
+				return value.GetStringValue(), true
+			}
+		}
+	}
+
+	return "", false
+}
+
 // UpdateDataPlaneStatus is called by agent on startup and upon any change in agent metadata,
 // instance metadata, or configurations. InstanceID may not be set on an initial CreateConnection,
 // and will instead be set on a call to UpdateDataPlaneStatus once the agent discovers its nginx instance.
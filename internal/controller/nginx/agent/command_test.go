@@ -11,6 +11,7 @@ import (
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -869,7 +870,15 @@ func TestGetPodOwner(t *testing.T) {
 				nil,
 			)
 
-			owner, err := cs.getPodOwner(test.podName)
+			resource := &pb.Resource{
+				Info: &pb.Resource_ContainerInfo{
+					ContainerInfo: &pb.ContainerInfo{
+						Hostname: test.podName,
+					},
+				},
+			}
+
+			owner, err := cs.getPodOwner(grpcContext.GrpcInfo{}, resource)
 
 			if test.errString != "" {
 				g.Expect(err).To(HaveOccurred())
@@ -884,6 +893,105 @@ func TestGetPodOwner(t *testing.T) {
 	}
 }
 
+func TestGetPodOwner_StaticDataPlane(t *testing.T) {
+	t.Parallel()
+
+	dataPlane := grpcContext.StaticDataPlane{
+		Gateway:    types.NamespacedName{Namespace: "test", Name: "gateway"},
+		Deployment: types.NamespacedName{Namespace: "test", Name: "gateway-nginx"},
+	}
+
+	createResource := func(labels map[string]any) *pb.Resource {
+		resource := &pb.Resource{
+			Info: &pb.Resource_HostInfo{
+				HostInfo: &pb.HostInfo{
+					Hostname: "nginx-vm",
+				},
+			},
+			Instances: []*pb.Instance{
+				{
+					InstanceMeta: &pb.InstanceMeta{
+						InstanceType: pb.InstanceMeta_INSTANCE_TYPE_NGINX,
+					},
+				},
+			},
+		}
+
+		if labels != nil {
+			agentLabels, err := structpb.NewStruct(labels)
+			if err != nil {
+				panic(err)
+			}
+
+			resource.Instances = append(resource.Instances, &pb.Instance{
+				InstanceMeta: &pb.InstanceMeta{
+					InstanceType: pb.InstanceMeta_INSTANCE_TYPE_AGENT,
+				},
+				InstanceConfig: &pb.InstanceConfig{
+					Config: &pb.InstanceConfig_AgentConfig{
+						AgentConfig: &pb.AgentConfig{
+							Labels: []*structpb.Struct{agentLabels},
+						},
+					},
+				},
+			})
+		}
+
+		return resource
+	}
+
+	tests := []struct {
+		resource  *pb.Resource
+		name      string
+		errString string
+		expected  types.NamespacedName
+	}{
+		{
+			name:     "gets owner from agent labels",
+			resource: createResource(map[string]any{"gateway": "test/gateway", "env": "prod"}),
+			expected: dataPlane.Deployment,
+		},
+		{
+			name:      "agent doesn't report labels",
+			resource:  createResource(nil),
+			errString: `agent labels do not contain the "gateway" label`,
+		},
+		{
+			name:      "agent reports a different gateway",
+			resource:  createResource(map[string]any{"gateway": "test/other"}),
+			errString: `agent reported Gateway "test/other", but its token belongs to Gateway "test/gateway"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			// the owner is resolved without looking up any Pods
+			cs := newCommandService(
+				logr.Discard(),
+				fake.NewFakeClient(),
+				NewDeploymentStore(nil),
+				nil,
+				status.NewQueue(),
+				nil,
+			)
+
+			owner, err := cs.getPodOwner(grpcContext.GrpcInfo{StaticDataPlane: &dataPlane}, test.resource)
+
+			if test.errString != "" {
+				g.Expect(err).To(MatchError(test.errString))
+				g.Expect(owner).To(Equal(types.NamespacedName{}))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(owner).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestUpdateDataPlaneStatus(t *testing.T) {
 	t.Parallel()
 
@@ -139,6 +139,14 @@ func (d *Deployment) GetConfigurationStatus() error {
 	return errors.Join(errs...)
 }
 
+// GetPodCount returns the number of Pods in the Deployment that have attempted to apply the configuration.
+func (d *Deployment) GetPodCount() int {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	return len(d.podStatuses)
+}
+
 /*
 The following functions for the Deployment object are UNLOCKED, meaning that they are unsafe.
 Callers of these functions MUST ensure the FileLock is set before calling.
@@ -223,6 +231,8 @@ func (d *Deployment) SetNGINXPlusActions(actions []*pb.NGINXPlusAction) {
 type DeploymentStorer interface {
 	Get(types.NamespacedName) *Deployment
 	GetOrStore(context.Context, types.NamespacedName, chan struct{}) *Deployment
+	StoreStaged(context.Context, types.NamespacedName, types.NamespacedName, chan struct{}) *Deployment
+	RemoveStaged(types.NamespacedName)
 	Remove(types.NamespacedName)
 }
 
@@ -230,6 +240,9 @@ type DeploymentStorer interface {
 type DeploymentStore struct {
 	connTracker agentgrpc.ConnectionsTracker
 	deployments sync.Map
+	// stagedDeployments maps the name of a Deployment to the name of the Deployment that stages
+	// a blue/green image upgrade of it.
+	stagedDeployments sync.Map
 }
 
 // NewDeploymentStore returns a new instance of a DeploymentStore.
@@ -283,7 +296,61 @@ func (d *DeploymentStore) StoreWithBroadcaster(
 	return deployment
 }
 
-// Remove the deployment from the store.
+// StoreStaged creates and stores the Deployment that stages a blue/green image upgrade of the primary Deployment.
+// The staged Deployment starts with the current configuration of the primary Deployment, so that its Pods
+// apply the same configuration when they connect.
+func (d *DeploymentStore) StoreStaged(
+	ctx context.Context,
+	primary types.NamespacedName,
+	staged types.NamespacedName,
+	stopCh chan struct{},
+) *Deployment {
+	deployment := newDeployment(broadcast.NewDeploymentBroadcaster(ctx, stopCh))
+
+	// The staged Deployment is stored while the configuration of the primary Deployment is locked, so that
+	// configuration updates of the primary Deployment are either copied here or also sent to the staged Deployment.
+	if primaryDeployment := d.Get(primary); primaryDeployment != nil {
+		primaryDeployment.FileLock.RLock()
+		defer primaryDeployment.FileLock.RUnlock()
+
+		deployment.SetFiles(primaryDeployment.files)
+		deployment.SetNGINXPlusActions(primaryDeployment.nginxPlusActions)
+	}
+
+	d.deployments.Store(staged, deployment)
+	d.stagedDeployments.Store(primary, staged)
+
+	return deployment
+}
+
+// GetStaged returns the Deployment that stages a blue/green image upgrade of the primary Deployment,
+// or nil if no upgrade is in progress.
+func (d *DeploymentStore) GetStaged(primary types.NamespacedName) *Deployment {
+	val, ok := d.stagedDeployments.Load(primary)
+	if !ok {
+		return nil
+	}
+
+	staged, ok := val.(types.NamespacedName)
+	if !ok {
+		panic(fmt.Sprintf("expected NamespacedName, got type %T", val))
+	}
+
+	return d.Get(staged)
+}
+
+// RemoveStaged removes the Deployment that stages a blue/green image upgrade of the primary Deployment.
+func (d *DeploymentStore) RemoveStaged(primary types.NamespacedName) {
+	val, ok := d.stagedDeployments.LoadAndDelete(primary)
+	if !ok {
+		return
+	}
+
+	d.deployments.Delete(val)
+}
+
+// Remove the deployment, and the Deployment that stages an upgrade of it, from the store.
 func (d *DeploymentStore) Remove(nsName types.NamespacedName) {
+	d.RemoveStaged(nsName)
 	d.deployments.Delete(nsName)
 }
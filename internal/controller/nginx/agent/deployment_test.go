@@ -99,8 +99,11 @@ func TestSetPodErrorStatus(t *testing.T) {
 	g.Expect(deployment.GetConfigurationStatus()).To(MatchError(ContainSubstring("test error")))
 	g.Expect(deployment.GetConfigurationStatus()).To(MatchError(ContainSubstring("test error 2")))
 
+	g.Expect(deployment.GetPodCount()).To(Equal(2))
+
 	deployment.RemovePodStatus("test-pod")
 	g.Expect(deployment.podStatuses).ToNot(HaveKey("test-pod"))
+	g.Expect(deployment.GetPodCount()).To(Equal(1))
 }
 
 func TestSetLatestConfigError(t *testing.T) {
@@ -145,3 +148,56 @@ func TestDeploymentStore(t *testing.T) {
 	store.Remove(nsName)
 	g.Expect(store.Get(nsName)).To(BeNil())
 }
+
+func TestDeploymentStore_Staged(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := NewDeploymentStore(&agentgrpcfakes.FakeConnectionsTracker{})
+
+	primaryNSName := types.NamespacedName{Namespace: "default", Name: "test-deployment"}
+	stagedNSName := types.NamespacedName{Namespace: "default", Name: "test-deployment-staged"}
+
+	g.Expect(store.GetStaged(primaryNSName)).To(BeNil())
+
+	primary := store.GetOrStore(context.Background(), primaryNSName, nil)
+	files := []File{
+		{
+			Meta: &pb.FileMeta{
+				Name: "test.conf",
+				Hash: "12345",
+			},
+			Contents: []byte("test content"),
+		},
+	}
+	actions := []*pb.NGINXPlusAction{{Action: &pb.NGINXPlusAction_UpdateHttpUpstreamServers{}}}
+
+	primary.FileLock.Lock()
+	primary.SetFiles(files)
+	primary.SetNGINXPlusActions(actions)
+	primary.FileLock.Unlock()
+
+	staged := store.StoreStaged(context.Background(), primaryNSName, stagedNSName, nil)
+	g.Expect(staged).ToNot(BeNil())
+	g.Expect(store.Get(stagedNSName)).To(Equal(staged))
+	g.Expect(store.GetStaged(primaryNSName)).To(Equal(staged))
+
+	// the staged Deployment starts with the configuration of the primary Deployment
+	primaryOverviews, primaryVersion := primary.GetFileOverviews()
+	stagedOverviews, stagedVersion := staged.GetFileOverviews()
+	g.Expect(stagedOverviews).To(Equal(primaryOverviews))
+	g.Expect(stagedVersion).To(Equal(primaryVersion))
+	g.Expect(staged.GetFile("test.conf", "12345")).To(Equal([]byte("test content")))
+	g.Expect(staged.GetNGINXPlusActions()).To(Equal(actions))
+
+	store.RemoveStaged(primaryNSName)
+	g.Expect(store.GetStaged(primaryNSName)).To(BeNil())
+	g.Expect(store.Get(stagedNSName)).To(BeNil())
+	g.Expect(store.Get(primaryNSName)).To(Equal(primary))
+
+	// removing the primary Deployment also removes its staged Deployment
+	store.StoreStaged(context.Background(), primaryNSName, stagedNSName, nil)
+	store.Remove(primaryNSName)
+	g.Expect(store.Get(primaryNSName)).To(BeNil())
+	g.Expect(store.Get(stagedNSName)).To(BeNil())
+}
@@ -2,12 +2,25 @@ package context
 
 import (
 	"context"
+
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // GrpcInfo for storing identity information for the gRPC client.
 type GrpcInfo struct {
-	Token     string `json:"token"`      // auth token that was provided by the gRPC client
-	IPAddress string `json:"ip_address"` // ip address of the agent
+	// StaticDataPlane is set if the gRPC client is the agent of a static data plane.
+	StaticDataPlane *StaticDataPlane `json:"static_data_plane,omitempty"`
+	Token           string           `json:"token"`      // auth token that was provided by the gRPC client
+	IPAddress       string           `json:"ip_address"` // ip address of the agent
+}
+
+// StaticDataPlane identifies the Gateway that the token of a static data plane is tied to.
+// A static data plane consists of NGINX instances that are not provisioned by the control plane.
+type StaticDataPlane struct {
+	// Gateway is the Gateway served by the static data plane.
+	Gateway types.NamespacedName `json:"gateway"`
+	// Deployment is the nginx Deployment of the Gateway that the agents of the static data plane belong to.
+	Deployment types.NamespacedName `json:"deployment"`
 }
 
 type contextGRPCKey struct{}
@@ -62,13 +62,14 @@ func NewServer(
 	registerSvcs []func(*grpc.Server),
 	k8sClient client.Client,
 	tokenAudience string,
+	staticTokens *StaticTokenStore,
 	resetConnChan chan<- struct{},
 ) *Server {
 	return &Server{
 		logger:           logger,
 		port:             port,
 		registerServices: registerSvcs,
-		interceptor:      interceptor.NewContextSetter(k8sClient, tokenAudience),
+		interceptor:      interceptor.NewContextSetter(k8sClient, tokenAudience, staticTokens),
 		resetConnChan:    resetConnChan,
 	}
 }
@@ -37,15 +37,22 @@ func (sh *streamHandler) Context() context.Context {
 	return sh.ctx
 }
 
+// StaticTokenGetter returns the static data plane that a token is tied to.
+type StaticTokenGetter interface {
+	GetStaticDataPlane(token string) (grpcContext.StaticDataPlane, bool)
+}
+
 type ContextSetter struct {
-	k8sClient client.Client
-	audience  string
+	k8sClient    client.Client
+	staticTokens StaticTokenGetter
+	audience     string
 }
 
-func NewContextSetter(k8sClient client.Client, audience string) ContextSetter {
+func NewContextSetter(k8sClient client.Client, audience string, staticTokens StaticTokenGetter) ContextSetter {
 	return ContextSetter{
-		k8sClient: k8sClient,
-		audience:  audience,
+		k8sClient:    k8sClient,
+		audience:     audience,
+		staticTokens: staticTokens,
 	}
 }
 
@@ -91,6 +98,15 @@ func (c ContextSetter) validateConnection(ctx context.Context) (context.Context,
 		return nil, err
 	}
 
+	// The agents of static data planes don't run in provisioned Pods, so they authenticate with the token of
+	// their Gateway instead of a ServiceAccount token.
+	if c.staticTokens != nil {
+		if dataPlane, ok := c.staticTokens.GetStaticDataPlane(gi.Token); ok {
+			gi.StaticDataPlane = &dataPlane
+			return grpcContext.NewGrpcContext(ctx, *gi), nil
+		}
+	}
+
 	return c.validateToken(ctx, gi)
 }
 
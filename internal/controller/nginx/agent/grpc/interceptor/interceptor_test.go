@@ -16,8 +16,10 @@ import (
 	authv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	grpcContext "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/grpc/context"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
 )
 
@@ -259,7 +261,7 @@ func TestInterceptor(t *testing.T) {
 				appName:       test.appName,
 				podNamespace:  test.podNamespace,
 			}
-			cs := NewContextSetter(mockK8sClient, "ngf-audience")
+			cs := NewContextSetter(mockK8sClient, "ngf-audience", nil)
 
 			ctx := context.Background()
 			if test.md != nil {
@@ -290,3 +292,76 @@ func TestInterceptor(t *testing.T) {
 		})
 	}
 }
+
+type mockStaticTokens struct {
+	tokens map[string]grpcContext.StaticDataPlane
+}
+
+func (m *mockStaticTokens) GetStaticDataPlane(token string) (grpcContext.StaticDataPlane, bool) {
+	dataPlane, ok := m.tokens[token]
+	return dataPlane, ok
+}
+
+func TestInterceptor_StaticDataPlane(t *testing.T) {
+	t.Parallel()
+
+	dataPlane := grpcContext.StaticDataPlane{
+		Gateway:    types.NamespacedName{Namespace: "default", Name: "gateway"},
+		Deployment: types.NamespacedName{Namespace: "default", Name: "gateway-nginx"},
+	}
+	staticTokens := &mockStaticTokens{
+		tokens: map[string]grpcContext.StaticDataPlane{"static-token": dataPlane},
+	}
+
+	tests := []struct {
+		expDataPlane *grpcContext.StaticDataPlane
+		name         string
+		token        string
+		expErrCode   codes.Code
+	}{
+		{
+			name:         "static token",
+			token:        "static-token",
+			expDataPlane: &dataPlane,
+			expErrCode:   codes.OK,
+		},
+		{
+			name:       "unknown token falls back to TokenReview",
+			token:      "other-token",
+			expErrCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			md := metadata.New(map[string]string{
+				headerUUID: "test-uuid",
+				headerAuth: test.token,
+			})
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1")}})
+
+			// the TokenReview is not authenticated, so only static tokens are accepted
+			cs := NewContextSetter(&mockClient{}, "ngf-audience", staticTokens)
+
+			var gi grpcContext.GrpcInfo
+			handler := func(ctx context.Context, _ any) (any, error) {
+				var ok bool
+				gi, ok = grpcContext.GrpcInfoFromContext(ctx)
+				g.Expect(ok).To(BeTrue())
+				return nil, nil //nolint:nilnil // unit test
+			}
+
+			_, err := cs.Unary(logr.Discard())(ctx, nil, nil, handler)
+			g.Expect(status.Code(err)).To(Equal(test.expErrCode))
+
+			if test.expDataPlane != nil {
+				g.Expect(gi.StaticDataPlane).To(Equal(test.expDataPlane))
+				g.Expect(gi.Token).To(Equal(test.token))
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"crypto/subtle"
+	"sync"
+
+	grpcContext "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/grpc/context"
+)
+
+// StaticTokenStore holds the tokens that the agents of static data planes authenticate with.
+// A static data plane consists of NGINX instances that are not provisioned by the control plane,
+// so their agents can't authenticate with the token of a ServiceAccount of a provisioned Pod.
+type StaticTokenStore struct {
+	// tokens maps a token to the static data plane it is tied to.
+	tokens map[string]grpcContext.StaticDataPlane
+
+	lock sync.RWMutex
+}
+
+// NewStaticTokenStore returns a new StaticTokenStore instance.
+func NewStaticTokenStore() *StaticTokenStore {
+	return &StaticTokenStore{
+		tokens: make(map[string]grpcContext.StaticDataPlane),
+	}
+}
+
+// Set replaces the tokens in the store.
+func (s *StaticTokenStore) Set(tokens map[string]grpcContext.StaticDataPlane) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.tokens = tokens
+}
+
+// GetStaticDataPlane returns the static data plane that the token is tied to.
+// Returns false if the token doesn't belong to any static data plane.
+func (s *StaticTokenStore) GetStaticDataPlane(token string) (grpcContext.StaticDataPlane, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	// the tokens are compared in constant time to not leak them through timing
+	for t, dataPlane := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return dataPlane, true
+		}
+	}
+
+	return grpcContext.StaticDataPlane{}, false
+}
@@ -0,0 +1,56 @@
+package grpc_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/grpc"
+	grpcContext "github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/grpc/context"
+)
+
+func TestStaticTokenStore(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := agentgrpc.NewStaticTokenStore()
+
+	_, ok := store.GetStaticDataPlane("token1")
+	g.Expect(ok).To(BeFalse())
+
+	dataPlane1 := grpcContext.StaticDataPlane{
+		Gateway:    types.NamespacedName{Namespace: "default", Name: "gateway1"},
+		Deployment: types.NamespacedName{Namespace: "default", Name: "gateway1-nginx"},
+	}
+	dataPlane2 := grpcContext.StaticDataPlane{
+		Gateway:    types.NamespacedName{Namespace: "default", Name: "gateway2"},
+		Deployment: types.NamespacedName{Namespace: "default", Name: "gateway2-nginx"},
+	}
+
+	store.Set(map[string]grpcContext.StaticDataPlane{
+		"token1": dataPlane1,
+		"token2": dataPlane2,
+	})
+
+	dataPlane, ok := store.GetStaticDataPlane("token1")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(dataPlane).To(Equal(dataPlane1))
+
+	dataPlane, ok = store.GetStaticDataPlane("token2")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(dataPlane).To(Equal(dataPlane2))
+
+	_, ok = store.GetStaticDataPlane("token")
+	g.Expect(ok).To(BeFalse())
+
+	// the tokens are replaced, not merged
+	store.Set(map[string]grpcContext.StaticDataPlane{"token2": dataPlane2})
+
+	_, ok = store.GetStaticDataPlane("token1")
+	g.Expect(ok).To(BeFalse())
+
+	dataPlane, ok = store.GetStaticDataPlane("token2")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(dataPlane).To(Equal(dataPlane2))
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"slices"
+	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/shared"
@@ -11,16 +13,49 @@ import (
 var baseHTTPTemplate = gotemplate.Must(gotemplate.New("baseHttp").Parse(baseHTTPTemplateText))
 
 type httpConfig struct {
-	Includes []shared.Include
-	HTTP2    bool
+	// SSLSessionCache is the value of the ssl_session_cache directive.
+	SSLSessionCache string
+	// SSLSessionTimeout is the value of the ssl_session_timeout directive.
+	SSLSessionTimeout string
+	// SSLSessionTickets is the value of the ssl_session_tickets directive.
+	SSLSessionTickets string
+	// Resolver is the value of the resolver directive.
+	Resolver string
+	// ResolverTimeout is the value of the resolver_timeout directive.
+	ResolverTimeout string
+	Includes        []shared.Include
+	// SSLSessionTicketKeys are the files with the session ticket keys. The first key encrypts session tickets.
+	SSLSessionTicketKeys []string
+	HTTP2                bool
 }
 
 func executeBaseHTTPConfig(conf dataplane.Configuration) []executeResult {
 	includes := createIncludesFromSnippets(conf.BaseHTTPConfig.Snippets)
 
+	tlsSettings := conf.BaseHTTPConfig.TLSSettings
+
 	hc := httpConfig{
-		HTTP2:    conf.BaseHTTPConfig.HTTP2,
-		Includes: includes,
+		HTTP2:             conf.BaseHTTPConfig.HTTP2,
+		Includes:          includes,
+		SSLSessionCache:   tlsSettings.SessionCache,
+		SSLSessionTimeout: tlsSettings.SessionTimeout,
+	}
+
+	if resolver := tlsSettings.OCSPResolver; resolver != nil {
+		params := resolver.Addresses
+		if resolver.Valid != "" {
+			params = append(slices.Clone(params), "valid="+resolver.Valid)
+		}
+		hc.Resolver = strings.Join(params, " ")
+		hc.ResolverTimeout = resolver.Timeout
+	}
+
+	if tlsSettings.SessionTicketsDisabled {
+		hc.SSLSessionTickets = "off"
+	}
+
+	for _, key := range tlsSettings.SessionTicketKeys {
+		hc.SSLSessionTicketKeys = append(hc.SSLSessionTicketKeys, generateSessionTicketKeyFileName(key.ID))
 	}
 
 	results := make([]executeResult, 0, len(includes)+1)
@@ -2,6 +2,24 @@ package config
 
 const baseHTTPTemplateText = `
 {{- if .HTTP2 }}http2 on;{{ end }}
+{{- if .SSLSessionCache }}
+ssl_session_cache {{ .SSLSessionCache }};
+{{- end }}
+{{- if .SSLSessionTimeout }}
+ssl_session_timeout {{ .SSLSessionTimeout }};
+{{- end }}
+{{- if .SSLSessionTickets }}
+ssl_session_tickets {{ .SSLSessionTickets }};
+{{- end }}
+{{- range $key := .SSLSessionTicketKeys }}
+ssl_session_ticket_key {{ $key }};
+{{- end }}
+{{- if .Resolver }}
+resolver {{ .Resolver }};
+{{- end }}
+{{- if .ResolverTimeout }}
+resolver_timeout {{ .ResolverTimeout }};
+{{- end }}
 
 # Set $gw_api_compliant_host variable to the value of $http_host unless $http_host is empty, then set it to the value
 # of $host. We prefer $http_host because it contains the original value of the host header, which is required by the
@@ -19,6 +37,21 @@ map $http_upgrade $connection_upgrade {
     '' close;
 }
 
+# Set $connection_upgrade_keepalive variable to upgrade when the $http_upgrade header is set, otherwise, set it to an
+# empty value. This allows support for websocket connections to upstreams with keep-alive connections enabled.
+map $http_upgrade $connection_upgrade_keepalive {
+    default upgrade;
+    '' '';
+}
+
+# Set $grpc_upstream_content_type variable to the gRPC content type of a gRPC-Web request, keeping its message
+# encoding, for example, application/grpc-web+json becomes application/grpc+json. Other content types, such as the
+# content type of native gRPC requests, are passed unchanged.
+map $http_content_type $grpc_upstream_content_type {
+    "~*^application/grpc-web(\+.*)?$" application/grpc$1;
+    default $http_content_type;
+}
+
 ## Returns just the path from the original request URI.
 map $request_uri $request_uri_path {
   "~^(?P<path>[^?]*)(\?.*)?$"  $path;
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"regexp"
 	"sort"
 	"strings"
 	"testing"
@@ -97,6 +98,7 @@ func TestExecuteBaseHttp_Snippets(t *testing.T) {
 	httpRes := string(res[0].data)
 	g.Expect(httpRes).To(ContainSubstring("map $http_host $gw_api_compliant_host {"))
 	g.Expect(httpRes).To(ContainSubstring("map $http_upgrade $connection_upgrade {"))
+	g.Expect(httpRes).To(ContainSubstring("map $http_upgrade $connection_upgrade_keepalive {"))
 	g.Expect(httpRes).To(ContainSubstring("map $request_uri $request_uri_path {"))
 	g.Expect(httpRes).To(ContainSubstring("include /etc/nginx/includes/snippet1.conf;"))
 	g.Expect(httpRes).To(ContainSubstring("include /etc/nginx/includes/snippet2.conf;"))
@@ -107,3 +109,132 @@ func TestExecuteBaseHttp_Snippets(t *testing.T) {
 	snippet2IncludeRes := string(res[2].data)
 	g.Expect(snippet2IncludeRes).To(ContainSubstring("contents2"))
 }
+
+func TestExecuteBaseHttp_GRPCUpstreamContentType(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	res := executeBaseHTTPConfig(dataplane.Configuration{})
+	g.Expect(res).To(HaveLen(1))
+
+	httpRes := string(res[0].data)
+	g.Expect(httpRes).To(ContainSubstring("map $http_content_type $grpc_upstream_content_type {"))
+	g.Expect(httpRes).To(ContainSubstring("default $http_content_type;"))
+
+	// the content type is mapped the way NGINX maps it, with the regex of the map
+	mapEntry := regexp.MustCompile(`"~\*(.+)" application/grpc\$1;`).FindStringSubmatch(httpRes)
+	g.Expect(mapEntry).To(HaveLen(2))
+	contentTypeRegex := regexp.MustCompile("(?i)" + mapEntry[1])
+
+	mapContentType := func(contentType string) string {
+		if match := contentTypeRegex.FindStringSubmatch(contentType); match != nil {
+			return "application/grpc" + match[1]
+		}
+		return contentType
+	}
+
+	tests := []struct {
+		contentType string
+		expected    string
+	}{
+		{contentType: "application/grpc-web", expected: "application/grpc"},
+		{contentType: "application/grpc-web+proto", expected: "application/grpc+proto"},
+		{contentType: "application/grpc-web+json", expected: "application/grpc+json"},
+		{contentType: "Application/gRPC-Web+json", expected: "application/grpc+json"},
+		{contentType: "application/grpc", expected: "application/grpc"},
+		{contentType: "application/grpc+json", expected: "application/grpc+json"},
+		{contentType: "application/grpc-web-text", expected: "application/grpc-web-text"},
+	}
+
+	for _, test := range tests {
+		g.Expect(mapContentType(test.contentType)).To(Equal(test.expected), test.contentType)
+	}
+}
+
+func TestExecuteBaseHttp_TLSSettings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		settings    dataplane.TLSSettings
+		expSubStrs  []string
+		notExpected []string
+	}{
+		{
+			name: "default settings",
+			notExpected: []string{
+				"ssl_session_cache",
+				"ssl_session_timeout",
+				"ssl_session_tickets",
+				"ssl_session_ticket_key",
+				"resolver",
+			},
+		},
+		{
+			name: "session cache, ticket keys and resolver",
+			settings: dataplane.TLSSettings{
+				SessionCache:   "shared:SSL:20m",
+				SessionTimeout: "1h",
+				SessionTicketKeys: []dataplane.SessionTicketKey{
+					{ID: "session_ticket_key_test_keys_b", Data: []byte("b")},
+					{ID: "session_ticket_key_test_keys_a", Data: []byte("a")},
+				},
+				OCSPStapling: true,
+				OCSPResolver: &dataplane.DNSResolver{
+					Addresses: []string{"10.0.0.10", "[fd00::10]:53"},
+					Valid:     "30s",
+					Timeout:   "5s",
+				},
+			},
+			expSubStrs: []string{
+				"ssl_session_cache shared:SSL:20m;",
+				"ssl_session_timeout 1h;",
+				"ssl_session_ticket_key /etc/nginx/secrets/session_ticket_key_test_keys_b.key;\n" +
+					"ssl_session_ticket_key /etc/nginx/secrets/session_ticket_key_test_keys_a.key;",
+				"resolver 10.0.0.10 [fd00::10]:53 valid=30s;",
+				"resolver_timeout 5s;",
+			},
+			notExpected: []string{"ssl_session_tickets"},
+		},
+		{
+			name: "session cache and tickets disabled",
+			settings: dataplane.TLSSettings{
+				SessionCache:           "off",
+				SessionTicketsDisabled: true,
+				OCSPResolver: &dataplane.DNSResolver{
+					Addresses: []string{"10.0.0.10"},
+				},
+			},
+			expSubStrs: []string{
+				"ssl_session_cache off;",
+				"ssl_session_tickets off;",
+				"resolver 10.0.0.10;",
+			},
+			notExpected: []string{"ssl_session_ticket_key", "resolver_timeout"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					TLSSettings: test.settings,
+				},
+			}
+
+			res := executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			httpConfig := string(res[0].data)
+			for _, expSubStr := range test.expSubStrs {
+				g.Expect(httpConfig).To(ContainSubstring(expSubStr))
+			}
+			for _, notExpected := range test.notExpected {
+				g.Expect(httpConfig).ToNot(ContainSubstring(notExpected))
+			}
+		})
+	}
+}
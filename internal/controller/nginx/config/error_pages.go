@@ -0,0 +1,137 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
+)
+
+// executeErrorPages generates the files that hold the bodies of the static error pages.
+func executeErrorPages(conf dataplane.Configuration) []executeResult {
+	bodies := make(map[string][]byte)
+
+	addPages := func(errorPages *dataplane.ErrorPages) {
+		if errorPages == nil {
+			return
+		}
+
+		for _, page := range errorPages.Pages {
+			if page.Static != nil {
+				bodies[page.Name] = page.Static.Body
+			}
+		}
+	}
+
+	for _, servers := range [][]dataplane.VirtualServer{conf.HTTPServers, conf.SSLServers} {
+		for _, s := range servers {
+			addPages(s.ErrorPages)
+
+			for _, rule := range s.PathRules {
+				addPages(rule.ErrorPages)
+			}
+		}
+	}
+
+	results := make([]executeResult, 0, len(bodies))
+	for name, body := range bodies {
+		results = append(results, executeResult{
+			dest: generateErrorPageFileName(name),
+			data: body,
+		})
+	}
+
+	return results
+}
+
+func generateErrorPageFileName(name string) string {
+	return filepath.Join(includesFolder, "error-page-"+name)
+}
+
+func createErrorPageLocationPath(name string) string {
+	return http.InternalErrorPagePathPrefix + "-" + name
+}
+
+// createErrorPages converts the ErrorPages into error_page directives and the value
+// of the proxy_intercept_errors directive.
+func createErrorPages(errorPages *dataplane.ErrorPages) ([]http.ErrorPage, string) {
+	if errorPages == nil {
+		return nil, ""
+	}
+
+	var interceptErrors string
+	if errorPages.InterceptUpstreamErrors != nil {
+		interceptErrors = "off"
+		if *errorPages.InterceptUpstreamErrors {
+			interceptErrors = "on"
+		}
+	}
+
+	pages := make([]http.ErrorPage, 0, len(errorPages.Pages))
+	for _, page := range errorPages.Pages {
+		errorPage := http.ErrorPage{
+			Codes: page.Codes,
+			URI:   createErrorPageLocationPath(page.Name),
+		}
+
+		if page.ResponseCode != nil {
+			errorPage.ResponseCode = *page.ResponseCode
+		}
+
+		if page.Redirect != nil {
+			errorPage.URI = page.Redirect.URL
+			errorPage.ResponseCode = page.Redirect.StatusCode
+		}
+
+		pages = append(pages, errorPage)
+	}
+
+	return pages, interceptErrors
+}
+
+// createErrorPageLocations creates the internal locations that serve the error pages of the server
+// and its path rules.
+func createErrorPageLocations(server *dataplane.VirtualServer, keepAliveCheck keepAliveChecker) []http.Location {
+	var locs []http.Location
+	created := make(map[string]struct{})
+
+	addLocations := func(errorPages *dataplane.ErrorPages) {
+		if errorPages == nil {
+			return
+		}
+
+		for _, page := range errorPages.Pages {
+			if _, exists := created[page.Name]; exists {
+				continue
+			}
+
+			loc := http.Location{
+				Path: exactPath(createErrorPageLocationPath(page.Name)),
+				Type: http.InternalLocationType,
+			}
+
+			switch {
+			case page.Static != nil:
+				loc.DefaultType = page.Static.ContentType
+				loc.Alias = generateErrorPageFileName(page.Name)
+			case page.Backend != nil:
+				backends := []dataplane.Backend{{UpstreamName: page.Backend.UpstreamName}}
+				loc.ProxySetHeaders = createBaseProxySetHeaders(getConnectionHeader(keepAliveCheck, backends, nil))
+				loc.ProxyPass = "http://" + page.Backend.UpstreamName + page.Backend.Path
+			default:
+				// redirects don't need a location
+				continue
+			}
+
+			created[page.Name] = struct{}{}
+			locs = append(locs, loc)
+		}
+	}
+
+	addLocations(server.ErrorPages)
+	for _, rule := range server.PathRules {
+		addLocations(rule.ErrorPages)
+	}
+
+	return locs
+}
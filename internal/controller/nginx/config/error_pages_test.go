@@ -0,0 +1,240 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+var (
+	staticErrorPage = dataplane.ErrorPage{
+		Name:  "test_epp_0",
+		Codes: []int{404},
+		Static: &dataplane.StaticErrorPage{
+			ContentType: "text/html",
+			Body:        []byte("not found"),
+		},
+	}
+	redirectErrorPage = dataplane.ErrorPage{
+		Name:  "test_epp_1",
+		Codes: []int{403},
+		Redirect: &dataplane.ErrorPageRedirect{
+			URL:        "https://example.com/forbidden",
+			StatusCode: 302,
+		},
+	}
+	backendErrorPage = dataplane.ErrorPage{
+		Name:         "test_epp_2",
+		Codes:        []int{502, 503},
+		ResponseCode: helpers.GetPointer(503),
+		Backend: &dataplane.ErrorPageBackend{
+			UpstreamName: "test_errors_80",
+			Path:         "/maintenance",
+		},
+	}
+	routeErrorPage = dataplane.ErrorPage{
+		Name:  "test_route-epp_0",
+		Codes: []int{500},
+		Static: &dataplane.StaticErrorPage{
+			ContentType: "application/json",
+			Body:        []byte(`{"error": "internal"}`),
+		},
+	}
+)
+
+func createErrorPagesVirtualServer() dataplane.VirtualServer {
+	return dataplane.VirtualServer{
+		Hostname: "example.com",
+		Port:     8080,
+		ErrorPages: &dataplane.ErrorPages{
+			InterceptUpstreamErrors: helpers.GetPointer(true),
+			Pages:                   []dataplane.ErrorPage{staticErrorPage, redirectErrorPage, backendErrorPage},
+		},
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						BackendGroup: dataplane.BackendGroup{
+							Backends: []dataplane.Backend{{UpstreamName: "test_foo_80", Valid: true, Weight: 1}},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/api",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						BackendGroup: dataplane.BackendGroup{
+							Backends: []dataplane.Backend{{UpstreamName: "test_api_80", Valid: true, Weight: 1}},
+						},
+					},
+				},
+				ErrorPages: &dataplane.ErrorPages{
+					InterceptUpstreamErrors: helpers.GetPointer(false),
+					Pages:                   []dataplane.ErrorPage{routeErrorPage, staticErrorPage},
+				},
+			},
+		},
+	}
+}
+
+func TestExecuteServersWithErrorPages(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{createErrorPagesVirtualServer()},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+
+	var serverConf string
+	for _, res := range results {
+		if res.dest == httpConfigFile {
+			serverConf = string(res.data)
+		}
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_intercept_errors on;":                                    1,
+		"proxy_intercept_errors off;":                                   1,
+		"error_page 404 /_ngf-internal-error-page-test_epp_0;":          2,
+		"error_page 403 =302 https://example.com/forbidden;":            1,
+		"error_page 502 503 =503 /_ngf-internal-error-page-test_epp_2;": 1,
+		"error_page 500 /_ngf-internal-error-page-test_route-epp_0;":    1,
+		"location = /_ngf-internal-error-page-test_epp_0 {":             1,
+		"location = /_ngf-internal-error-page-test_route-epp_0 {":       1,
+		"location = /_ngf-internal-error-page-test_epp_2 {":             1,
+		"location = /_ngf-internal-error-page-test_epp_1 {":             0,
+		"default_type \"text/html\";":                                   1,
+		"alias /etc/nginx/includes/error-page-test_epp_0;":              1,
+		"default_type \"application/json\";":                            1,
+		"alias /etc/nginx/includes/error-page-test_route-epp_0;":        1,
+		"proxy_pass http://test_errors_80/maintenance;":                 1,
+	}
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteErrorPages(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{createErrorPagesVirtualServer()},
+		SSLServers:  []dataplane.VirtualServer{createErrorPagesVirtualServer()},
+	}
+
+	results := executeErrorPages(conf)
+	g.Expect(results).To(ConsistOf(
+		executeResult{
+			dest: "/etc/nginx/includes/error-page-test_epp_0",
+			data: []byte("not found"),
+		},
+		executeResult{
+			dest: "/etc/nginx/includes/error-page-test_route-epp_0",
+			data: []byte(`{"error": "internal"}`),
+		},
+	))
+
+	g.Expect(executeErrorPages(dataplane.Configuration{})).To(BeEmpty())
+}
+
+func TestCreateErrorPages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		errorPages         *dataplane.ErrorPages
+		name               string
+		expInterceptErrors string
+		expPages           []http.ErrorPage
+	}{
+		{
+			name: "nil error pages",
+		},
+		{
+			name: "intercept errors disabled",
+			errorPages: &dataplane.ErrorPages{
+				InterceptUpstreamErrors: helpers.GetPointer(false),
+			},
+			expInterceptErrors: "off",
+			expPages:           []http.ErrorPage{},
+		},
+		{
+			name: "all error page types",
+			errorPages: &dataplane.ErrorPages{
+				Pages: []dataplane.ErrorPage{staticErrorPage, redirectErrorPage, backendErrorPage},
+			},
+			expPages: []http.ErrorPage{
+				{
+					Codes: []int{404},
+					URI:   "/_ngf-internal-error-page-test_epp_0",
+				},
+				{
+					Codes:        []int{403},
+					URI:          "https://example.com/forbidden",
+					ResponseCode: 302,
+				},
+				{
+					Codes:        []int{502, 503},
+					URI:          "/_ngf-internal-error-page-test_epp_2",
+					ResponseCode: 503,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			pages, interceptErrors := createErrorPages(test.errorPages)
+			g.Expect(pages).To(Equal(test.expPages))
+			g.Expect(interceptErrors).To(Equal(test.expInterceptErrors))
+		})
+	}
+}
+
+func TestCreateErrorPageLocations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := createErrorPagesVirtualServer()
+
+	keepAliveCheck := func(name string) bool { return name == "test_errors_80" }
+
+	locs := createErrorPageLocations(&server, keepAliveCheck)
+	g.Expect(locs).To(Equal([]http.Location{
+		{
+			Path:        "= /_ngf-internal-error-page-test_epp_0",
+			Type:        http.InternalLocationType,
+			DefaultType: "text/html",
+			Alias:       "/etc/nginx/includes/error-page-test_epp_0",
+		},
+		{
+			Path:            "= /_ngf-internal-error-page-test_epp_2",
+			Type:            http.InternalLocationType,
+			ProxySetHeaders: createBaseProxySetHeaders(unsetHTTPConnectionHeader),
+			ProxyPass:       "http://test_errors_80/maintenance",
+		},
+		{
+			Path:        "= /_ngf-internal-error-page-test_route-epp_0",
+			Type:        http.InternalLocationType,
+			DefaultType: "application/json",
+			Alias:       "/etc/nginx/includes/error-page-test_route-epp_0",
+		},
+	}))
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/clientsettings"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/observability"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/upstreamsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/websocket"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/file"
 )
@@ -119,6 +121,8 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []agent.File {
 	policyGenerator := policies.NewCompositeGenerator(
 		clientsettings.NewGenerator(),
 		observability.NewGenerator(conf.Telemetry),
+		proxysettings.NewGenerator(),
+		websocket.NewGenerator(),
 	)
 
 	files = append(files, g.executeConfigTemplates(conf, policyGenerator)...)
@@ -127,6 +131,10 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []agent.File {
 		files = append(files, generateCertBundle(id, bundle))
 	}
 
+	for _, key := range conf.BaseHTTPConfig.TLSSettings.SessionTicketKeys {
+		files = append(files, generateSessionTicketKey(key))
+	}
+
 	return files
 }
 
@@ -198,6 +206,7 @@ func (g GeneratorImpl) getExecuteFuncs(
 		executeMainConfig,
 		executeBaseHTTPConfig,
 		g.newExecuteServersFunc(generator, keepAliveCheck),
+		executeErrorPages,
 		newExecuteUpstreamsFunc(upstreams),
 		executeSplitClients,
 		executeMaps,
@@ -245,3 +254,21 @@ func generateCertBundle(id dataplane.CertBundleID, cert []byte) agent.File {
 func generateCertBundleFileName(id dataplane.CertBundleID) string {
 	return filepath.Join(secretsFolder, string(id)+".crt")
 }
+
+// generateSessionTicketKey generates the file of a TLS session ticket key. When the keys are rotated,
+// the files are pushed to NGINX along with the updated configuration that references them.
+func generateSessionTicketKey(key dataplane.SessionTicketKey) agent.File {
+	return agent.File{
+		Meta: &pb.FileMeta{
+			Name:        generateSessionTicketKeyFileName(key.ID),
+			Hash:        filesHelper.GenerateHash(key.Data),
+			Permissions: file.SecretFileMode,
+			Size:        int64(len(key.Data)),
+		},
+		Contents: key.Data,
+	}
+}
+
+func generateSessionTicketKeyFileName(id dataplane.SessionTicketKeyID) string {
+	return filepath.Join(secretsFolder, string(id)+".key")
+}
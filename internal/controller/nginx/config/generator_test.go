@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -274,3 +275,50 @@ func TestGenerate(t *testing.T) {
 	g.Expect(streamCfg).To(ContainSubstring("app.example.com unix:/var/run/nginx/app.example.com-443.sock"))
 	g.Expect(streamCfg).To(ContainSubstring("example.com unix:/var/run/nginx/https443.sock"))
 }
+
+func TestGenerate_SessionTicketKeys(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			TLSSettings: dataplane.TLSSettings{
+				SessionTicketKeys: []dataplane.SessionTicketKey{
+					{ID: "session_ticket_key_test_keys_b", Data: []byte("key-b")},
+					{ID: "session_ticket_key_test_keys_a", Data: []byte("key-a")},
+				},
+			},
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false, nil, logr.Discard())
+	files := generator.Generate(conf)
+
+	var keyFiles []agent.File
+	for _, f := range files {
+		if strings.HasPrefix(f.Meta.Name, "/etc/nginx/secrets/session_ticket_key_") {
+			keyFiles = append(keyFiles, f)
+		}
+	}
+
+	g.Expect(keyFiles).To(ConsistOf(
+		agent.File{
+			Meta: &pb.FileMeta{
+				Name:        "/etc/nginx/secrets/session_ticket_key_test_keys_b.key",
+				Hash:        filesHelper.GenerateHash([]byte("key-b")),
+				Permissions: file.SecretFileMode,
+				Size:        int64(len("key-b")),
+			},
+			Contents: []byte("key-b"),
+		},
+		agent.File{
+			Meta: &pb.FileMeta{
+				Name:        "/etc/nginx/secrets/session_ticket_key_test_keys_a.key",
+				Hash:        filesHelper.GenerateHash([]byte("key-a")),
+				Permissions: file.SecretFileMode,
+				Size:        int64(len("key-a")),
+			},
+			Contents: []byte("key-a"),
+		},
+	))
+}
@@ -7,20 +7,34 @@ import (
 const (
 	InternalRoutePathPrefix       = "/_ngf-internal"
 	InternalMirrorRoutePathPrefix = InternalRoutePathPrefix + "-mirror"
+	InternalErrorPagePathPrefix   = InternalRoutePathPrefix + "-error-page"
+	InternalMirrorSplitPathPrefix = InternalMirrorRoutePathPrefix + "-split"
+	InternalMirrorDropPath        = InternalMirrorSplitPathPrefix + "-drop"
 	HTTPSScheme                   = "https"
 )
 
 // Server holds all configuration for an HTTP server.
 type Server struct {
-	SSL           *SSL
-	ServerName    string
-	Listen        string
-	Locations     []Location
-	Includes      []shared.Include
-	IsDefaultHTTP bool
-	IsDefaultSSL  bool
-	GRPC          bool
-	IsSocket      bool
+	SSL             *SSL
+	HTTP3           *HTTP3
+	ServerName      string
+	Listen          string
+	InterceptErrors string
+	Locations       []Location
+	Includes        []shared.Include
+	ErrorPages      []ErrorPage
+	IsDefaultHTTP   bool
+	IsDefaultSSL    bool
+	GRPC            bool
+	IsSocket        bool
+}
+
+// HTTP3 holds the HTTP/3 configuration of a Server.
+type HTTP3 struct {
+	// Listen is the UDP port that the Server accepts QUIC connections on.
+	Listen string
+	// AltSvc is the value of the Alt-Svc response header that advertises HTTP/3 to clients.
+	AltSvc string
 }
 
 type LocationType string
@@ -29,6 +43,9 @@ const (
 	InternalLocationType LocationType = "internal"
 	ExternalLocationType LocationType = "external"
 	RedirectLocationType LocationType = "redirect"
+	// NativeRedirectLocationType is a location that redirects requests to internal locations without NJS.
+	// The internal location is selected by the variable HTTPMatchVar.
+	NativeRedirectLocationType LocationType = "native-redirect"
 )
 
 // Location holds all configuration for an HTTP location.
@@ -36,17 +53,46 @@ type Location struct {
 	Path            string
 	ProxyPass       string
 	HTTPMatchKey    string
+	HTTPMatchVar    string
 	Type            LocationType
+	DefaultType     string
+	Alias           string
+	InterceptErrors string
 	ProxySetHeaders []Header
 	ProxySSLVerify  *ProxySSLVerify
 	Return          *Return
+	GRPCWeb         *GRPCWeb
 	ResponseHeaders ResponseHeaders
 	Rewrites        []string
 	MirrorPaths     []string
 	Includes        []shared.Include
+	ErrorPages      []ErrorPage
 	GRPC            bool
 }
 
+// GRPCWeb holds the gRPC-Web configuration of a location.
+type GRPCWeb struct {
+	// CORS holds the CORS configuration. If nil, CORS headers are not added.
+	CORS *GRPCWebCORS
+}
+
+// GRPCWebCORS holds the CORS configuration for gRPC-Web requests.
+type GRPCWebCORS struct {
+	// AllowOrigins is a space-separated list of allowed origins.
+	AllowOrigins string
+	// AllowHeaders is a comma-separated list of allowed request headers.
+	AllowHeaders string
+	// MaxAge is the number of seconds that the result of a preflight request can be cached.
+	MaxAge int32
+}
+
+// ErrorPage holds the configuration for an error_page directive.
+type ErrorPage struct {
+	URI          string
+	Codes        []int
+	ResponseCode int
+}
+
 // Header defines an HTTP header to be passed to the proxied server.
 type Header struct {
 	Name  string
@@ -70,12 +116,22 @@ type Return struct {
 type SSL struct {
 	Certificate    string
 	CertificateKey string
+	// Protocols is the value of the ssl_protocols directive.
+	Protocols string
+	// Ciphers is the value of the ssl_ciphers directive.
+	Ciphers string
+	// PreferServerCiphers is the value of the ssl_prefer_server_ciphers directive.
+	PreferServerCiphers string
+	// Stapling specifies whether the stapling of OCSP responses is enabled.
+	Stapling bool
 }
 
 // StatusCode is an HTTP status code.
 type StatusCode int
 
 const (
+	// StatusNoContent is the HTTP 204 status code.
+	StatusNoContent StatusCode = 204
 	// StatusFound is the HTTP 302 status code.
 	StatusFound StatusCode = 302
 	// StatusNotFound is the HTTP 404 status code.
@@ -108,6 +164,7 @@ type UpstreamServer struct {
 
 // SplitClient holds all configuration for an HTTP split client.
 type SplitClient struct {
+	Key           string
 	VariableName  string
 	Distributions []SplitClientDistribution
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 	gotemplate "text/template"
 
@@ -26,6 +28,11 @@ const (
 
 func executeMaps(conf dataplane.Configuration) []executeResult {
 	maps := buildAddHeaderMaps(append(conf.HTTPServers, conf.SSLServers...))
+	maps = append(maps, buildSplitKeyMaps(conf.BackendGroups)...)
+	if conf.BaseHTTPConfig.NativeHTTPMatching {
+		maps = append(maps, buildHTTPMatchMaps(conf)...)
+	}
+
 	result := executeResult{
 		dest: httpConfigFile,
 		data: helpers.MustExecuteTemplate(mapsTemplate, maps),
@@ -177,3 +184,183 @@ func createAddHeadersMap(name string) shared.Map {
 		Parameters: params,
 	}
 }
+
+var (
+	// nativeHeaderNameRegexp matches the header names that can be looked up with the $http_ variables.
+	nativeHeaderNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+	// nativeQueryParamNameRegexp matches the query parameter names that can be looked up with the $arg_ variables.
+	nativeQueryParamNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+)
+
+// supportsNativeHTTPMatching returns whether the matches of the path rule can be evaluated with maps,
+// which is the case when all headers and query parameters can be looked up with NGINX variables.
+func supportsNativeHTTPMatching(rule dataplane.PathRule) bool {
+	for _, r := range rule.MatchRules {
+		for _, h := range r.Match.Headers {
+			if !nativeHeaderNameRegexp.MatchString(h.Name) {
+				return false
+			}
+		}
+
+		for _, p := range r.Match.QueryParams {
+			if !nativeQueryParamNameRegexp.MatchString(p.Name) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func createHTTPMatchVariableName(httpMatchKey string) string {
+	return "ngf_http_match_" + strings.ToLower(httpMatchKey)
+}
+
+// buildHTTPMatchMaps builds the maps that select the internal location of the first match rule that a request
+// satisfies, for every path rule that is evaluated without NJS. The keys and the internal locations must be
+// the same as the ones created in createLocations.
+func buildHTTPMatchMaps(conf dataplane.Configuration) []shared.Map {
+	var maps []shared.Map
+
+	addServerMaps := func(server dataplane.VirtualServer, serverID string) {
+		if server.IsDefault {
+			return
+		}
+
+		for pathRuleIdx, rule := range server.PathRules {
+			if !needsInternalLocations(rule) || !supportsNativeHTTPMatching(rule) {
+				continue
+			}
+
+			maps = append(maps, createHTTPMatchMaps(createHTTPMatchKey(serverID, pathRuleIdx), pathRuleIdx, rule)...)
+		}
+	}
+
+	for idx, s := range conf.HTTPServers {
+		addServerMaps(s, httpServerID(idx))
+	}
+
+	for idx, s := range conf.SSLServers {
+		addServerMaps(s, sslServerID(idx))
+	}
+
+	return maps
+}
+
+// createHTTPMatchMaps creates a map for every match condition of the path rule, which results in "1"
+// if the request satisfies the condition, and "0" otherwise. A final map concatenates the results and selects
+// the internal location of the first match rule whose conditions are all satisfied. Since NGINX checks
+// regular expressions in the order they appear, the precedence of the match rules is preserved.
+// If no match rule is satisfied, the final map results in an empty string.
+func createHTTPMatchMaps(httpMatchKey string, pathRuleIdx int, rule dataplane.PathRule) []shared.Map {
+	varName := createHTTPMatchVariableName(httpMatchKey)
+
+	maps := make([]shared.Map, 0, len(rule.MatchRules)+1)
+	params := []shared.MapParameter{
+		{
+			Value:  "default",
+			Result: `""`,
+		},
+	}
+
+	var source strings.Builder
+	var conditionCount int
+
+	for matchRuleIdx, r := range rule.MatchRules {
+		path := createInternalLocationPath(pathRuleIdx, matchRuleIdx)
+
+		if isPathOnlyMatch(r.Match) {
+			// the rule matches every request, so the rules after it are never selected.
+			params[0].Result = path
+			break
+		}
+
+		conditions := createHTTPMatchConditions(r.Match)
+		for conditionIdx, c := range conditions {
+			conditionVarName := fmt.Sprintf("%s_%d_%d", varName, matchRuleIdx, conditionIdx)
+			maps = append(maps, shared.Map{
+				Source:   c.Source,
+				Variable: "$" + conditionVarName,
+				Parameters: []shared.MapParameter{
+					{
+						Value:  "default",
+						Result: "0",
+					},
+					{
+						Value:  c.Value,
+						Result: "1",
+					},
+				},
+			})
+			source.WriteString("${" + conditionVarName + "}")
+		}
+
+		params = append(params, shared.MapParameter{
+			Value:  `"~^` + strings.Repeat(".", conditionCount) + strings.Repeat("1", len(conditions)) + `"`,
+			Result: path,
+		})
+		conditionCount += len(conditions)
+	}
+
+	maps = append(maps, shared.Map{
+		Source:     `"` + source.String() + `"`,
+		Variable:   "$" + varName,
+		Parameters: params,
+	})
+
+	return maps
+}
+
+// httpMatchCondition is a condition of a match. A request satisfies the condition if the value of
+// the Source variable matches the Value.
+type httpMatchCondition struct {
+	Source string
+	Value  string
+}
+
+// createHTTPMatchConditions converts the method, headers and query parameters of a match to conditions.
+// Values are regular expressions, because the strings in a map are compared case-insensitively.
+func createHTTPMatchConditions(match dataplane.Match) []httpMatchCondition {
+	conditions := make([]httpMatchCondition, 0, len(match.Headers)+len(match.QueryParams)+1)
+
+	if match.Method != nil {
+		conditions = append(conditions, httpMatchCondition{
+			Source: "$request_method",
+			Value:  createHTTPMatchConditionValue(dataplane.MatchTypeExact, *match.Method),
+		})
+	}
+
+	headerNames := make(map[string]struct{})
+	for _, h := range match.Headers {
+		// duplicate header names are not permitted by the spec
+		// only configure the first entry for every header name (case-insensitive)
+		lowerName := strings.ToLower(h.Name)
+		if _, ok := headerNames[lowerName]; ok {
+			continue
+		}
+		headerNames[lowerName] = struct{}{}
+
+		conditions = append(conditions, httpMatchCondition{
+			Source: "$http_" + convertStringToSafeVariableName(lowerName),
+			Value:  createHTTPMatchConditionValue(h.Type, h.Value),
+		})
+	}
+
+	for _, p := range match.QueryParams {
+		conditions = append(conditions, httpMatchCondition{
+			Source: "$arg_" + p.Name,
+			Value:  createHTTPMatchConditionValue(p.Type, p.Value),
+		})
+	}
+
+	return conditions
+}
+
+func createHTTPMatchConditionValue(matchType dataplane.MatchType, value string) string {
+	regex := value
+	if matchType != dataplane.MatchTypeRegularExpression {
+		regex = "^" + regexp.QuoteMeta(value) + "$"
+	}
+
+	return `"~` + quotedStringEscaper.Replace(regex) + `"`
+}
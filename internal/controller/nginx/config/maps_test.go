@@ -9,6 +9,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/resolver"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 )
 
 func TestExecuteMaps(t *testing.T) {
@@ -191,6 +192,213 @@ func TestBuildAddHeaderMaps(t *testing.T) {
 	g.Expect(maps).To(ConsistOf(expectedMap))
 }
 
+func createNativeHTTPMatchPathRule() dataplane.PathRule {
+	return dataplane.PathRule{
+		Path:     "/coffee",
+		PathType: dataplane.PathTypePrefix,
+		MatchRules: []dataplane.MatchRule{
+			{
+				Match: dataplane.Match{
+					Method: helpers.GetPointer("POST"),
+					Headers: []dataplane.HTTPHeaderMatch{
+						{Name: "Version", Value: "v1.0", Type: dataplane.MatchTypeExact},
+						{Name: "version", Value: "v2", Type: dataplane.MatchTypeExact},
+						{Name: "X-Env", Value: `^(canary|"beta")$`, Type: dataplane.MatchTypeRegularExpression},
+					},
+				},
+			},
+			{
+				Match: dataplane.Match{
+					QueryParams: []dataplane.HTTPQueryParamMatch{
+						{Name: "tier", Value: "gold", Type: dataplane.MatchTypeExact},
+					},
+				},
+			},
+			{
+				Match: dataplane.Match{},
+			},
+			{
+				Match: dataplane.Match{
+					Method: helpers.GetPointer("GET"),
+				},
+			},
+		},
+	}
+}
+
+func TestExecuteMaps_NativeHTTPMatching(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pathRules := []dataplane.PathRule{createNativeHTTPMatchPathRule()}
+
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{NativeHTTPMatching: true},
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+			},
+			{
+				PathRules: pathRules,
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+			},
+			{
+				PathRules: pathRules,
+			},
+		},
+	}
+
+	selectorMap := `map "${ngf_http_match_1_0_0_0}${ngf_http_match_1_0_0_1}${ngf_http_match_1_0_0_2}` +
+		"${ngf_http_match_1_0_1_0}\" $ngf_http_match_1_0 {"
+
+	expSubStrings := map[string]int{
+		"map $request_method $ngf_http_match_1_0_0_0 {":     1,
+		"map $request_method $ngf_http_match_ssl_1_0_0_0 {": 1,
+		"map $arg_tier $ngf_http_match_1_0_1_0 {":           1,
+		"map $http_version $ngf_http_match_1_0_0_1 {":       1,
+		selectorMap:                             1,
+		`"~^POST$" 1;`:                          2,
+		`"~^v1\\.0$" 1;`:                        2,
+		`"~^(canary|\"beta\")$" 1;`:             2,
+		"default /_ngf-internal-rule0-route2;":  2,
+		`"~^111" /_ngf-internal-rule0-route0;`:  2,
+		`"~^...1" /_ngf-internal-rule0-route1;`: 2,
+		"/_ngf-internal-rule0-route3":           0,
+	}
+
+	mapResult := executeMaps(conf)
+	g.Expect(mapResult).To(HaveLen(1))
+	maps := string(mapResult[0].data)
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(maps, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+
+	conf.BaseHTTPConfig.NativeHTTPMatching = false
+	g.Expect(string(executeMaps(conf)[0].data)).ToNot(ContainSubstring("ngf_http_match"))
+}
+
+func TestCreateHTTPMatchMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	maps := createHTTPMatchMaps("SSL_1_2", 2, createNativeHTTPMatchPathRule())
+	g.Expect(maps).To(Equal([]shared.Map{
+		{
+			Source:   "$request_method",
+			Variable: "$ngf_http_match_ssl_1_2_0_0",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "0"},
+				{Value: `"~^POST$"`, Result: "1"},
+			},
+		},
+		{
+			Source:   "$http_version",
+			Variable: "$ngf_http_match_ssl_1_2_0_1",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "0"},
+				{Value: `"~^v1\\.0$"`, Result: "1"},
+			},
+		},
+		{
+			Source:   "$http_x_env",
+			Variable: "$ngf_http_match_ssl_1_2_0_2",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "0"},
+				{Value: `"~^(canary|\"beta\")$"`, Result: "1"},
+			},
+		},
+		{
+			Source:   "$arg_tier",
+			Variable: "$ngf_http_match_ssl_1_2_1_0",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "0"},
+				{Value: `"~^gold$"`, Result: "1"},
+			},
+		},
+		{
+			Source: `"${ngf_http_match_ssl_1_2_0_0}${ngf_http_match_ssl_1_2_0_1}${ngf_http_match_ssl_1_2_0_2}` +
+				`${ngf_http_match_ssl_1_2_1_0}"`,
+			Variable: "$ngf_http_match_ssl_1_2",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "/_ngf-internal-rule2-route2"},
+				{Value: `"~^111"`, Result: "/_ngf-internal-rule2-route0"},
+				{Value: `"~^...1"`, Result: "/_ngf-internal-rule2-route1"},
+			},
+		},
+	}))
+
+	noPathOnlyRule := dataplane.PathRule{
+		MatchRules: []dataplane.MatchRule{
+			{
+				Match: dataplane.Match{Method: helpers.GetPointer("GET")},
+			},
+		},
+	}
+
+	maps = createHTTPMatchMaps("0_0", 0, noPathOnlyRule)
+	g.Expect(maps).To(HaveLen(2))
+	g.Expect(maps[1]).To(Equal(shared.Map{
+		Source:   `"${ngf_http_match_0_0_0_0}"`,
+		Variable: "$ngf_http_match_0_0",
+		Parameters: []shared.MapParameter{
+			{Value: "default", Result: `""`},
+			{Value: `"~^1"`, Result: "/_ngf-internal-rule0-route0"},
+		},
+	}))
+}
+
+func TestSupportsNativeHTTPMatching(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		match dataplane.Match
+		exp   bool
+	}{
+		{
+			name: "supported header and query parameter names",
+			match: dataplane.Match{
+				Headers:     []dataplane.HTTPHeaderMatch{{Name: "X-Version-2"}},
+				QueryParams: []dataplane.HTTPQueryParamMatch{{Name: "api_version"}},
+			},
+			exp: true,
+		},
+		{
+			name: "unsupported header name",
+			match: dataplane.Match{
+				Headers: []dataplane.HTTPHeaderMatch{{Name: "x.version"}},
+			},
+			exp: false,
+		},
+		{
+			name: "unsupported query parameter name",
+			match: dataplane.Match{
+				QueryParams: []dataplane.HTTPQueryParamMatch{{Name: "api-version"}},
+			},
+			exp: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			rule := dataplane.PathRule{
+				MatchRules: []dataplane.MatchRule{
+					{Match: dataplane.Match{Method: helpers.GetPointer("GET")}},
+					{Match: test.match},
+				},
+			}
+			g.Expect(supportsNativeHTTPMatching(rule)).To(Equal(test.exp))
+		})
+	}
+}
+
 func TestExecuteStreamMaps(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
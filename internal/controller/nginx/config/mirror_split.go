@@ -0,0 +1,155 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
+)
+
+// mirrorSplitVariableNameReplacer converts the path of a mirror split location to a variable name.
+// Kubernetes names cannot contain underscores, so replacing "/" with "__" keeps the variable names unique.
+var mirrorSplitVariableNameReplacer = strings.NewReplacer("/", "__", "-", "_", ".", "_")
+
+// mirrorSplit mirrors a percentage of requests to the internal location of a mirror route.
+//
+// NGINX mirrors every request to the paths of the mirror directive, and the mirror directive doesn't
+// support variables. To mirror a percentage of requests, a location mirrors the requests to the internal
+// mirror split location instead, which uses the variable of a split_clients block to internally redirect
+// the request either to the mirror route location or to a location that drops the request.
+type mirrorSplit struct {
+	// path is the path of the mirror split location.
+	path string
+	// target is the path of the mirror route location.
+	target string
+	// percent is the percentage of requests that are mirrored to the target.
+	percent float64
+}
+
+func (m mirrorSplit) variableName() string {
+	return mirrorSplitVariableNameReplacer.Replace(strings.TrimPrefix(m.path, "/"))
+}
+
+// createMirrorSplitPath returns the path of the mirror split location for the target and percentage.
+// The percentage contains a '.', which Kubernetes names cannot, so the path never conflicts with the path of
+// a mirror route location.
+func createMirrorSplitPath(target string, percent float64) string {
+	return fmt.Sprintf(
+		"%s-%.2f%s",
+		http.InternalMirrorSplitPathPrefix,
+		percent,
+		strings.TrimPrefix(target, http.InternalMirrorRoutePathPrefix),
+	)
+}
+
+// createMirrorPath returns the path that the mirror directive of a location uses for the mirror filter.
+// It returns an empty string if no requests are mirrored.
+func createMirrorPath(filter *dataplane.HTTPRequestMirrorFilter) string {
+	if filter.Target == nil {
+		return ""
+	}
+
+	if filter.Percent == nil {
+		return *filter.Target
+	}
+
+	if *filter.Percent <= 0 {
+		return ""
+	}
+
+	return createMirrorSplitPath(*filter.Target, *filter.Percent)
+}
+
+// getMirrorSplits returns the unique mirror splits of the server.
+func getMirrorSplits(server dataplane.VirtualServer) []mirrorSplit {
+	var splits []mirrorSplit
+	seen := make(map[string]struct{})
+
+	for _, rule := range server.PathRules {
+		for _, matchRule := range rule.MatchRules {
+			for _, filter := range matchRule.Filters.RequestMirrors {
+				if filter.Target == nil || filter.Percent == nil || *filter.Percent <= 0 {
+					continue
+				}
+
+				split := mirrorSplit{
+					path:    createMirrorSplitPath(*filter.Target, *filter.Percent),
+					target:  *filter.Target,
+					percent: *filter.Percent,
+				}
+
+				if _, exists := seen[split.path]; exists {
+					continue
+				}
+
+				seen[split.path] = struct{}{}
+				splits = append(splits, split)
+			}
+		}
+	}
+
+	return splits
+}
+
+// createMirrorSplitLocations creates the internal locations that mirror a percentage of requests to the
+// mirror route locations of the server, and the location that drops the requests that are not mirrored.
+func createMirrorSplitLocations(server *dataplane.VirtualServer) []http.Location {
+	splits := getMirrorSplits(*server)
+	if len(splits) == 0 {
+		return nil
+	}
+
+	locs := make([]http.Location, 0, len(splits)+1)
+
+	for _, split := range splits {
+		locs = append(locs, http.Location{
+			Path:     exactPath(split.path),
+			Type:     http.InternalLocationType,
+			Rewrites: []string{fmt.Sprintf("^ $%s last", split.variableName())},
+		})
+	}
+
+	locs = append(locs, http.Location{
+		Path:   exactPath(http.InternalMirrorDropPath),
+		Type:   http.InternalLocationType,
+		Return: &http.Return{Code: http.StatusNoContent},
+	})
+
+	return locs
+}
+
+// createMirrorSplitClients creates the split clients that decide whether a request is mirrored to the
+// mirror route location.
+func createMirrorSplitClients(servers ...[]dataplane.VirtualServer) []http.SplitClient {
+	var splitClients []http.SplitClient
+	seen := make(map[string]struct{})
+
+	for _, virtualServers := range servers {
+		for _, server := range virtualServers {
+			for _, split := range getMirrorSplits(server) {
+				if _, exists := seen[split.path]; exists {
+					continue
+				}
+
+				seen[split.path] = struct{}{}
+				splitClients = append(splitClients, http.SplitClient{
+					Key:          requestIDVariable,
+					VariableName: split.variableName(),
+					Distributions: []http.SplitClientDistribution{
+						{
+							Percent: fmt.Sprintf("%.2f", split.percent),
+							Value:   split.target,
+						},
+						{
+							Percent: fmt.Sprintf("%.2f", 100-split.percent),
+							Value:   http.InternalMirrorDropPath,
+						},
+					},
+				})
+			}
+		}
+	}
+
+	return splitClients
+}
@@ -0,0 +1,226 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func createMirrorSplitVirtualServer() dataplane.VirtualServer {
+	return dataplane.VirtualServer{
+		Hostname: "example.com",
+		Port:     8080,
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/coffee",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{
+							RequestMirrors: []*dataplane.HTTPRequestMirrorFilter{
+								{
+									Name:    helpers.GetPointer("mirror"),
+									Target:  helpers.GetPointer("/_ngf-internal-mirror-test/mirror-0"),
+									Percent: helpers.GetPointer(33.33),
+								},
+								{
+									Name:   helpers.GetPointer("mirror-all"),
+									Target: helpers.GetPointer("/_ngf-internal-mirror-mirror-all-0"),
+								},
+								{
+									Name:    helpers.GetPointer("mirror-none"),
+									Target:  helpers.GetPointer("/_ngf-internal-mirror-mirror-none-0"),
+									Percent: helpers.GetPointer(0.0),
+								},
+							},
+						},
+						BackendGroup: dataplane.BackendGroup{
+							Backends: []dataplane.Backend{{UpstreamName: "test_coffee_80", Valid: true, Weight: 1}},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/tea",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{
+							RequestMirrors: []*dataplane.HTTPRequestMirrorFilter{
+								{
+									Name:    helpers.GetPointer("mirror"),
+									Target:  helpers.GetPointer("/_ngf-internal-mirror-test/mirror-0"),
+									Percent: helpers.GetPointer(33.33),
+								},
+								{
+									Name:    helpers.GetPointer("mirror"),
+									Target:  helpers.GetPointer("/_ngf-internal-mirror-test/mirror-0"),
+									Percent: helpers.GetPointer(5.0),
+								},
+							},
+						},
+						BackendGroup: dataplane.BackendGroup{
+							Backends: []dataplane.Backend{{UpstreamName: "test_tea_80", Valid: true, Weight: 1}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCreateMirrorPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter  *dataplane.HTTPRequestMirrorFilter
+		name    string
+		expPath string
+	}{
+		{
+			name:    "no target",
+			filter:  &dataplane.HTTPRequestMirrorFilter{},
+			expPath: "",
+		},
+		{
+			name: "all requests",
+			filter: &dataplane.HTTPRequestMirrorFilter{
+				Target: helpers.GetPointer("/_ngf-internal-mirror-backend-0"),
+			},
+			expPath: "/_ngf-internal-mirror-backend-0",
+		},
+		{
+			name: "no requests",
+			filter: &dataplane.HTTPRequestMirrorFilter{
+				Target:  helpers.GetPointer("/_ngf-internal-mirror-backend-0"),
+				Percent: helpers.GetPointer(0.0),
+			},
+			expPath: "",
+		},
+		{
+			name: "percentage of requests",
+			filter: &dataplane.HTTPRequestMirrorFilter{
+				Target:  helpers.GetPointer("/_ngf-internal-mirror-test/backend-0"),
+				Percent: helpers.GetPointer(12.5),
+			},
+			expPath: "/_ngf-internal-mirror-split-12.50-test/backend-0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createMirrorPath(test.filter)).To(Equal(test.expPath))
+		})
+	}
+}
+
+func TestCreateMirrorSplitLocations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := createMirrorSplitVirtualServer()
+
+	g.Expect(createMirrorSplitLocations(&server)).To(Equal([]http.Location{
+		{
+			Path:     "= /_ngf-internal-mirror-split-33.33-test/mirror-0",
+			Type:     http.InternalLocationType,
+			Rewrites: []string{"^ $_ngf_internal_mirror_split_33_33_test__mirror_0 last"},
+		},
+		{
+			Path:     "= /_ngf-internal-mirror-split-5.00-test/mirror-0",
+			Type:     http.InternalLocationType,
+			Rewrites: []string{"^ $_ngf_internal_mirror_split_5_00_test__mirror_0 last"},
+		},
+		{
+			Path:   "= /_ngf-internal-mirror-split-drop",
+			Type:   http.InternalLocationType,
+			Return: &http.Return{Code: http.StatusNoContent},
+		},
+	}))
+
+	g.Expect(createMirrorSplitLocations(&dataplane.VirtualServer{})).To(BeNil())
+}
+
+func TestCreateMirrorSplitClients(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := createMirrorSplitVirtualServer()
+
+	splitClients := createMirrorSplitClients(
+		[]dataplane.VirtualServer{server},
+		[]dataplane.VirtualServer{server},
+	)
+	g.Expect(splitClients).To(Equal([]http.SplitClient{
+		{
+			Key:          "$request_id",
+			VariableName: "_ngf_internal_mirror_split_33_33_test__mirror_0",
+			Distributions: []http.SplitClientDistribution{
+				{Percent: "33.33", Value: "/_ngf-internal-mirror-test/mirror-0"},
+				{Percent: "66.67", Value: "/_ngf-internal-mirror-split-drop"},
+			},
+		},
+		{
+			Key:          "$request_id",
+			VariableName: "_ngf_internal_mirror_split_5_00_test__mirror_0",
+			Distributions: []http.SplitClientDistribution{
+				{Percent: "5.00", Value: "/_ngf-internal-mirror-test/mirror-0"},
+				{Percent: "95.00", Value: "/_ngf-internal-mirror-split-drop"},
+			},
+		},
+	}))
+
+	g.Expect(createMirrorSplitClients(nil, nil)).To(BeNil())
+}
+
+func TestExecuteServersWithMirrorSplits(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{createMirrorSplitVirtualServer()},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+
+	var serverConf string
+	for _, res := range results {
+		if res.dest == httpConfigFile {
+			serverConf = string(res.data)
+		}
+	}
+
+	expSubStrings := map[string]int{
+		"mirror /_ngf-internal-mirror-split-33.33-test/mirror-0;": 2,
+		"mirror /_ngf-internal-mirror-split-5.00-test/mirror-0;":  1,
+		"mirror /_ngf-internal-mirror-mirror-all-0;":              1,
+		"mirror-none": 0,
+		"location = /_ngf-internal-mirror-split-33.33-test/mirror-0 {":     1,
+		"rewrite ^ $_ngf_internal_mirror_split_33_33_test__mirror_0 last;": 1,
+		"location = /_ngf-internal-mirror-split-drop {":                    1,
+		"return 204 \"\";": 1,
+	}
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+
+	splitResults := executeSplitClients(conf)
+	g.Expect(splitResults).To(HaveLen(1))
+	g.Expect(string(splitResults[0].data)).To(ContainSubstring(
+		"split_clients $request_id $_ngf_internal_mirror_split_33_33_test__mirror_0 {\n" +
+			"    33.33% /_ngf-internal-mirror-test/mirror-0;\n" +
+			"    66.67% /_ngf-internal-mirror-split-drop;\n" +
+			"}",
+	))
+}
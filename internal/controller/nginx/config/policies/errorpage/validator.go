@@ -0,0 +1,163 @@
+package errorpage
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+const (
+	redirectURLFmt    = `https?://[^\s{};"'$\\]+`
+	redirectURLErrMsg = "must be an absolute http or https URL, " +
+		"and must not contain whitespace, '{', '}', ';', '\"', ''', '$' or '\\'"
+	servicePathFmt    = `/[^\s{};"'$\\]*`
+	servicePathErrMsg = "must start with / and must not contain whitespace, '{', '}', ';', '\"', ''', '$' or '\\'"
+)
+
+var (
+	redirectURLRegexp = regexp.MustCompile("^" + redirectURLFmt + "$")
+	servicePathRegexp = regexp.MustCompile("^" + servicePathFmt + "$")
+)
+
+// Validator validates an ErrorPagePolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of an ErrorPagePolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	epp := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range epp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateErrorPages(epp.Spec.ErrorPages); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates an ErrorPagePolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two ErrorPagePolicies conflict.
+// Two ErrorPagePolicies conflict if they both configure the same status code,
+// or if they both configure intercepting upstream errors.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	a := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](polA)
+	b := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](polB)
+
+	if a.Spec.InterceptUpstreamErrors != nil && b.Spec.InterceptUpstreamErrors != nil {
+		return true
+	}
+
+	codes := make(map[ngfAPI.ErrorPageStatusCode]struct{})
+	for _, page := range a.Spec.ErrorPages {
+		for _, code := range page.Codes {
+			codes[code] = struct{}{}
+		}
+	}
+
+	for _, page := range b.Spec.ErrorPages {
+		for _, code := range page.Codes {
+			if _, exists := codes[code]; exists {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateErrorPages performs validation on fields in the error pages that are vulnerable to code injection,
+// and ensures that a status code is only configured by a single error page.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateErrorPages(pages []ngfAPI.ErrorPage) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("errorPages")
+
+	codes := make(map[ngfAPI.ErrorPageStatusCode]struct{})
+
+	for i, page := range pages {
+		pagePath := fieldPath.Index(i)
+
+		for j, code := range page.Codes {
+			if _, exists := codes[code]; exists {
+				allErrs = append(allErrs, field.Duplicate(pagePath.Child("codes").Index(j), code))
+			}
+			codes[code] = struct{}{}
+		}
+
+		var sources int
+
+		if page.Static != nil {
+			sources++
+
+			if ct := page.Static.ContentType; ct != nil {
+				if err := v.genericValidator.ValidateContentType(*ct); err != nil {
+					path := pagePath.Child("static").Child("contentType")
+					allErrs = append(allErrs, field.Invalid(path, *ct, err.Error()))
+				}
+			}
+		}
+
+		if page.Redirect != nil {
+			sources++
+
+			if !redirectURLRegexp.MatchString(page.Redirect.URL) {
+				path := pagePath.Child("redirect").Child("url")
+				allErrs = append(allErrs, field.Invalid(path, page.Redirect.URL, redirectURLErrMsg))
+			}
+
+			if page.ResponseCode != nil {
+				path := pagePath.Child("responseCode")
+				allErrs = append(allErrs, field.Forbidden(path, "cannot be set with redirect"))
+			}
+		}
+
+		if page.Service != nil {
+			sources++
+
+			if p := page.Service.Path; p != nil && !servicePathRegexp.MatchString(*p) {
+				path := pagePath.Child("service").Child("path")
+				allErrs = append(allErrs, field.Invalid(path, *p, servicePathErrMsg))
+			}
+		}
+
+		if sources != 1 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(pagePath, sources, "exactly one of static, redirect or service must be set"),
+			)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,304 @@
+package errorpage_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/errorpage"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy
+
+func createValidPolicy() *ngfAPI.ErrorPagePolicy {
+	return &ngfAPI.ErrorPagePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ErrorPagePolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			ErrorPages: []ngfAPI.ErrorPage{
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{404},
+					Static: &ngfAPI.ErrorPageStatic{
+						ContentType:   helpers.GetPointer("text/html; charset=utf-8"),
+						ConfigMapName: "error-pages",
+						Key:           "404.html",
+					},
+				},
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{403},
+					Redirect: &ngfAPI.ErrorPageRedirect{
+						URL: "https://example.com/forbidden",
+					},
+				},
+				{
+					Codes:        []ngfAPI.ErrorPageStatusCode{502, 503},
+					ResponseCode: helpers.GetPointer[int32](503),
+					Service: &ngfAPI.ErrorPageService{
+						Name: "errors",
+						Port: 80,
+						Path: helpers.GetPointer("/maintenance"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ErrorPagePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ErrorPagePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "duplicate status code",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Codes = append(p.Spec.ErrorPages[1].Codes, 404)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.errorPages[1].codes[1]: Duplicate value: 404"),
+			},
+		},
+		{
+			name: "invalid redirect url",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Redirect.URL = "https://example.com/;return 200"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.errorPages[1].redirect.url: Invalid value: " +
+					"\"https://example.com/;return 200\": must be an absolute http or https URL, " +
+					"and must not contain whitespace, '{', '}', ';', '\"', ''', '$' or '\\'"),
+			},
+		},
+		{
+			name: "redirect to path",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Redirect.URL = "/error"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.errorPages[1].redirect.url: Invalid value: " +
+					"\"/error\": must be an absolute http or https URL, " +
+					"and must not contain whitespace, '{', '}', ';', '\"', ''', '$' or '\\'"),
+			},
+		},
+		{
+			name: "response code with redirect",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].ResponseCode = helpers.GetPointer[int32](200)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.errorPages[1].responseCode: Forbidden: cannot be set with redirect"),
+			},
+		},
+		{
+			name: "invalid service path",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[2].Service.Path = helpers.GetPointer("/$uri")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.errorPages[2].service.path: Invalid value: \"/$uri\": " +
+					"must start with / and must not contain whitespace, '{', '}', ';', '\"', ''', '$' or '\\'"),
+			},
+		},
+		{
+			name: "invalid content type",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[0].Static.ContentType = helpers.GetPointer("text\"")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.errorPages[0].static.contentType: Invalid value: \"text\\\"\": " +
+					"must be a valid media type (e.g. 'text/plain',  or 'application/json',  or " +
+					"'text/html; charset=utf-8', regex used for validation is " +
+					"'[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+-]+(;\\s*[a-zA-Z0-9_-]+=[a-zA-Z0-9_.-]+)*')"),
+			},
+		},
+		{
+			name: "multiple sources",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[0].Redirect = &ngfAPI.ErrorPageRedirect{URL: "https://example.com/error"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.errorPages[0]: Invalid value: 2: " +
+					"exactly one of static, redirect or service must be set"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := errorpage.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := errorpage.NewValidator(validation.GenericValidator{})
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	v := errorpage.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.ErrorPagePolicy
+		polB      *ngfAPI.ErrorPagePolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.ErrorPagePolicy{
+				Spec: ngfAPI.ErrorPagePolicySpec{
+					InterceptUpstreamErrors: helpers.GetPointer(true),
+					ErrorPages: []ngfAPI.ErrorPage{
+						{Codes: []ngfAPI.ErrorPageStatusCode{404, 500}},
+					},
+				},
+			},
+			polB: &ngfAPI.ErrorPagePolicy{
+				Spec: ngfAPI.ErrorPagePolicySpec{
+					ErrorPages: []ngfAPI.ErrorPage{
+						{Codes: []ngfAPI.ErrorPageStatusCode{502}},
+						{Codes: []ngfAPI.ErrorPageStatusCode{503}},
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "status code conflicts",
+			polA: &ngfAPI.ErrorPagePolicy{
+				Spec: ngfAPI.ErrorPagePolicySpec{
+					ErrorPages: []ngfAPI.ErrorPage{
+						{Codes: []ngfAPI.ErrorPageStatusCode{404, 500}},
+					},
+				},
+			},
+			polB: &ngfAPI.ErrorPagePolicy{
+				Spec: ngfAPI.ErrorPagePolicySpec{
+					ErrorPages: []ngfAPI.ErrorPage{
+						{Codes: []ngfAPI.ErrorPageStatusCode{502}},
+						{Codes: []ngfAPI.ErrorPageStatusCode{500}},
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "intercept upstream errors conflicts",
+			polA: &ngfAPI.ErrorPagePolicy{
+				Spec: ngfAPI.ErrorPagePolicySpec{
+					InterceptUpstreamErrors: helpers.GetPointer(true),
+				},
+			},
+			polB: &ngfAPI.ErrorPagePolicy{
+				Spec: ngfAPI.ErrorPagePolicySpec{
+					InterceptUpstreamErrors: helpers.GetPointer(false),
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := errorpage.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := errorpage.NewValidator(validation.GenericValidator{})
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
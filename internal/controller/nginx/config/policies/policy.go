@@ -1,12 +1,15 @@
 package policies
 
 import (
+	"regexp"
 	"slices"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 )
 
 //go:generate go tool counterfeiter -generate
@@ -58,5 +61,59 @@ func ValidateTargetRef(
 	return nil
 }
 
+var (
+	splitKeyCookieNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+	splitKeyHeaderNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// ValidateSplitKey validates a SplitKey, which is shared by TrafficSplitPolicies and NginxProxies.
+// The name of a cookie or a header is used in an NGINX variable name, so it is restricted to the characters
+// that NGINX supports.
+func ValidateSplitKey(key ngfAPIv1alpha1.SplitKey, basePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	namePath := basePath.Child("name")
+
+	switch key.Type {
+	case ngfAPIv1alpha1.SplitKeyTypeRequestID, ngfAPIv1alpha1.SplitKeyTypeClientIP:
+		if key.Name != nil {
+			allErrs = append(allErrs, field.Forbidden(namePath, "must not be set when type is "+string(key.Type)))
+		}
+	case ngfAPIv1alpha1.SplitKeyTypeCookie:
+		if key.Name == nil {
+			allErrs = append(allErrs, field.Required(namePath, "must be set when type is Cookie"))
+		} else if !splitKeyCookieNameRegexp.MatchString(*key.Name) {
+			allErrs = append(allErrs, field.Invalid(
+				namePath,
+				*key.Name,
+				"must only contain alphanumeric characters or '_'",
+			))
+		}
+	case ngfAPIv1alpha1.SplitKeyTypeHeader:
+		if key.Name == nil {
+			allErrs = append(allErrs, field.Required(namePath, "must be set when type is Header"))
+		} else if !splitKeyHeaderNameRegexp.MatchString(*key.Name) {
+			allErrs = append(allErrs, field.Invalid(
+				namePath,
+				*key.Name,
+				"must only contain alphanumeric characters, '-' or '_'",
+			))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			basePath.Child("type"),
+			key.Type,
+			[]ngfAPIv1alpha1.SplitKeyType{
+				ngfAPIv1alpha1.SplitKeyTypeRequestID,
+				ngfAPIv1alpha1.SplitKeyTypeClientIP,
+				ngfAPIv1alpha1.SplitKeyTypeCookie,
+				ngfAPIv1alpha1.SplitKeyTypeHeader,
+			},
+		))
+	}
+
+	return allErrs
+}
+
 // We generate a mock of ObjectKind so that we can create fake policies and set their GVKs.
 //counterfeiter:generate k8s.io/apimachinery/pkg/runtime/schema.ObjectKind
@@ -0,0 +1,149 @@
+package proxysettings
+
+import (
+	"fmt"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+var tmpl = template.Must(template.New("proxy settings policy").Parse(proxySettingsTemplate))
+
+const proxySettingsTemplate = `
+{{- if .Buffering }}
+proxy_buffering {{ .Buffering }};
+{{- end }}
+{{- if .BufferSize }}
+proxy_buffer_size {{ .BufferSize }};
+{{- end }}
+{{- if .Buffers }}
+proxy_buffers {{ .Buffers.Number }} {{ .Buffers.Size }};
+{{- end }}
+{{- if .BusyBuffersSize }}
+proxy_busy_buffers_size {{ .BusyBuffersSize }};
+{{- end }}
+{{- if .MaxTempFileSize }}
+proxy_max_temp_file_size {{ .MaxTempFileSize }};
+{{- end }}
+{{- if .RequestBuffering }}
+proxy_request_buffering {{ .RequestBuffering }};
+{{- end }}
+`
+
+type templateData struct {
+	Buffers         *ngfAPI.ProxyBuffers
+	BufferSize      ngfAPI.Size
+	BusyBuffersSize ngfAPI.Size
+	MaxTempFileSize ngfAPI.Size
+	// Buffering and RequestBuffering are either "on", "off", or empty if they are not set in the policy.
+	// They are set to "on" explicitly when buffering is not disabled, so that a policy attached to a Route
+	// can enable the buffering that is disabled by a policy attached to its Gateway.
+	Buffering        string
+	RequestBuffering string
+}
+
+// Generator generates nginx configuration based on a proxysettings policy.
+// A policy attached to a Gateway is generated for the server block, and its directives are inherited by
+// the locations of the server unless a policy attached to a Route overrides them in the location block.
+// The buffer sizes are overridden together.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+	webSocketBuffering := webSocketPolicySetsBuffering(pols)
+
+	for _, pol := range pols {
+		psp, ok := pol.(*ngfAPI.ProxySettingsPolicy)
+		if !ok {
+			continue
+		}
+
+		data := newTemplateData(psp.Spec)
+		if webSocketBuffering {
+			// the buffering of a WebSocketPolicy attached to the same Route takes precedence,
+			// otherwise the buffering directives would be duplicated in the location.
+			data.Buffering = ""
+			data.RequestBuffering = ""
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ProxySettingsPolicy_%s_%s.conf", psp.Namespace, psp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, data),
+		})
+	}
+
+	return files
+}
+
+func webSocketPolicySetsBuffering(pols []policies.Policy) bool {
+	for _, pol := range pols {
+		if wsp, ok := pol.(*ngfAPI.WebSocketPolicy); ok && wsp.Spec.Buffering != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newTemplateData(spec ngfAPI.ProxySettingsPolicySpec) templateData {
+	var data templateData
+
+	if spec.Buffering != nil {
+		b := spec.Buffering
+		data.Buffering = onOff(b.Disable)
+
+		// the buffer sizes are set together, with the defaults for the sizes that are not set in the policy,
+		// so that they are not combined with the sizes inherited from the server block.
+		if sizes, ok := effectiveBufferSizes(*b); ok && setsBufferSizes(*b) {
+			data.BufferSize = formatSize(sizes.bufferSize)
+			data.Buffers = &ngfAPI.ProxyBuffers{
+				Number: sizes.buffersNumber,
+				Size:   formatSize(sizes.buffersSize),
+			}
+			data.BusyBuffersSize = formatSize(sizes.busyBuffersSize)
+			data.MaxTempFileSize = formatSize(sizes.maxTempFileSize)
+		}
+	}
+
+	if spec.RequestBuffering != nil {
+		data.RequestBuffering = onOff(spec.RequestBuffering.Disable)
+	}
+
+	return data
+}
+
+// onOff converts a disable setting to the value of an NGINX flag directive.
+// It returns an empty string if the setting is nil.
+func onOff(disable *bool) string {
+	switch {
+	case disable == nil:
+		return ""
+	case *disable:
+		return "off"
+	default:
+		return "on"
+	}
+}
@@ -0,0 +1,191 @@
+package proxysettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/proxysettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		spec          ngfAPIv1alpha1.ProxySettingsPolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "buffering disabled",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				Buffering: &ngfAPIv1alpha1.ProxyBuffering{Disable: helpers.GetPointer(true)},
+			},
+			expStrings:    []string{"proxy_buffering off;"},
+			notExpStrings: []string{"proxy_buffer_size", "proxy_request_buffering"},
+		},
+		{
+			name: "buffering enabled",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				Buffering: &ngfAPIv1alpha1.ProxyBuffering{Disable: helpers.GetPointer(false)},
+			},
+			expStrings: []string{"proxy_buffering on;"},
+		},
+		{
+			name: "buffer sizes populated",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+					BufferSize:      helpers.GetPointer[ngfAPIv1alpha1.Size]("32k"),
+					Buffers:         &ngfAPIv1alpha1.ProxyBuffers{Number: 8, Size: "32k"},
+					BusyBuffersSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("64k"),
+					MaxTempFileSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("0"),
+				},
+			},
+			expStrings: []string{
+				"proxy_buffer_size 32k;",
+				"proxy_buffers 8 32k;",
+				"proxy_busy_buffers_size 64k;",
+				"proxy_max_temp_file_size 0;",
+			},
+			notExpStrings: []string{"proxy_buffering"},
+		},
+		{
+			name: "only buffer size populated",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+					BufferSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("16k"),
+				},
+			},
+			expStrings: []string{
+				"proxy_buffer_size 16k;",
+				"proxy_buffers 8 4k;",
+				"proxy_busy_buffers_size 28k;",
+				"proxy_max_temp_file_size 1g;",
+			},
+			notExpStrings: []string{"proxy_buffering"},
+		},
+		{
+			name: "only buffers populated",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+					Buffers: &ngfAPIv1alpha1.ProxyBuffers{Number: 4, Size: "8k"},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffer_size 4k;",
+				"proxy_buffers 4 8k;",
+				"proxy_busy_buffers_size 16k;",
+				"proxy_max_temp_file_size 1g;",
+			},
+		},
+		{
+			name: "request buffering disabled",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				RequestBuffering: &ngfAPIv1alpha1.ProxyRequestBuffering{Disable: helpers.GetPointer(true)},
+			},
+			expStrings:    []string{"proxy_request_buffering off;"},
+			notExpStrings: []string{"proxy_buffering"},
+		},
+		{
+			name: "request buffering enabled",
+			spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				RequestBuffering: &ngfAPIv1alpha1.ProxyRequestBuffering{Disable: helpers.GetPointer(false)},
+			},
+			expStrings: []string{"proxy_request_buffering on;"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			generator := proxysettings.NewGenerator()
+
+			policy := &ngfAPIv1alpha1.ProxySettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "proxy",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+			pols := []policies.Policy{policy}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForServer(pols, http.Server{}),
+				generator.GenerateForLocation(pols, http.Location{}),
+				generator.GenerateForInternalLocation(pols),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("ProxySettingsPolicy_test_proxy.conf"))
+
+				content := string(resFiles[0].Content)
+				for _, str := range test.expStrings {
+					g.Expect(content).To(ContainSubstring(str))
+				}
+				for _, str := range test.notExpStrings {
+					g.Expect(content).ToNot(ContainSubstring(str))
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateWithWebSocketPolicy(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pols := []policies.Policy{
+		&ngfAPIv1alpha1.ProxySettingsPolicy{
+			Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+				Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+					Disable:    helpers.GetPointer(true),
+					BufferSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("16k"),
+				},
+				RequestBuffering: &ngfAPIv1alpha1.ProxyRequestBuffering{Disable: helpers.GetPointer(true)},
+			},
+		},
+		&ngfAPIv1alpha1.WebSocketPolicy{
+			Spec: ngfAPIv1alpha1.WebSocketPolicySpec{Buffering: helpers.GetPointer(true)},
+		},
+	}
+
+	resFiles := proxysettings.NewGenerator().GenerateForLocation(pols, http.Location{})
+	g.Expect(resFiles).To(HaveLen(1))
+
+	content := string(resFiles[0].Content)
+	g.Expect(content).To(ContainSubstring("proxy_buffer_size 16k;"))
+	g.Expect(content).ToNot(ContainSubstring("proxy_buffering"))
+	g.Expect(content).ToNot(ContainSubstring("proxy_request_buffering"))
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := proxysettings.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
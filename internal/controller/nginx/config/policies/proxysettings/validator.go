@@ -0,0 +1,319 @@
+package proxysettings
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+// Validator validates a ProxySettingsPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a ProxySettingsPolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	psp := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range psp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(psp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates a ProxySettingsPolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two ProxySettingsPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	pspA := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](polA)
+	pspB := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](polB)
+
+	return conflicts(pspA.Spec, pspB.Spec)
+}
+
+func conflicts(a, b ngfAPI.ProxySettingsPolicySpec) bool {
+	if a.Buffering != nil && b.Buffering != nil {
+		if a.Buffering.Disable != nil && b.Buffering.Disable != nil {
+			return true
+		}
+
+		// the buffer sizes are generated together, so two policies that set any of them conflict.
+		if setsBufferSizes(*a.Buffering) && setsBufferSizes(*b.Buffering) {
+			return true
+		}
+	}
+
+	if a.RequestBuffering != nil && b.RequestBuffering != nil {
+		if a.RequestBuffering.Disable != nil && b.RequestBuffering.Disable != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// It also validates the relationship between the buffer sizes. For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ProxySettingsPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Buffering != nil {
+		allErrs = append(allErrs, v.validateBuffering(*spec.Buffering, fieldPath.Child("buffering"))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateBuffering(buffering ngfAPI.ProxyBuffering, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	validateSize := func(size *ngfAPI.Size, path *field.Path) {
+		if size == nil {
+			return
+		}
+
+		if err := v.genericValidator.ValidateNginxSize(string(*size)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path, *size, err.Error()))
+		}
+	}
+
+	validateSize(buffering.BufferSize, fieldPath.Child("bufferSize"))
+	validateSize(buffering.BusyBuffersSize, fieldPath.Child("busyBuffersSize"))
+	validateSize(buffering.MaxTempFileSize, fieldPath.Child("maxTempFileSize"))
+
+	if buffering.Buffers != nil {
+		validateSize(&buffering.Buffers.Size, fieldPath.Child("buffers").Child("size"))
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	return validateBufferSizes(buffering, fieldPath)
+}
+
+// validateBufferSizes validates the relationship between the buffer sizes, which NGINX enforces when it loads the
+// configuration. The sizes are validated together with the defaults of the sizes that are not set, because
+// the generated configuration sets all of them, see effectiveBufferSizes.
+func validateBufferSizes(buffering ngfAPI.ProxyBuffering, fieldPath *field.Path) field.ErrorList {
+	if !setsBufferSizes(buffering) {
+		return nil
+	}
+
+	sizes, ok := effectiveBufferSizes(buffering)
+	if !ok {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	largest := sizes.largestBufferSize()
+	limit := sizes.busyBuffersLimit()
+	limitMsg := fmt.Sprintf("must be less than or equal to the total size of the buffers minus one buffer (%d bytes)", limit)
+
+	switch {
+	case buffering.BusyBuffersSize != nil:
+		busyPath := fieldPath.Child("busyBuffersSize")
+
+		if sizes.busyBuffersSize < largest {
+			allErrs = append(allErrs, field.Invalid(
+				busyPath,
+				*buffering.BusyBuffersSize,
+				fmt.Sprintf("must be equal to or greater than bufferSize and buffers.size (%d bytes)", largest),
+			))
+		}
+
+		if sizes.busyBuffersSize > limit {
+			allErrs = append(allErrs, field.Invalid(
+				busyPath,
+				*buffering.BusyBuffersSize,
+				limitMsg,
+			))
+		}
+	case largest > limit && buffering.BufferSize != nil:
+		// no busy buffers size is both at least the buffer size and within the buffers.
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("bufferSize"),
+			*buffering.BufferSize,
+			limitMsg,
+		))
+	case largest > limit:
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("buffers"),
+			fmt.Sprintf("%d %s", buffering.Buffers.Number, buffering.Buffers.Size),
+			fmt.Sprintf(
+				"the total size of the buffers minus one buffer must be equal to or greater than bufferSize (%d bytes)",
+				sizes.bufferSize,
+			),
+		))
+	}
+
+	if buffering.MaxTempFileSize != nil && sizes.maxTempFileSize != 0 && sizes.maxTempFileSize < largest {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("maxTempFileSize"),
+			*buffering.MaxTempFileSize,
+			fmt.Sprintf("must be 0 or equal to or greater than bufferSize and buffers.size (%d bytes)", largest),
+		))
+	}
+
+	return allErrs
+}
+
+const (
+	// defaultBufferSize is the default size of the buffer for the first part of a response and of each of the
+	// buffers, which NGINX sets to one memory page.
+	defaultBufferSize int64 = 4 << 10
+	// defaultBuffersNumber is the default number of the buffers.
+	defaultBuffersNumber int32 = 8
+	// defaultMaxTempFileSize is the default maximum size of a temporary file.
+	defaultMaxTempFileSize int64 = 1 << 30
+)
+
+// bufferSizes contains the sizes, in bytes, of the buffers used for reading a response from the backend.
+type bufferSizes struct {
+	bufferSize      int64
+	buffersSize     int64
+	busyBuffersSize int64
+	maxTempFileSize int64
+	buffersNumber   int32
+}
+
+// largestBufferSize returns the larger of the buffer size and the size of one of the buffers.
+func (s bufferSizes) largestBufferSize() int64 {
+	return max(s.bufferSize, s.buffersSize)
+}
+
+// busyBuffersLimit returns the maximum busy buffers size, which is the total size of the buffers minus one buffer.
+func (s bufferSizes) busyBuffersLimit() int64 {
+	return int64(s.buffersNumber-1) * s.buffersSize
+}
+
+// setsBufferSizes returns whether any of the buffer sizes is set.
+func setsBufferSizes(buffering ngfAPI.ProxyBuffering) bool {
+	return buffering.BufferSize != nil ||
+		buffering.Buffers != nil ||
+		buffering.BusyBuffersSize != nil ||
+		buffering.MaxTempFileSize != nil
+}
+
+// effectiveBufferSizes returns the buffer sizes of the buffering settings, with the defaults for the sizes
+// that are not set. The buffer sizes are always generated together, so that a policy attached to a Route never
+// combines its sizes with the sizes of a policy attached to the Gateway, which NGINX would check together.
+// The busy buffers size defaults to twice the largest buffer size, as in NGINX, limited to the total size of the
+// buffers minus one buffer, so that setting only a larger buffer size doesn't break the configuration.
+// It returns false if a size can't be parsed.
+func effectiveBufferSizes(buffering ngfAPI.ProxyBuffering) (bufferSizes, bool) {
+	sizes := bufferSizes{
+		bufferSize:      defaultBufferSize,
+		buffersSize:     defaultBufferSize,
+		buffersNumber:   defaultBuffersNumber,
+		maxTempFileSize: defaultMaxTempFileSize,
+	}
+
+	ok := true
+
+	parse := func(size *ngfAPI.Size, bytes *int64) {
+		if size == nil {
+			return
+		}
+
+		n, parsed := parseSize(*size)
+		if !parsed {
+			ok = false
+			return
+		}
+
+		*bytes = n
+	}
+
+	parse(buffering.BufferSize, &sizes.bufferSize)
+	parse(buffering.MaxTempFileSize, &sizes.maxTempFileSize)
+
+	if buffering.Buffers != nil {
+		sizes.buffersNumber = buffering.Buffers.Number
+		parse(&buffering.Buffers.Size, &sizes.buffersSize)
+	}
+
+	largest := sizes.largestBufferSize()
+	sizes.busyBuffersSize = max(largest, min(2*largest, sizes.busyBuffersLimit()))
+	parse(buffering.BusyBuffersSize, &sizes.busyBuffersSize)
+
+	return sizes, ok
+}
+
+// formatSize returns the NGINX size of a number of bytes, using the largest unit that represents it exactly.
+func formatSize(bytes int64) ngfAPI.Size {
+	switch {
+	case bytes != 0 && bytes%(1<<30) == 0:
+		return ngfAPI.Size(fmt.Sprintf("%dg", bytes>>30))
+	case bytes != 0 && bytes%(1<<20) == 0:
+		return ngfAPI.Size(fmt.Sprintf("%dm", bytes>>20))
+	case bytes != 0 && bytes%(1<<10) == 0:
+		return ngfAPI.Size(fmt.Sprintf("%dk", bytes>>10))
+	default:
+		return ngfAPI.Size(strconv.FormatInt(bytes, 10))
+	}
+}
+
+// parseSize returns the number of bytes of a size. It returns false if the size can't be parsed.
+func parseSize(size ngfAPI.Size) (int64, bool) {
+	s := string(size)
+	if s == "" {
+		return 0, false
+	}
+
+	var multiplier int64 = 1
+
+	switch s[len(s)-1] {
+	case 'k':
+		multiplier = 1 << 10
+	case 'm':
+		multiplier = 1 << 20
+	case 'g':
+		multiplier = 1 << 30
+	}
+
+	if multiplier != 1 {
+		s = s[:len(s)-1]
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return n * multiplier, true
+}
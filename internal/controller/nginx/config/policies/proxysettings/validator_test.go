@@ -0,0 +1,388 @@
+package proxysettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/proxysettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy
+
+func createValidPolicy() *ngfAPI.ProxySettingsPolicy {
+	return &ngfAPI.ProxySettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ProxySettingsPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			Buffering: &ngfAPI.ProxyBuffering{
+				Disable:    helpers.GetPointer(false),
+				BufferSize: helpers.GetPointer[ngfAPI.Size]("16k"),
+				Buffers: &ngfAPI.ProxyBuffers{
+					Number: 8,
+					Size:   "16k",
+				},
+				BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("32k"),
+				MaxTempFileSize: helpers.GetPointer[ngfAPI.Size]("1g"),
+			},
+			RequestBuffering: &ngfAPI.ProxyRequestBuffering{
+				Disable: helpers.GetPointer(true),
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ProxySettingsPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	sizeErr := "^\\d{1,4}(k|m|g)?$ (e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is " +
+		"'must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed')"
+
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ProxySettingsPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid sizes",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BufferSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Buffering.BusyBuffersSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Buffering.MaxTempFileSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Buffering.Buffers.Size = "invalid"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"[spec.buffering.bufferSize: Invalid value: \"invalid\": " + sizeErr + ", " +
+						"spec.buffering.busyBuffersSize: Invalid value: \"invalid\": " + sizeErr + ", " +
+						"spec.buffering.maxTempFileSize: Invalid value: \"invalid\": " + sizeErr + ", " +
+						"spec.buffering.buffers.size: Invalid value: \"invalid\": " + sizeErr + "]",
+				),
+			},
+		},
+		{
+			name: "busy buffers size less than buffer size and buffers size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BusyBuffersSize = helpers.GetPointer[ngfAPI.Size]("8k")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"spec.buffering.busyBuffersSize: Invalid value: \"8k\": " +
+						"must be equal to or greater than bufferSize and buffers.size (16384 bytes)",
+				),
+			},
+		},
+		{
+			name: "busy buffers size greater than total size of buffers minus one buffer",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BusyBuffersSize = helpers.GetPointer[ngfAPI.Size]("128k")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"spec.buffering.busyBuffersSize: Invalid value: \"128k\": " +
+						"must be less than or equal to the total size of the buffers minus one buffer (114688 bytes)",
+				),
+			},
+		},
+		{
+			name: "busy buffers size greater than default buffers",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.Buffers = nil
+				p.Spec.Buffering.BusyBuffersSize = helpers.GetPointer[ngfAPI.Size]("1m")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"spec.buffering.busyBuffersSize: Invalid value: \"1m\": " +
+						"must be less than or equal to the total size of the buffers minus one buffer (28672 bytes)",
+				),
+			},
+		},
+		{
+			name: "buffer size greater than default buffers",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BufferSize = helpers.GetPointer[ngfAPI.Size]("64k")
+				p.Spec.Buffering.Buffers = nil
+				p.Spec.Buffering.BusyBuffersSize = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"spec.buffering.bufferSize: Invalid value: \"64k\": " +
+						"must be less than or equal to the total size of the buffers minus one buffer (28672 bytes)",
+				),
+			},
+		},
+		{
+			name: "buffers smaller than default buffer size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BufferSize = nil
+				p.Spec.Buffering.Buffers = &ngfAPI.ProxyBuffers{Number: 2, Size: "2k"}
+				p.Spec.Buffering.BusyBuffersSize = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"spec.buffering.buffers: Invalid value: \"2 2k\": " +
+						"the total size of the buffers minus one buffer must be equal to or greater than " +
+						"bufferSize (4096 bytes)",
+				),
+			},
+		},
+		{
+			name: "max temp file size less than buffer size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.MaxTempFileSize = helpers.GetPointer[ngfAPI.Size]("8k")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"spec.buffering.maxTempFileSize: Invalid value: \"8k\": " +
+						"must be 0 or equal to or greater than bufferSize and buffers.size (16384 bytes)",
+				),
+			},
+		},
+		{
+			name: "valid; busy buffers size equal to total size of buffers minus one buffer",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BusyBuffersSize = helpers.GetPointer[ngfAPI.Size]("112k")
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid; only buffer size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.Buffers = nil
+				p.Spec.Buffering.BusyBuffersSize = nil
+				p.Spec.Buffering.MaxTempFileSize = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid; max temp file size disabled",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.MaxTempFileSize = helpers.GetPointer[ngfAPI.Size]("0")
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := proxysettings.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := proxysettings.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := proxysettings.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.ProxySettingsPolicy
+		polB      *ngfAPI.ProxySettingsPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Disable: helpers.GetPointer(true),
+					},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Buffers:         &ngfAPI.ProxyBuffers{Number: 4, Size: "8k"},
+						BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("16k"),
+						MaxTempFileSize: helpers.GetPointer[ngfAPI.Size]("0"),
+					},
+					RequestBuffering: &ngfAPI.ProxyRequestBuffering{},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "different buffer sizes conflict",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{BufferSize: helpers.GetPointer[ngfAPI.Size]("16k")},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{MaxTempFileSize: helpers.GetPointer[ngfAPI.Size]("0")},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffering disable conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{Disable: helpers.GetPointer(true)},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffer size conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{BufferSize: helpers.GetPointer[ngfAPI.Size]("8k")},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffers conflict",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{Buffers: &ngfAPI.ProxyBuffers{Number: 4, Size: "8k"}},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "busy buffers size conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("16k")},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "max temp file size conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{MaxTempFileSize: helpers.GetPointer[ngfAPI.Size]("0")},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "request buffering conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					RequestBuffering: &ngfAPI.ProxyRequestBuffering{Disable: helpers.GetPointer(false)},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := proxysettings.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := proxysettings.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -0,0 +1,60 @@
+package trafficsplit
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+// Validator validates a TrafficSplitPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a TrafficSplitPolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	tsp := helpers.MustCastObject[*ngfAPI.TrafficSplitPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute, kinds.GRPCRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range tsp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	splitKeyPath := field.NewPath("spec").Child("splitKey")
+	if errs := policies.ValidateSplitKey(tsp.Spec.SplitKey, splitKeyPath); len(errs) > 0 {
+		return []conditions.Condition{conditions.NewPolicyInvalid(errs.ToAggregate().Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates a TrafficSplitPolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two TrafficSplitPolicies conflict.
+// The split key is required, so two TrafficSplitPolicies that target the same Route always conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	_ = helpers.MustCastObject[*ngfAPI.TrafficSplitPolicy](polA)
+	_ = helpers.MustCastObject[*ngfAPI.TrafficSplitPolicy](polB)
+
+	return true
+}
@@ -0,0 +1,218 @@
+package trafficsplit_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/trafficsplit"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy
+
+func createValidPolicy() *ngfAPI.TrafficSplitPolicy {
+	return &ngfAPI.TrafficSplitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.TrafficSplitPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: gatewayv1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			SplitKey: ngfAPI.SplitKey{
+				Type: ngfAPI.SplitKeyTypeCookie,
+				Name: helpers.GetPointer("session_id"),
+			},
+		},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.TrafficSplitPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.TrafficSplitPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.Gateway
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"Gateway\": " +
+					"supported values: \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid cookie name",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey.Name = helpers.GetPointer("session-id")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.splitKey.name: Invalid value: \"session-id\": " +
+					"must only contain alphanumeric characters or '_'"),
+			},
+		},
+		{
+			name: "missing cookie name",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey.Name = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.splitKey.name: Required value: must be set when type is Cookie"),
+			},
+		},
+		{
+			name: "invalid header name",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey.Type = ngfAPI.SplitKeyTypeHeader
+				p.Spec.SplitKey.Name = helpers.GetPointer("x-user;")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.splitKey.name: Invalid value: \"x-user;\": " +
+					"must only contain alphanumeric characters, '-' or '_'"),
+			},
+		},
+		{
+			name: "missing header name",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey.Type = ngfAPI.SplitKeyTypeHeader
+				p.Spec.SplitKey.Name = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.splitKey.name: Required value: must be set when type is Header"),
+			},
+		},
+		{
+			name: "name set with client IP",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey.Type = ngfAPI.SplitKeyTypeClientIP
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.splitKey.name: Forbidden: must not be set when type is ClientIP"),
+			},
+		},
+		{
+			name: "unsupported type",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey.Type = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.splitKey.type: Unsupported value: \"Unsupported\": " +
+					"supported values: \"RequestID\", \"ClientIP\", \"Cookie\", \"Header\""),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid header",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey.Type = ngfAPI.SplitKeyTypeHeader
+				p.Spec.SplitKey.Name = helpers.GetPointer("X-User-ID")
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid request ID",
+			policy: createModifiedPolicy(func(p *ngfAPI.TrafficSplitPolicy) *ngfAPI.TrafficSplitPolicy {
+				p.Spec.SplitKey = ngfAPI.SplitKey{Type: ngfAPI.SplitKeyTypeRequestID}
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	v := trafficsplit.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := trafficsplit.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	v := trafficsplit.NewValidator()
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	v := trafficsplit.NewValidator()
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := trafficsplit.NewValidator()
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
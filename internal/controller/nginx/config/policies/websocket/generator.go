@@ -0,0 +1,94 @@
+package websocket
+
+import (
+	"fmt"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+var tmpl = template.Must(template.New("websocket policy").Parse(webSocketTemplate))
+
+// The Upgrade and Connection headers are set in the location by the main config generator,
+// because they depend on the keep-alive settings of the upstreams.
+const webSocketTemplate = `
+{{- if .ReadTimeout }}
+proxy_read_timeout {{ .ReadTimeout }};
+{{- end }}
+{{- if .SendTimeout }}
+proxy_send_timeout {{ .SendTimeout }};
+{{- end }}
+{{- if .Buffering }}
+proxy_buffering {{ .Buffering }};
+proxy_request_buffering {{ .Buffering }};
+{{- end }}
+`
+
+type templateData struct {
+	ReadTimeout ngfAPI.Duration
+	SendTimeout ngfAPI.Duration
+	// Buffering is either "on", "off", or empty if it is not set in the policy.
+	Buffering string
+}
+
+// Generator generates nginx configuration based on a websocket policy.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		wsp, ok := pol.(*ngfAPI.WebSocketPolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("WebSocketPolicy_%s_%s.conf", wsp.Namespace, wsp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, newTemplateData(wsp.Spec)),
+		})
+	}
+
+	return files
+}
+
+func newTemplateData(spec ngfAPI.WebSocketPolicySpec) templateData {
+	var data templateData
+
+	if spec.ReadTimeout != nil {
+		data.ReadTimeout = *spec.ReadTimeout
+	}
+
+	if spec.SendTimeout != nil {
+		data.SendTimeout = *spec.SendTimeout
+	}
+
+	if spec.Buffering != nil {
+		data.Buffering = "off"
+		if *spec.Buffering {
+			data.Buffering = "on"
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,145 @@
+package websocket_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/websocket"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	readTimeout := helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h")
+	sendTimeout := helpers.GetPointer[ngfAPIv1alpha1.Duration]("10m")
+
+	tests := []struct {
+		name          string
+		spec          ngfAPIv1alpha1.WebSocketPolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "read timeout populated",
+			spec: ngfAPIv1alpha1.WebSocketPolicySpec{
+				ReadTimeout: readTimeout,
+			},
+			expStrings: []string{
+				"proxy_read_timeout 1h;",
+			},
+			notExpStrings: []string{"proxy_send_timeout", "proxy_buffering"},
+		},
+		{
+			name: "send timeout populated",
+			spec: ngfAPIv1alpha1.WebSocketPolicySpec{
+				SendTimeout: sendTimeout,
+			},
+			expStrings: []string{
+				"proxy_send_timeout 10m;",
+			},
+			notExpStrings: []string{"proxy_read_timeout", "proxy_buffering"},
+		},
+		{
+			name: "buffering disabled",
+			spec: ngfAPIv1alpha1.WebSocketPolicySpec{
+				Buffering: helpers.GetPointer(false),
+			},
+			expStrings: []string{
+				"proxy_buffering off;",
+				"proxy_request_buffering off;",
+			},
+		},
+		{
+			name: "buffering enabled",
+			spec: ngfAPIv1alpha1.WebSocketPolicySpec{
+				Buffering: helpers.GetPointer(true),
+			},
+			expStrings: []string{
+				"proxy_buffering on;",
+				"proxy_request_buffering on;",
+			},
+		},
+		{
+			name: "upgrade only",
+			spec: ngfAPIv1alpha1.WebSocketPolicySpec{
+				Upgrade: helpers.GetPointer(true),
+			},
+			notExpStrings: []string{"proxy_read_timeout", "proxy_send_timeout", "proxy_buffering"},
+		},
+		{
+			name: "all fields populated",
+			spec: ngfAPIv1alpha1.WebSocketPolicySpec{
+				Upgrade:     helpers.GetPointer(true),
+				ReadTimeout: readTimeout,
+				SendTimeout: sendTimeout,
+				Buffering:   helpers.GetPointer(false),
+			},
+			expStrings: []string{
+				"proxy_read_timeout 1h;",
+				"proxy_send_timeout 10m;",
+				"proxy_buffering off;",
+				"proxy_request_buffering off;",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			generator := websocket.NewGenerator()
+
+			policy := &ngfAPIv1alpha1.WebSocketPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ws",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForLocation([]policies.Policy{policy}, http.Location{}),
+				generator.GenerateForInternalLocation([]policies.Policy{policy}),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("WebSocketPolicy_test_ws.conf"))
+
+				content := string(resFiles[0].Content)
+				for _, str := range test.expStrings {
+					g.Expect(content).To(ContainSubstring(str))
+				}
+				for _, str := range test.notExpStrings {
+					g.Expect(content).ToNot(ContainSubstring(str))
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := websocket.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha1.WebSocketPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,102 @@
+package websocket
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+// Validator validates a WebSocketPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a WebSocketPolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	wsp := helpers.MustCastObject[*ngfAPI.WebSocketPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range wsp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(wsp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates a WebSocketPolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two WebSocketPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	wspA := helpers.MustCastObject[*ngfAPI.WebSocketPolicy](polA)
+	wspB := helpers.MustCastObject[*ngfAPI.WebSocketPolicy](polB)
+
+	return conflicts(wspA.Spec, wspB.Spec)
+}
+
+func conflicts(a, b ngfAPI.WebSocketPolicySpec) bool {
+	if a.Upgrade != nil && b.Upgrade != nil {
+		return true
+	}
+
+	if a.ReadTimeout != nil && b.ReadTimeout != nil {
+		return true
+	}
+
+	if a.SendTimeout != nil && b.SendTimeout != nil {
+		return true
+	}
+
+	return a.Buffering != nil && b.Buffering != nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.WebSocketPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.ReadTimeout != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*spec.ReadTimeout)); err != nil {
+			path := fieldPath.Child("readTimeout")
+
+			allErrs = append(allErrs, field.Invalid(path, *spec.ReadTimeout, err.Error()))
+		}
+	}
+
+	if spec.SendTimeout != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*spec.SendTimeout)); err != nil {
+			path := fieldPath.Child("sendTimeout")
+
+			allErrs = append(allErrs, field.Invalid(path, *spec.SendTimeout, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
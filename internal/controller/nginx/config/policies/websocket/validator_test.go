@@ -0,0 +1,225 @@
+package websocket_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies/websocket"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.WebSocketPolicy) *ngfAPI.WebSocketPolicy
+
+func createValidPolicy() *ngfAPI.WebSocketPolicy {
+	return &ngfAPI.WebSocketPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.WebSocketPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Upgrade:     helpers.GetPointer(true),
+			ReadTimeout: helpers.GetPointer[ngfAPI.Duration]("1h"),
+			SendTimeout: helpers.GetPointer[ngfAPI.Duration]("10m"),
+			Buffering:   helpers.GetPointer(false),
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.WebSocketPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.WebSocketPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.WebSocketPolicy) *ngfAPI.WebSocketPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.WebSocketPolicy) *ngfAPI.WebSocketPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid durations",
+			policy: createModifiedPolicy(func(p *ngfAPI.WebSocketPolicy) *ngfAPI.WebSocketPolicy {
+				p.Spec.ReadTimeout = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.SendTimeout = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid(
+					"[spec.readTimeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
+						"spec.sendTimeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := websocket.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := websocket.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := websocket.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.WebSocketPolicy
+		polB      *ngfAPI.WebSocketPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.WebSocketPolicy{
+				Spec: ngfAPI.WebSocketPolicySpec{
+					Upgrade:     helpers.GetPointer(true),
+					ReadTimeout: helpers.GetPointer[ngfAPI.Duration]("1h"),
+				},
+			},
+			polB: &ngfAPI.WebSocketPolicy{
+				Spec: ngfAPI.WebSocketPolicySpec{
+					SendTimeout: helpers.GetPointer[ngfAPI.Duration]("10m"),
+					Buffering:   helpers.GetPointer(false),
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "upgrade conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.WebSocketPolicy{
+				Spec: ngfAPI.WebSocketPolicySpec{
+					Upgrade: helpers.GetPointer(false),
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "read timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.WebSocketPolicy{
+				Spec: ngfAPI.WebSocketPolicySpec{
+					ReadTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "send timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.WebSocketPolicy{
+				Spec: ngfAPI.WebSocketPolicySpec{
+					SendTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffering conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.WebSocketPolicy{
+				Spec: ngfAPI.WebSocketPolicySpec{
+					Buffering: helpers.GetPointer(true),
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := websocket.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := websocket.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -24,6 +24,42 @@ const (
 	rootPath             = "/"
 )
 
+// quotedStringEscaper escapes the characters that have a special meaning in a double-quoted NGINX string.
+// NGINX unescapes them when it parses the configuration, so the original value is preserved.
+var quotedStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+const (
+	// grpcRequestURIRewrite restores the original request URI in internal gRPC locations, because grpc_pass
+	// does not support URIs and the internal location path must not be sent to the backend.
+	grpcRequestURIRewrite = "^ $request_uri break"
+	// grpcPathRegex always matches a gRPC request path, capturing the service and the method.
+	grpcPathRegex = "^/?([^/]*)/?(.*)$"
+	// grpcWebDefaultMaxAge is the default number of seconds that the result of a gRPC-Web preflight request
+	// can be cached.
+	grpcWebDefaultMaxAge = 86400
+)
+
+// grpcWebAllowHeaders are the request headers used by gRPC-Web clients.
+var grpcWebAllowHeaders = []string{
+	"keep-alive",
+	"user-agent",
+	"cache-control",
+	"content-type",
+	"content-transfer-encoding",
+	"x-accept-content-transfer-encoding",
+	"x-accept-response-streaming",
+	"x-user-agent",
+	"x-grpc-web",
+	"grpc-timeout",
+}
+
+// grpcContentTypeHeader sets the content type of gRPC-Web requests to the gRPC content type with the same
+// message encoding. The content type of native gRPC requests is not changed.
+var grpcContentTypeHeader = http.Header{
+	Name:  "Content-Type",
+	Value: "$grpc_upstream_content_type",
+}
+
 var grpcAuthorityHeader = http.Header{
 	Name:  "Authority",
 	Value: "$gw_api_compliant_host",
@@ -39,11 +75,26 @@ var unsetHTTPConnectionHeader = http.Header{
 	Value: "",
 }
 
+var keepAliveHTTPConnectionHeader = http.Header{
+	Name:  "Connection",
+	Value: "$connection_upgrade_keepalive",
+}
+
+var closeHTTPConnectionHeader = http.Header{
+	Name:  "Connection",
+	Value: "close",
+}
+
 var httpUpgradeHeader = http.Header{
 	Name:  "Upgrade",
 	Value: "$http_upgrade",
 }
 
+var unsetHTTPUpgradeHeader = http.Header{
+	Name:  "Upgrade",
+	Value: "",
+}
+
 func (g GeneratorImpl) newExecuteServersFunc(
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
@@ -118,21 +169,29 @@ func createServers(
 		sharedTLSPorts[passthroughServer.Port] = struct{}{}
 	}
 
+	nativeMatching := conf.BaseHTTPConfig.NativeHTTPMatching
+
 	for idx, s := range conf.HTTPServers {
-		serverID := fmt.Sprintf("%d", idx)
-		httpServer, matchPairs := createServer(s, serverID, generator, keepAliveCheck)
+		serverID := httpServerID(idx)
+		httpServer, matchPairs := createServer(s, serverID, generator, keepAliveCheck, nativeMatching)
 		servers = append(servers, httpServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
 
 	for idx, s := range conf.SSLServers {
-		serverID := fmt.Sprintf("SSL_%d", idx)
+		serverID := sslServerID(idx)
 
-		sslServer, matchPairs := createSSLServer(s, serverID, generator, keepAliveCheck)
+		sslServer, matchPairs := createSSLServer(s, serverID, generator, keepAliveCheck, nativeMatching)
 		if _, portInUse := sharedTLSPorts[s.Port]; portInUse {
 			sslServer.Listen = getSocketNameHTTPS(s.Port)
 			sslServer.IsSocket = true
 		}
+		if conf.BaseHTTPConfig.HTTP3Settings.Enabled {
+			sslServer.HTTP3 = createHTTP3(s.Port, conf.BaseHTTPConfig.HTTP3Settings)
+		}
+		if sslServer.SSL != nil {
+			sslServer.SSL.Stapling = conf.BaseHTTPConfig.TLSSettings.OCSPStapling
+		}
 		servers = append(servers, sslServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
@@ -140,11 +199,52 @@ func createServers(
 	return servers, finalMatchPairs
 }
 
+// httpServerID returns the ID of the HTTP server with the given index. The ID is used to build the keys
+// of the HTTP matches of the server.
+func httpServerID(idx int) string {
+	return strconv.Itoa(idx)
+}
+
+// sslServerID returns the ID of the SSL server with the given index. The ID is used to build the keys
+// of the HTTP matches of the server.
+func sslServerID(idx int) string {
+	return "SSL_" + strconv.Itoa(idx)
+}
+
+// createHTTP3 creates the HTTP/3 configuration of an SSL server. QUIC connections are accepted on the UDP port
+// with the same number as the TCP port of the server, even if the server listens on a socket, because
+// TLS passthrough only uses TCP.
+func createHTTP3(port int32, settings dataplane.HTTP3Settings) *http.HTTP3 {
+	return &http.HTTP3{
+		Listen: fmt.Sprint(port),
+		AltSvc: fmt.Sprintf(`h3=":%d"; ma=%d`, port, settings.AltSvcMaxAge),
+	}
+}
+
+func createSSL(ssl *dataplane.SSL) *http.SSL {
+	httpSSL := &http.SSL{
+		Certificate:    generatePEMFileName(ssl.KeyPairID),
+		CertificateKey: generatePEMFileName(ssl.KeyPairID),
+		Protocols:      strings.Join(ssl.Protocols, " "),
+		Ciphers:        ssl.Ciphers,
+	}
+
+	if ssl.PreferServerCiphers != nil {
+		httpSSL.PreferServerCiphers = "off"
+		if *ssl.PreferServerCiphers {
+			httpSSL.PreferServerCiphers = "on"
+		}
+	}
+
+	return httpSSL
+}
+
 func createSSLServer(
 	virtualServer dataplane.VirtualServer,
 	serverID string,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	nativeMatching bool,
 ) (http.Server, httpMatchPairs) {
 	listen := fmt.Sprint(virtualServer.Port)
 	if virtualServer.IsDefault {
@@ -154,17 +254,19 @@ func createSSLServer(
 		}, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck)
+	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck, nativeMatching)
+	locs = append(locs, createErrorPageLocations(&virtualServer, keepAliveCheck)...)
+	locs = append(locs, createMirrorSplitLocations(&virtualServer)...)
+	errorPages, interceptErrors := createErrorPages(virtualServer.ErrorPages)
 
 	server := http.Server{
-		ServerName: virtualServer.Hostname,
-		SSL: &http.SSL{
-			Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
-			CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
-		},
-		Locations: locs,
-		GRPC:      grpc,
-		Listen:    listen,
+		ServerName:      virtualServer.Hostname,
+		SSL:             createSSL(virtualServer.SSL),
+		Locations:       locs,
+		ErrorPages:      errorPages,
+		InterceptErrors: interceptErrors,
+		GRPC:            grpc,
+		Listen:          listen,
 	}
 
 	policyIncludes := createIncludesFromPolicyGenerateResult(
@@ -184,6 +286,7 @@ func createServer(
 	serverID string,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	nativeMatching bool,
 ) (http.Server, httpMatchPairs) {
 	listen := fmt.Sprint(virtualServer.Port)
 
@@ -194,13 +297,18 @@ func createServer(
 		}, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck)
+	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck, nativeMatching)
+	locs = append(locs, createErrorPageLocations(&virtualServer, keepAliveCheck)...)
+	locs = append(locs, createMirrorSplitLocations(&virtualServer)...)
+	errorPages, interceptErrors := createErrorPages(virtualServer.ErrorPages)
 
 	server := http.Server{
-		ServerName: virtualServer.Hostname,
-		Locations:  locs,
-		Listen:     listen,
-		GRPC:       grpc,
+		ServerName:      virtualServer.Hostname,
+		Locations:       locs,
+		ErrorPages:      errorPages,
+		InterceptErrors: interceptErrors,
+		Listen:          listen,
+		GRPC:            grpc,
 	}
 
 	policyIncludes := createIncludesFromPolicyGenerateResult(
@@ -231,6 +339,7 @@ func createLocations(
 	serverID string,
 	generator policies.Generator,
 	keepAliveCheck keepAliveChecker,
+	nativeMatching bool,
 ) ([]http.Location, httpMatchPairs, bool) {
 	maxLocs, pathsAndTypes := getMaxLocationCountAndPathMap(server.PathRules)
 	locs := make([]http.Location, 0, maxLocs)
@@ -242,7 +351,7 @@ func createLocations(
 	for pathRuleIdx, rule := range server.PathRules {
 		matches := make([]routeMatch, 0, len(rule.MatchRules))
 
-		if rule.Path == rootPath {
+		if rule.Path == rootPath && rule.PathType != dataplane.PathTypeRegularExpression {
 			rootPathExists = true
 		}
 
@@ -250,11 +359,15 @@ func createLocations(
 			grpcServer = true
 		}
 
+		errorPages, interceptErrors := createErrorPages(rule.ErrorPages)
+
 		extLocations := initializeExternalLocations(rule, pathsAndTypes)
 		for i := range extLocations {
 			extLocations[i].Includes = createIncludesFromPolicyGenerateResult(
 				generator.GenerateForLocation(rule.Policies, extLocations[i]),
 			)
+			extLocations[i].ErrorPages = errorPages
+			extLocations[i].InterceptErrors = interceptErrors
 		}
 
 		if !needsInternalLocations(rule) {
@@ -281,6 +394,8 @@ func createLocations(
 			intLocation.Includes = createIncludesFromPolicyGenerateResult(
 				generator.GenerateForInternalLocation(rule.Policies),
 			)
+			intLocation.ErrorPages = errorPages
+			intLocation.InterceptErrors = interceptErrors
 
 			intLocation = updateLocation(
 				r.Filters,
@@ -296,7 +411,19 @@ func createLocations(
 			matches = append(matches, match)
 		}
 
-		httpMatchKey := serverID + "_" + strconv.Itoa(pathRuleIdx)
+		httpMatchKey := createHTTPMatchKey(serverID, pathRuleIdx)
+		if nativeMatching && supportsNativeHTTPMatching(rule) {
+			// the matches are evaluated by the maps built in buildHTTPMatchMaps.
+			for i := range extLocations {
+				extLocations[i].Type = http.NativeRedirectLocationType
+				extLocations[i].HTTPMatchVar = "$" + createHTTPMatchVariableName(httpMatchKey)
+			}
+
+			locs = append(locs, extLocations...)
+			locs = append(locs, internalLocations...)
+			continue
+		}
+
 		for i := range extLocations {
 			// FIXME(sberman): De-dupe matches and associated locations
 			// so we don't need nginx/njs to perform unnecessary matching.
@@ -316,6 +443,11 @@ func createLocations(
 	return locs, matchPairs, grpcServer
 }
 
+// createHTTPMatchKey returns the key of the HTTP matches of a path rule of a server.
+func createHTTPMatchKey(serverID string, pathRuleIdx int) string {
+	return serverID + "_" + strconv.Itoa(pathRuleIdx)
+}
+
 func needsInternalLocations(rule dataplane.PathRule) bool {
 	if len(rule.MatchRules) > 1 {
 		return true
@@ -414,8 +546,13 @@ func initializeInternalLocation(
 	match dataplane.Match,
 	grpc bool,
 ) (http.Location, routeMatch) {
-	path := fmt.Sprintf("%s-rule%d-route%d", http.InternalRoutePathPrefix, pathruleIdx, matchRuleIdx)
-	return createMatchLocation(path, grpc), createRouteMatch(match, path)
+	path := createInternalLocationPath(pathruleIdx, matchRuleIdx)
+	// the location uses an exact match, so that regular expression locations can't take precedence over it.
+	return createMatchLocation(exactPath(path), grpc), createRouteMatch(match, path)
+}
+
+func createInternalLocationPath(pathRuleIdx, matchRuleIdx int) string {
+	return fmt.Sprintf("%s-rule%d-route%d", http.InternalRoutePathPrefix, pathRuleIdx, matchRuleIdx)
 }
 
 // updateLocation updates a location with any relevant configurations, like proxy_pass, filters, tls settings, etc.
@@ -436,7 +573,7 @@ func updateLocation(
 	if strings.HasPrefix(path, http.InternalMirrorRoutePathPrefix) {
 		location.Type = http.InternalLocationType
 		if grpc {
-			location.Rewrites = []string{"^ $request_uri break"}
+			location.Rewrites = []string{grpcRequestURIRewrite}
 		}
 	}
 
@@ -451,6 +588,15 @@ func updateLocation(
 		return location
 	}
 
+	if filters.DirectResponse != nil {
+		location.DefaultType = filters.DirectResponse.ContentType
+		location.Return = &http.Return{
+			Code: http.StatusCode(filters.DirectResponse.StatusCode),
+			Body: quotedStringEscaper.Replace(filters.DirectResponse.Body),
+		}
+		return location
+	}
+
 	rewrites := createRewritesValForRewriteFilter(filters.RequestURLRewrite, path)
 	if rewrites != nil {
 		if location.Type == http.InternalLocationType && rewrites.InternalRewrite != "" {
@@ -461,18 +607,32 @@ func updateLocation(
 		}
 	}
 
+	if grpc && filters.GRPCRewrite != nil {
+		location.Rewrites = createRewritesForGRPCRewriteFilter(filters.GRPCRewrite, location.Rewrites)
+	}
+
+	if grpc && filters.GRPCWeb != nil {
+		location.GRPCWeb = createGRPCWeb(filters.GRPCWeb)
+	}
+
 	for _, filter := range filters.RequestMirrors {
-		if filter.Target != nil {
-			location.MirrorPaths = append(location.MirrorPaths, *filter.Target)
+		if mirrorPath := createMirrorPath(filter); mirrorPath != "" {
+			location.MirrorPaths = append(location.MirrorPaths, mirrorPath)
 		}
 	}
 
 	extraHeaders := make([]http.Header, 0, 3)
 	if grpc {
 		extraHeaders = append(extraHeaders, grpcAuthorityHeader)
+		if filters.GRPCWeb != nil {
+			extraHeaders = append(extraHeaders, grpcContentTypeHeader)
+		}
 	} else {
-		extraHeaders = append(extraHeaders, httpUpgradeHeader)
-		extraHeaders = append(extraHeaders, getConnectionHeader(keepAliveCheck, matchRule.BackendGroup.Backends))
+		extraHeaders = append(extraHeaders, getUpgradeHeader(matchRule.Upgrade))
+		extraHeaders = append(
+			extraHeaders,
+			getConnectionHeader(keepAliveCheck, matchRule.BackendGroup.Backends, matchRule.Upgrade),
+		)
 	}
 
 	proxySetHeaders := generateProxySetHeaders(&matchRule.Filters, createBaseProxySetHeaders(extraHeaders...))
@@ -773,10 +933,66 @@ func createProxyPass(
 	return protocol + "://" + backendName + requestURI
 }
 
+// createRewritesForGRPCRewriteFilter returns the rewrites of a gRPC location with the service and method
+// of the request replaced according to the filter.
+// The rewrite that restores the original request URI in internal locations no longer stops the processing,
+// so that the service and method rewrite is applied to the original request URI.
+func createRewritesForGRPCRewriteFilter(filter *dataplane.GRPCRewriteFilter, rewrites []string) []string {
+	if filter.Service == nil && filter.Method == nil {
+		return rewrites
+	}
+
+	service, method := "$1", "$2"
+	if filter.Service != nil {
+		service = *filter.Service
+	}
+	if filter.Method != nil {
+		method = *filter.Method
+	}
+
+	result := make([]string, 0, len(rewrites)+1)
+	for _, r := range rewrites {
+		if r == grpcRequestURIRewrite {
+			r = strings.TrimSuffix(r, " break")
+		}
+		result = append(result, r)
+	}
+
+	return append(result, fmt.Sprintf("%s /%s/%s break", grpcPathRegex, service, method))
+}
+
+func createGRPCWeb(filter *dataplane.GRPCWebFilter) *http.GRPCWeb {
+	if filter.CORS == nil {
+		return &http.GRPCWeb{}
+	}
+
+	maxAge := int32(grpcWebDefaultMaxAge)
+	if filter.CORS.MaxAge != nil {
+		maxAge = *filter.CORS.MaxAge
+	}
+
+	allowHeaders := make([]string, 0, len(grpcWebAllowHeaders)+len(filter.CORS.AllowHeaders))
+	allowHeaders = append(allowHeaders, grpcWebAllowHeaders...)
+	for _, h := range filter.CORS.AllowHeaders {
+		h = strings.ToLower(h)
+		if !slices.Contains(allowHeaders, h) {
+			allowHeaders = append(allowHeaders, h)
+		}
+	}
+
+	return &http.GRPCWeb{
+		CORS: &http.GRPCWebCORS{
+			AllowOrigins: strings.Join(filter.CORS.AllowOrigins, " "),
+			AllowHeaders: strings.Join(allowHeaders, ", "),
+			MaxAge:       maxAge,
+		},
+	}
+}
+
 func createMatchLocation(path string, grpc bool) http.Location {
 	var rewrites []string
 	if grpc {
-		rewrites = []string{"^ $request_uri break"}
+		rewrites = []string{grpcRequestURIRewrite}
 	}
 
 	loc := http.Location{
@@ -801,6 +1017,14 @@ func generateProxySetHeaders(
 		}
 	}
 
+	if filters != nil && filters.GRPCRewrite != nil && filters.GRPCRewrite.Hostname != nil {
+		for i, header := range baseHeaders {
+			if header.Name == "Host" || header.Name == grpcAuthorityHeader.Name {
+				baseHeaders[i].Value = *filters.GRPCRewrite.Hostname
+			}
+		}
+	}
+
 	if filters == nil || filters.RequestHeaderModifiers == nil {
 		return baseHeaders
 	}
@@ -881,11 +1105,19 @@ func exactPath(path string) string {
 	return fmt.Sprintf("= %s", path)
 }
 
+// regexPath returns the location path for a case-sensitive regular expression.
+// The regular expression is quoted, because it may contain characters like `{`, `}` or `;`.
+func regexPath(path string) string {
+	return fmt.Sprintf(`~ "%s"`, quotedStringEscaper.Replace(path))
+}
+
 // createPath builds the location path depending on the path type.
 func createPath(rule dataplane.PathRule) string {
 	switch rule.PathType {
 	case dataplane.PathTypeExact:
 		return exactPath(rule.Path)
+	case dataplane.PathTypeRegularExpression:
+		return regexPath(rule.Path)
 	default:
 		return rule.Path
 	}
@@ -951,14 +1183,43 @@ func createBaseProxySetHeaders(extraHeaders ...http.Header) []http.Header {
 	return baseHeaders
 }
 
-func getConnectionHeader(keepAliveCheck keepAliveChecker, backends []dataplane.Backend) http.Header {
-	for _, backend := range backends {
-		if keepAliveCheck(backend.UpstreamName) {
-			// if keep-alive settings are enabled on any upstream, the connection header value
-			// must be empty for the location
-			return unsetHTTPConnectionHeader
-		}
+// getConnectionHeader returns the Connection header for a location. The upgrade parameter is set by
+// a WebSocketPolicy; if it is nil, upgrades are only passed to upstreams that don't use keep-alive connections.
+func getConnectionHeader(
+	keepAliveCheck keepAliveChecker,
+	backends []dataplane.Backend,
+	upgrade *bool,
+) http.Header {
+	keepAlive := slices.ContainsFunc(backends, func(backend dataplane.Backend) bool {
+		return keepAliveCheck(backend.UpstreamName)
+	})
+
+	switch {
+	case upgrade == nil && keepAlive:
+		// if keep-alive settings are enabled on any upstream, the connection header value
+		// must be empty for the location
+		return unsetHTTPConnectionHeader
+	case upgrade == nil:
+		return httpConnectionHeader
+	case *upgrade && keepAlive:
+		// pass upgrades explicitly, but keep the connection header empty for other requests,
+		// so that the connections to the upstream are kept alive.
+		return keepAliveHTTPConnectionHeader
+	case *upgrade:
+		return httpConnectionHeader
+	case keepAlive:
+		return unsetHTTPConnectionHeader
+	default:
+		return closeHTTPConnectionHeader
+	}
+}
+
+// getUpgradeHeader returns the Upgrade header for a location. If upgrades are disabled by a WebSocketPolicy,
+// the Upgrade header of the request is not passed to the upstream.
+func getUpgradeHeader(upgrade *bool) http.Header {
+	if upgrade != nil && !*upgrade {
+		return unsetHTTPUpgradeHeader
 	}
 
-	return httpConnectionHeader
+	return httpUpgradeHeader
 }
@@ -12,6 +12,14 @@ server {
         {{- if and ($.IPFamily.IPv6) (not $s.IsSocket) }}
     listen [::]:{{ $s.Listen }} ssl default_server{{ $.RewriteClientIP.ProxyProtocol }};
         {{- end }}
+        {{- if $s.HTTP3 }}
+          {{- if $.IPFamily.IPv4 }}
+    listen {{ $s.HTTP3.Listen }} quic reuseport default_server;
+          {{- end }}
+          {{- if $.IPFamily.IPv6 }}
+    listen [::]:{{ $s.HTTP3.Listen }} quic reuseport default_server;
+          {{- end }}
+        {{- end }}
     ssl_reject_handshake on;
         {{- range $address := $.RewriteClientIP.RealIPFrom }}
     set_real_ip_from {{ $address }};
@@ -52,8 +60,28 @@ server {
           {{- if and ($.IPFamily.IPv6) (not $s.IsSocket) }}
     listen [::]:{{ $s.Listen }} ssl{{ $.RewriteClientIP.ProxyProtocol }};
           {{- end }}
+          {{- if $s.HTTP3 }}
+            {{- if $.IPFamily.IPv4 }}
+    listen {{ $s.HTTP3.Listen }} quic;
+            {{- end }}
+            {{- if $.IPFamily.IPv6 }}
+    listen [::]:{{ $s.HTTP3.Listen }} quic;
+            {{- end }}
+          {{- end }}
     ssl_certificate {{ $s.SSL.Certificate }};
     ssl_certificate_key {{ $s.SSL.CertificateKey }};
+          {{- if $s.SSL.Protocols }}
+    ssl_protocols {{ $s.SSL.Protocols }};
+          {{- end }}
+          {{- if $s.SSL.Ciphers }}
+    ssl_ciphers {{ $s.SSL.Ciphers }};
+          {{- end }}
+          {{- if $s.SSL.PreferServerCiphers }}
+    ssl_prefer_server_ciphers {{ $s.SSL.PreferServerCiphers }};
+          {{- end }}
+          {{- if $s.SSL.Stapling }}
+    ssl_stapling on;
+          {{- end }}
 
     if ($ssl_server_name != $host) {
         return 421;
@@ -87,16 +115,64 @@ server {
     real_ip_recursive on;
         {{- end }}
 
+        {{- if $s.InterceptErrors }}
+    proxy_intercept_errors {{ $s.InterceptErrors }};
+        {{- end }}
+        {{- range $e := $s.ErrorPages }}
+    error_page{{ range $c := $e.Codes }} {{ $c }}{{ end }}{{ if $e.ResponseCode }} ={{ $e.ResponseCode }}{{ end }} {{ $e.URI }};
+        {{- end }}
+
         {{ range $l := $s.Locations }}
     location {{ $l.Path }} {
         {{ if eq $l.Type "internal" -}}
         internal;
         {{ end }}
 
+        {{- if $s.HTTP3 }}
+        add_header Alt-Svc '{{ $s.HTTP3.AltSvc }}' always;
+        {{- end }}
+
         {{- range $i := $l.Includes }}
         include {{ $i.Name }};
         {{- end -}}
 
+        {{- if $l.InterceptErrors }}
+        proxy_intercept_errors {{ $l.InterceptErrors }};
+        {{- end }}
+        {{- range $e := $l.ErrorPages }}
+        error_page{{ range $c := $e.Codes }} {{ $c }}{{ end }}{{ if $e.ResponseCode }} ={{ $e.ResponseCode }}{{ end }} {{ $e.URI }};
+        {{- end }}
+
+        {{- if $l.DefaultType }}
+        default_type "{{ $l.DefaultType }}";
+        {{- end }}
+        {{- if $l.Alias }}
+        alias {{ $l.Alias }};
+        {{- end }}
+
+        {{- if $l.GRPCWeb }}
+        set $grpc_web on;
+            {{- if $l.GRPCWeb.CORS }}
+        set $grpc_web_allow_origins "{{ $l.GRPCWeb.CORS.AllowOrigins }}";
+        if ($request_method = OPTIONS) {
+            add_header Access-Control-Allow-Origin $grpc_web_allow_origin always;
+            add_header Access-Control-Allow-Methods "POST, OPTIONS" always;
+            add_header Access-Control-Allow-Headers "{{ $l.GRPCWeb.CORS.AllowHeaders }}" always;
+            add_header Access-Control-Max-Age {{ $l.GRPCWeb.CORS.MaxAge }} always;
+            add_header Vary Origin always;
+            return 204;
+        }
+        add_header Access-Control-Allow-Origin $grpc_web_allow_origin always;
+        add_header Access-Control-Expose-Headers $grpc_web_expose_headers always;
+        add_header Vary Origin always;
+            {{- end }}
+        if ($http_content_type ~* "^application/grpc-web-text") {
+            return 415;
+        }
+        js_header_filter grpcweb.headerFilter;
+        js_body_filter grpcweb.bodyFilter buffer_type=buffer;
+        {{- end }}
+
         {{ range $r := $l.Rewrites }}
         rewrite {{ $r }};
         {{- end }}
@@ -114,6 +190,13 @@ server {
         js_content httpmatches.redirect;
         {{- end }}
 
+        {{- if eq $l.Type "native-redirect" }}
+        if ({{ $l.HTTPMatchVar }} = "") {
+            return 404;
+        }
+        rewrite ^ {{ $l.HTTPMatchVar }} last;
+        {{- end }}
+
         {{ $proxyOrGRPC := "proxy" }}{{ if $l.GRPC }}{{ $proxyOrGRPC = "grpc" }}{{ end }}
 
         {{- if $l.GRPC }}
@@ -342,6 +342,170 @@ func TestExecuteServers_IPFamily(t *testing.T) {
 	}
 }
 
+func TestExecuteServers_HTTP3(t *testing.T) {
+	t.Parallel()
+	sslServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      8443,
+		},
+		{
+			Hostname: "example.com",
+			SSL: &dataplane.SSL{
+				KeyPairID: "test-keypair",
+			},
+			Port: 8443,
+		},
+	}
+	passThroughServers := []dataplane.Layer4VirtualServer{
+		{
+			IsDefault: true,
+			Hostname:  "*.example.com",
+			Port:      8443,
+		},
+	}
+	http3Settings := dataplane.HTTP3Settings{
+		Enabled:      true,
+		AltSvcMaxAge: 3600,
+	}
+
+	tests := []struct {
+		msg                string
+		expectedHTTPConfig map[string]int
+		config             dataplane.Configuration
+	}{
+		{
+			msg: "http3 disabled",
+			config: dataplane.Configuration{
+				SSLServers: sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.Dual,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"quic":    0,
+				"Alt-Svc": 0,
+			},
+		},
+		{
+			msg: "http3 enabled with Dual IP family",
+			config: dataplane.Configuration{
+				SSLServers: sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily:      dataplane.Dual,
+					HTTP3Settings: http3Settings,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen 8443 ssl default_server;":                     1,
+				"listen 8443 quic reuseport default_server;":          1,
+				"listen [::]:8443 quic reuseport default_server;":     1,
+				"listen 8443 ssl;":                                    1,
+				"listen 8443 quic;":                                   1,
+				"listen [::]:8443 quic;":                              1,
+				`add_header Alt-Svc 'h3=":8443"; ma=3600' always;`:    1,
+				"listen unix:/var/run/nginx/https8443.sock ssl;":      0,
+				"listen unix:/var/run/nginx/https8443.sock quic;":     0,
+				"listen 8443 ssl default_server proxy_protocol;":      0,
+				"listen 8443 quic reuseport default_server proxy_pro": 0,
+			},
+		},
+		{
+			msg: "http3 enabled with IPv4 IP family and a TLS passthrough server on the same port",
+			config: dataplane.Configuration{
+				SSLServers:            sslServers,
+				TLSPassthroughServers: passThroughServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily:      dataplane.IPv4,
+					HTTP3Settings: http3Settings,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen unix:/var/run/nginx/https8443.sock ssl default_server;": 1,
+				"listen unix:/var/run/nginx/https8443.sock ssl;":                1,
+				"listen 8443 quic reuseport default_server;":                    1,
+				"listen 8443 quic;":                                1,
+				"listen [::]:8443 quic":                            0,
+				`add_header Alt-Svc 'h3=":8443"; ma=3600' always;`: 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			results := gen.executeServers(test.config, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+
+			g.Expect(results).To(HaveLen(2))
+			serverConf := string(results[0].data)
+
+			for expSubStr, expCount := range test.expectedHTTPConfig {
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
+func TestExecuteServers_SSLSettings(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		SSLServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8443,
+			},
+			{
+				Hostname: "example.com",
+				SSL: &dataplane.SSL{
+					KeyPairID:           "test-keypair",
+					Protocols:           []string{"TLSv1.2", "TLSv1.3"},
+					Ciphers:             "HIGH:!aNULL:!MD5",
+					PreferServerCiphers: helpers.GetPointer(true),
+				},
+				Port: 8443,
+			},
+			{
+				Hostname: "cafe.example.com",
+				SSL: &dataplane.SSL{
+					KeyPairID:           "test-keypair",
+					PreferServerCiphers: helpers.GetPointer(false),
+				},
+				Port: 8443,
+			},
+		},
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			IPFamily: dataplane.IPv4,
+			TLSSettings: dataplane.TLSSettings{
+				OCSPStapling: true,
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"ssl_protocols TLSv1.2 TLSv1.3;": 1,
+		"ssl_ciphers HIGH:!aNULL:!MD5;":  1,
+		"ssl_prefer_server_ciphers on;":  1,
+		"ssl_prefer_server_ciphers off;": 1,
+		"ssl_protocols":                  1,
+		"ssl_ciphers":                    1,
+		"ssl_stapling on;":               2,
+	}
+
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+	serverConf := string(results[0].data)
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
 func TestExecuteServers_RewriteClientIP(t *testing.T) {
 	t.Parallel()
 	httpServers := []dataplane.VirtualServer{
@@ -1241,21 +1405,21 @@ func TestCreateServers(t *testing.T) {
 				Includes:     externalIncludes,
 			},
 			{
-				Path:            "/_ngf-internal-rule0-route0",
+				Path:            "= /_ngf-internal-rule0-route0",
 				ProxyPass:       "http://test_foo_80$request_uri",
 				ProxySetHeaders: httpBaseHeaders,
 				Type:            http.InternalLocationType,
 				Includes:        internalIncludes,
 			},
 			{
-				Path:            "/_ngf-internal-rule0-route1",
+				Path:            "= /_ngf-internal-rule0-route1",
 				ProxyPass:       "http://test_foo_80$request_uri",
 				ProxySetHeaders: httpBaseHeaders,
 				Type:            http.InternalLocationType,
 				Includes:        internalIncludes,
 			},
 			{
-				Path:            "/_ngf-internal-rule0-route2",
+				Path:            "= /_ngf-internal-rule0-route2",
 				ProxyPass:       "http://test_foo_80$request_uri",
 				ProxySetHeaders: httpBaseHeaders,
 				Type:            http.InternalLocationType,
@@ -1268,7 +1432,7 @@ func TestCreateServers(t *testing.T) {
 				Includes:     externalIncludes,
 			},
 			{
-				Path:            "/_ngf-internal-rule1-route0",
+				Path:            "= /_ngf-internal-rule1-route0",
 				ProxyPass:       "http://$group_test__route1_rule1$request_uri",
 				ProxySetHeaders: httpBaseHeaders,
 				Type:            http.InternalLocationType,
@@ -1359,7 +1523,7 @@ func TestCreateServers(t *testing.T) {
 				Includes:     externalIncludes,
 			},
 			{
-				Path: "/_ngf-internal-rule6-route0",
+				Path: "= /_ngf-internal-rule6-route0",
 				Return: &http.Return{
 					Body: "$scheme://foo.example.com:8080$request_uri",
 					Code: 302,
@@ -1396,7 +1560,7 @@ func TestCreateServers(t *testing.T) {
 				Includes:     externalIncludes,
 			},
 			{
-				Path:            "/_ngf-internal-rule8-route0",
+				Path:            "= /_ngf-internal-rule8-route0",
 				Rewrites:        []string{"^ $request_uri", "^/rewrite-with-headers([^?]*)? /prefix-replacement$1?$args? break"},
 				ProxyPass:       "http://test_foo_80",
 				ProxySetHeaders: rewriteProxySetHeaders,
@@ -1473,7 +1637,7 @@ func TestCreateServers(t *testing.T) {
 				Includes:     externalIncludes,
 			},
 			{
-				Path: "/_ngf-internal-rule14-route0",
+				Path: "= /_ngf-internal-rule14-route0",
 				Return: &http.Return{
 					Code: http.StatusInternalServerError,
 				},
@@ -1494,7 +1658,7 @@ func TestCreateServers(t *testing.T) {
 				Includes:     externalIncludes,
 			},
 			{
-				Path:            "/_ngf-internal-rule16-route0",
+				Path:            "= /_ngf-internal-rule16-route0",
 				ProxyPass:       "http://test_foo_80$request_uri",
 				ProxySetHeaders: httpBaseHeaders,
 				Type:            http.InternalLocationType,
@@ -1578,7 +1742,7 @@ func TestCreateServers(t *testing.T) {
 				Includes:     externalIncludes,
 			},
 			{
-				Path:            "/_ngf-internal-rule21-route0",
+				Path:            "= /_ngf-internal-rule21-route0",
 				ProxyPass:       "http://test_foo_80$request_uri",
 				ProxySetHeaders: httpBaseHeaders,
 				Type:            http.InternalLocationType,
@@ -2180,7 +2344,7 @@ func TestCreateLocations_Includes(t *testing.T) {
 			Includes: []shared.Include{externalPolicyInclude},
 		},
 		{
-			Path: "/_ngf-internal-rule2-route0",
+			Path: "= /_ngf-internal-rule2-route0",
 			Includes: []shared.Include{
 				{
 					Name:    includesFolder + "/method-match-location-snippet.conf",
@@ -2205,7 +2369,7 @@ func TestCreateLocations_Includes(t *testing.T) {
 		},
 	})
 
-	locations, matches, grpc := createLocations(&httpServer, "1", fakeGenerator, alwaysFalseKeepAliveChecker)
+	locations, matches, grpc := createLocations(&httpServer, "1", fakeGenerator, alwaysFalseKeepAliveChecker, false)
 
 	g := NewWithT(t)
 	g.Expect(grpc).To(BeFalse())
@@ -2217,6 +2381,617 @@ func TestCreateLocations_Includes(t *testing.T) {
 	}
 }
 
+func TestCreateLocations_DirectResponse(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	directResponse := &dataplane.HTTPDirectResponseFilter{
+		StatusCode:  503,
+		ContentType: "application/json",
+		Body:        `{"message": "down for \maintenance"}`,
+	}
+
+	httpServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/maintenance",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{
+							DirectResponse: directResponse,
+						},
+					},
+				},
+			},
+			{
+				Path:     "/maintenance-with-method-match",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Method: helpers.GetPointer("GET"),
+						},
+						Filters: dataplane.HTTPFilters{
+							DirectResponse: directResponse,
+						},
+					},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	expReturn := &http.Return{
+		Code: 503,
+		Body: `{\"message\": \"down for \\maintenance\"}`,
+	}
+
+	locations, _, _ := createLocations(
+		&httpServer,
+		"1",
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		false,
+	)
+
+	// the default root location is also created
+	g.Expect(locations).To(HaveLen(4))
+	for _, loc := range locations {
+		if loc.Path == "/" {
+			continue
+		}
+
+		if loc.Type == http.RedirectLocationType {
+			g.Expect(loc.Return).To(BeNil())
+			continue
+		}
+
+		g.Expect(loc.Return).To(Equal(expReturn))
+		g.Expect(loc.DefaultType).To(Equal("application/json"))
+		g.Expect(loc.ProxyPass).To(BeEmpty())
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		dataplane.Configuration{HTTPServers: []dataplane.VirtualServer{httpServer}},
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+	)
+
+	var serverConf string
+	for _, res := range results {
+		if res.dest == httpConfigFile {
+			serverConf = string(res.data)
+		}
+	}
+
+	g.Expect(strings.Count(serverConf, `default_type "application/json";`)).To(Equal(2))
+	g.Expect(strings.Count(
+		serverConf,
+		`return 503 "{\"message\": \"down for \\maintenance\"}";`,
+	)).To(Equal(2))
+}
+
+func TestCreateLocations_GRPCRewrite(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	grpcRewrite := &dataplane.GRPCRewriteFilter{
+		Hostname: helpers.GetPointer("billing.example.com"),
+		Service:  helpers.GetPointer("acme.billing.v2.Billing"),
+	}
+
+	grpcServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/acme.Billing/",
+				PathType: dataplane.PathTypePrefix,
+				GRPC:     true,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{
+							GRPCRewrite: grpcRewrite,
+						},
+					},
+				},
+			},
+			{
+				Path:     "/acme.Invoices/GetInvoice",
+				PathType: dataplane.PathTypeExact,
+				GRPC:     true,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Headers: []dataplane.HTTPHeaderMatch{
+								{
+									Name:  "version",
+									Value: "v2",
+								},
+							},
+						},
+						Filters: dataplane.HTTPFilters{
+							GRPCRewrite: &dataplane.GRPCRewriteFilter{
+								Method: helpers.GetPointer("GetInvoiceV2"),
+							},
+						},
+					},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	locations, _, grpc := createLocations(
+		&grpcServer,
+		"1",
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		false,
+	)
+	g.Expect(grpc).To(BeTrue())
+
+	rewrites := make(map[string][]string)
+	for _, loc := range locations {
+		rewrites[loc.Path] = loc.Rewrites
+	}
+
+	g.Expect(rewrites).To(HaveKeyWithValue(
+		"/acme.Billing/",
+		[]string{"^/?([^/]*)/?(.*)$ /acme.billing.v2.Billing/$2 break"},
+	))
+	g.Expect(rewrites).To(HaveKeyWithValue(
+		"= /_ngf-internal-rule1-route0",
+		[]string{
+			"^ $request_uri",
+			"^/?([^/]*)/?(.*)$ /$1/GetInvoiceV2 break",
+		},
+	))
+
+	for _, loc := range locations {
+		if loc.Path != "/acme.Billing/" {
+			continue
+		}
+
+		g.Expect(loc.ProxySetHeaders).To(ContainElements(
+			http.Header{Name: "Host", Value: "billing.example.com"},
+			http.Header{Name: "Authority", Value: "billing.example.com"},
+		))
+	}
+}
+
+func TestCreateLocations_GRPCWeb(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	grpcServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/helloworld.Greeter/",
+				PathType: dataplane.PathTypePrefix,
+				GRPC:     true,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{
+							GRPCWeb: &dataplane.GRPCWebFilter{
+								CORS: &dataplane.GRPCWebCORS{
+									AllowOrigins: []string{"https://app.example.com", "https://admin.example.com"},
+									MaxAge:       helpers.GetPointer[int32](600),
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/helloworld.Internal/",
+				PathType: dataplane.PathTypePrefix,
+				GRPC:     true,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Filters: dataplane.HTTPFilters{},
+					},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	locations, _, _ := createLocations(
+		&grpcServer,
+		"1",
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		false,
+	)
+
+	for _, loc := range locations {
+		switch loc.Path {
+		case "/helloworld.Greeter/":
+			g.Expect(loc.GRPCWeb).ToNot(BeNil())
+			g.Expect(loc.ProxySetHeaders).To(ContainElement(grpcContentTypeHeader))
+		default:
+			g.Expect(loc.GRPCWeb).To(BeNil())
+			g.Expect(loc.ProxySetHeaders).ToNot(ContainElement(grpcContentTypeHeader))
+		}
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		dataplane.Configuration{HTTPServers: []dataplane.VirtualServer{grpcServer}},
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+	)
+
+	var serverConf string
+	for _, res := range results {
+		if res.dest == httpConfigFile {
+			serverConf = string(res.data)
+		}
+	}
+
+	allowOrigins := `set $grpc_web_allow_origins "https://app.example.com https://admin.example.com";`
+
+	// the Access-Control-Allow-Origin header is added for preflight requests and for the other requests
+	expSubStrings := map[string]int{
+		"set $grpc_web on;":                             1,
+		allowOrigins:                                    1,
+		"if ($request_method = OPTIONS) {":              1,
+		"add_header Access-Control-Max-Age 600 always;": 1,
+		"add_header Access-Control-Allow-Origin $grpc_web_allow_origin always;":     2,
+		"add_header Access-Control-Expose-Headers $grpc_web_expose_headers always;": 1,
+		`if ($http_content_type ~* "^application/grpc-web-text") {`:                 1,
+		"js_header_filter grpcweb.headerFilter;":                                    1,
+		"js_body_filter grpcweb.bodyFilter buffer_type=buffer;":                     1,
+		`grpc_set_header Content-Type "$grpc_upstream_content_type";`:               1,
+	}
+
+	for expSubString, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubString)).To(Equal(expCount), expSubString)
+	}
+}
+
+func TestCreateGRPCWeb(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter   *dataplane.GRPCWebFilter
+		expected *http.GRPCWeb
+		msg      string
+	}{
+		{
+			msg:      "no cors",
+			filter:   &dataplane.GRPCWebFilter{},
+			expected: &http.GRPCWeb{},
+		},
+		{
+			msg: "cors with defaults",
+			filter: &dataplane.GRPCWebFilter{
+				CORS: &dataplane.GRPCWebCORS{
+					AllowOrigins: []string{"*"},
+				},
+			},
+			expected: &http.GRPCWeb{
+				CORS: &http.GRPCWebCORS{
+					AllowOrigins: "*",
+					AllowHeaders: "keep-alive, user-agent, cache-control, content-type, content-transfer-encoding, " +
+						"x-accept-content-transfer-encoding, x-accept-response-streaming, x-user-agent, x-grpc-web, " +
+						"grpc-timeout",
+					MaxAge: 86400,
+				},
+			},
+		},
+		{
+			msg: "cors with additional headers",
+			filter: &dataplane.GRPCWebFilter{
+				CORS: &dataplane.GRPCWebCORS{
+					AllowOrigins: []string{"https://app.example.com", "http://localhost:8080"},
+					AllowHeaders: []string{"X-Tenant", "Content-Type", "authorization"},
+					MaxAge:       helpers.GetPointer[int32](0),
+				},
+			},
+			expected: &http.GRPCWeb{
+				CORS: &http.GRPCWebCORS{
+					AllowOrigins: "https://app.example.com http://localhost:8080",
+					AllowHeaders: "keep-alive, user-agent, cache-control, content-type, content-transfer-encoding, " +
+						"x-accept-content-transfer-encoding, x-accept-response-streaming, x-user-agent, x-grpc-web, " +
+						"grpc-timeout, x-tenant, authorization",
+					MaxAge: 0,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createGRPCWeb(test.filter)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateRewritesForGRPCRewriteFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter   *dataplane.GRPCRewriteFilter
+		msg      string
+		rewrites []string
+		expected []string
+	}{
+		{
+			msg: "hostname only",
+			filter: &dataplane.GRPCRewriteFilter{
+				Hostname: helpers.GetPointer("billing.example.com"),
+			},
+			rewrites: []string{grpcRequestURIRewrite},
+			expected: []string{grpcRequestURIRewrite},
+		},
+		{
+			msg: "service and method",
+			filter: &dataplane.GRPCRewriteFilter{
+				Service: helpers.GetPointer("acme.billing.v2.Billing"),
+				Method:  helpers.GetPointer("GetInvoice"),
+			},
+			expected: []string{"^/?([^/]*)/?(.*)$ /acme.billing.v2.Billing/GetInvoice break"},
+		},
+		{
+			msg: "method in internal location",
+			filter: &dataplane.GRPCRewriteFilter{
+				Method: helpers.GetPointer("GetInvoice"),
+			},
+			rewrites: []string{grpcRequestURIRewrite},
+			expected: []string{
+				"^ $request_uri",
+				"^/?([^/]*)/?(.*)$ /$1/GetInvoice break",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result := createRewritesForGRPCRewriteFilter(test.filter, test.rewrites)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateLocations_RegularExpression(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fooGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{UpstreamName: "test_foo_80", Valid: true, Weight: 1},
+		},
+	}
+
+	httpServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/legacy",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{BackendGroup: fooGroup},
+				},
+			},
+			{
+				Path:     `^/legacy/(\d+)\.php$`,
+				PathType: dataplane.PathTypeRegularExpression,
+				MatchRules: []dataplane.MatchRule{
+					{BackendGroup: fooGroup},
+				},
+			},
+			{
+				Path:     "/",
+				PathType: dataplane.PathTypeRegularExpression,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Headers: []dataplane.HTTPHeaderMatch{
+								{Name: "version", Value: "legacy", Type: dataplane.MatchTypeExact},
+							},
+						},
+						BackendGroup: fooGroup,
+					},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	locs, matchPairs, _ := createLocations(
+		&httpServer,
+		"1",
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		false,
+	)
+
+	g.Expect(locs).To(Equal([]http.Location{
+		{
+			Path:            "= /legacy",
+			ProxyPass:       "http://test_foo_80$request_uri",
+			ProxySetHeaders: httpBaseHeaders,
+			Type:            http.ExternalLocationType,
+		},
+		{
+			Path:            `~ "^/legacy/(\\d+)\\.php$"`,
+			ProxyPass:       "http://test_foo_80$request_uri",
+			ProxySetHeaders: httpBaseHeaders,
+			Type:            http.ExternalLocationType,
+		},
+		{
+			Path:         `~ "/"`,
+			HTTPMatchKey: "1_2",
+			Type:         http.RedirectLocationType,
+		},
+		{
+			Path:            "= /_ngf-internal-rule2-route0",
+			ProxyPass:       "http://test_foo_80$request_uri",
+			ProxySetHeaders: httpBaseHeaders,
+			Type:            http.InternalLocationType,
+		},
+		// a RegularExpression root path doesn't prevent the default root location
+		{
+			Path: "/",
+			Return: &http.Return{
+				Code: http.StatusNotFound,
+			},
+		},
+	}))
+	g.Expect(matchPairs).To(HaveKeyWithValue("1_2", []routeMatch{
+		{
+			RedirectPath: "/_ngf-internal-rule2-route0",
+			Headers:      []string{"version:Exact:legacy"},
+		},
+	}))
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		dataplane.Configuration{HTTPServers: []dataplane.VirtualServer{httpServer}},
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+	)
+
+	var serverConf string
+	for _, res := range results {
+		if res.dest == httpConfigFile {
+			serverConf = string(res.data)
+		}
+	}
+
+	g.Expect(serverConf).To(ContainSubstring(`location ~ "^/legacy/(\\d+)\\.php$" {`))
+	g.Expect(serverConf).To(ContainSubstring(`location ~ "/" {`))
+	g.Expect(serverConf).To(ContainSubstring(`location = /_ngf-internal-rule2-route0 {`))
+}
+
+func TestCreateLocations_NativeHTTPMatching(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fooGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{UpstreamName: "test_foo_80", Valid: true, Weight: 1},
+		},
+	}
+
+	httpServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/coffee",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Headers: []dataplane.HTTPHeaderMatch{
+								{Name: "version", Value: "v2", Type: dataplane.MatchTypeExact},
+							},
+						},
+						BackendGroup: fooGroup,
+					},
+				},
+			},
+			{
+				Path:     "/tea",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							QueryParams: []dataplane.HTTPQueryParamMatch{
+								{Name: "api-version", Value: "2", Type: dataplane.MatchTypeExact},
+							},
+						},
+						BackendGroup: fooGroup,
+					},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	locs, matchPairs, _ := createLocations(
+		&httpServer,
+		"1",
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+		true,
+	)
+
+	g.Expect(locs).To(Equal([]http.Location{
+		{
+			Path:         "= /coffee",
+			HTTPMatchVar: "$ngf_http_match_1_0",
+			Type:         http.NativeRedirectLocationType,
+		},
+		{
+			Path:            "= /_ngf-internal-rule0-route0",
+			ProxyPass:       "http://test_foo_80$request_uri",
+			ProxySetHeaders: httpBaseHeaders,
+			Type:            http.InternalLocationType,
+		},
+		// the query parameter name can't be looked up with a variable, so the matches are evaluated with NJS.
+		{
+			Path:         "= /tea",
+			HTTPMatchKey: "1_1",
+			Type:         http.RedirectLocationType,
+		},
+		{
+			Path:            "= /_ngf-internal-rule1-route0",
+			ProxyPass:       "http://test_foo_80$request_uri",
+			ProxySetHeaders: httpBaseHeaders,
+			Type:            http.InternalLocationType,
+		},
+		{
+			Path: "/",
+			Return: &http.Return{
+				Code: http.StatusNotFound,
+			},
+		},
+	}))
+	g.Expect(matchPairs).To(Equal(httpMatchPairs{
+		"1_1": {
+			{
+				RedirectPath: "/_ngf-internal-rule1-route0",
+				QueryParams:  []string{"api-version=Exact=2"},
+			},
+		},
+	}))
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		dataplane.Configuration{
+			HTTPServers:    []dataplane.VirtualServer{httpServer},
+			BaseHTTPConfig: dataplane.BaseHTTPConfig{NativeHTTPMatching: true},
+		},
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+	)
+
+	var serverConf string
+	for _, res := range results {
+		if res.dest == httpConfigFile {
+			serverConf = string(res.data)
+		}
+	}
+
+	g.Expect(serverConf).To(ContainSubstring(`if ($ngf_http_match_0_0 = "") {`))
+	g.Expect(serverConf).To(ContainSubstring("rewrite ^ $ngf_http_match_0_0 last;"))
+	g.Expect(strings.Count(serverConf, "js_content httpmatches.redirect;")).To(Equal(1))
+}
+
 func TestCreateLocationsRootPath(t *testing.T) {
 	t.Parallel()
 	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
@@ -2396,6 +3171,7 @@ func TestCreateLocationsRootPath(t *testing.T) {
 				"1",
 				&policiesfakes.FakeGenerator{},
 				alwaysFalseKeepAliveChecker,
+				false,
 			)
 			g.Expect(locs).To(Equal(test.expLocations))
 			g.Expect(httpMatchPair).To(BeEmpty())
@@ -3386,6 +4162,45 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 			}, grpcBaseHeaders...),
 			baseHeaders: grpcBaseHeaders,
 		},
+		{
+			msg: "with gRPC rewrite hostname",
+			filters: &dataplane.HTTPFilters{
+				GRPCRewrite: &dataplane.GRPCRewriteFilter{
+					Hostname: helpers.GetPointer("billing.example.com"),
+				},
+			},
+			expectedHeaders: []http.Header{
+				{
+					Name:  "Host",
+					Value: "billing.example.com",
+				},
+				{
+					Name:  "X-Forwarded-For",
+					Value: "$proxy_add_x_forwarded_for",
+				},
+				{
+					Name:  "X-Real-IP",
+					Value: "$remote_addr",
+				},
+				{
+					Name:  "X-Forwarded-Proto",
+					Value: "$scheme",
+				},
+				{
+					Name:  "X-Forwarded-Host",
+					Value: "$host",
+				},
+				{
+					Name:  "X-Forwarded-Port",
+					Value: "$server_port",
+				},
+				{
+					Name:  "Authority",
+					Value: "billing.example.com",
+				},
+			},
+			baseHeaders: createBaseProxySetHeaders(grpcAuthorityHeader),
+		},
 	}
 
 	for _, tc := range tests {
@@ -3479,6 +4294,7 @@ func TestGetConnectionHeader(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
+		upgrade             *bool
 		msg                 string
 		upstreams           []http.Upstream
 		expConnectionHeader http.Header
@@ -3587,6 +4403,48 @@ func TestGetConnectionHeader(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg:     "upgrade enabled by policy; upstream with keepAlive enabled",
+			upgrade: helpers.GetPointer(true),
+			upstreams: []http.Upstream{
+				{
+					Name: "upstream",
+					KeepAlive: http.UpstreamKeepAlive{
+						Connections: 1,
+					},
+				},
+			},
+			backends:            []dataplane.Backend{{UpstreamName: "upstream"}},
+			expConnectionHeader: keepAliveHTTPConnectionHeader,
+		},
+		{
+			msg:                 "upgrade enabled by policy; upstream with keepAlive disabled",
+			upgrade:             helpers.GetPointer(true),
+			upstreams:           []http.Upstream{{Name: "upstream"}},
+			backends:            []dataplane.Backend{{UpstreamName: "upstream"}},
+			expConnectionHeader: httpConnectionHeader,
+		},
+		{
+			msg:     "upgrade disabled by policy; upstream with keepAlive enabled",
+			upgrade: helpers.GetPointer(false),
+			upstreams: []http.Upstream{
+				{
+					Name: "upstream",
+					KeepAlive: http.UpstreamKeepAlive{
+						Connections: 1,
+					},
+				},
+			},
+			backends:            []dataplane.Backend{{UpstreamName: "upstream"}},
+			expConnectionHeader: unsetHTTPConnectionHeader,
+		},
+		{
+			msg:                 "upgrade disabled by policy; upstream with keepAlive disabled",
+			upgrade:             helpers.GetPointer(false),
+			upstreams:           []http.Upstream{{Name: "upstream"}},
+			backends:            []dataplane.Backend{{UpstreamName: "upstream"}},
+			expConnectionHeader: closeHTTPConnectionHeader,
+		},
 	}
 
 	for _, tc := range tests {
@@ -3596,12 +4454,21 @@ func TestGetConnectionHeader(t *testing.T) {
 
 			keepAliveCheck := newKeepAliveChecker(tc.upstreams)
 
-			connectionHeader := getConnectionHeader(keepAliveCheck, tc.backends)
+			connectionHeader := getConnectionHeader(keepAliveCheck, tc.backends, tc.upgrade)
 			g.Expect(connectionHeader).To(Equal(tc.expConnectionHeader))
 		})
 	}
 }
 
+func TestGetUpgradeHeader(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(getUpgradeHeader(nil)).To(Equal(httpUpgradeHeader))
+	g.Expect(getUpgradeHeader(helpers.GetPointer(true))).To(Equal(httpUpgradeHeader))
+	g.Expect(getUpgradeHeader(helpers.GetPointer(false))).To(Equal(unsetHTTPUpgradeHeader))
+}
+
 func TestConvertBackendTLSFromGroup(t *testing.T) {
 	t.Parallel()
 
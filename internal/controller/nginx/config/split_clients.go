@@ -3,17 +3,23 @@ package config
 import (
 	"fmt"
 	"math"
+	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 )
 
+// requestIDVariable is the default key used to split traffic.
+const requestIDVariable = "$request_id"
+
 var splitClientsTemplate = gotemplate.Must(gotemplate.New("split_clients").Parse(splitClientsTemplateText))
 
 func executeSplitClients(conf dataplane.Configuration) []executeResult {
 	splitClients := createSplitClients(conf.BackendGroups)
+	splitClients = append(splitClients, createMirrorSplitClients(conf.HTTPServers, conf.SSLServers)...)
 
 	result := executeResult{
 		dest: httpConfigFile,
@@ -44,6 +50,7 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 		}
 
 		splitClients = append(splitClients, http.SplitClient{
+			Key:           createSplitKeyVariable(group.SplitKey),
 			VariableName:  convertStringToSafeVariableName(group.Name()),
 			Distributions: distributions,
 		})
@@ -52,6 +59,71 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 	return splitClients
 }
 
+// createSplitKeyVariable returns the NGINX variable that is hashed to split traffic between the backends.
+// The request ID is used if the split key is not set. A cookie or header split key uses the variable of its
+// map, see buildSplitKeyMaps.
+func createSplitKeyVariable(splitKey *dataplane.SplitKey) string {
+	if splitKey == nil {
+		return requestIDVariable
+	}
+
+	switch splitKey.Type {
+	case dataplane.SplitKeyTypeClientIP:
+		return "$remote_addr"
+	case dataplane.SplitKeyTypeCookie, dataplane.SplitKeyTypeHeader:
+		return splitKeyMapVariable(splitKeySourceVariable(splitKey))
+	default:
+		return requestIDVariable
+	}
+}
+
+// splitKeySourceVariable returns the NGINX variable with the value of a cookie or header split key.
+func splitKeySourceVariable(splitKey *dataplane.SplitKey) string {
+	if splitKey.Type == dataplane.SplitKeyTypeCookie {
+		return "$cookie_" + splitKey.Name
+	}
+
+	return "$http_" + strings.ReplaceAll(strings.ToLower(splitKey.Name), "-", "_")
+}
+
+// splitKeyMapVariable returns the variable of the map of the split key source variable.
+func splitKeyMapVariable(source string) string {
+	return "$split_key_" + strings.TrimPrefix(source, "$")
+}
+
+// buildSplitKeyMaps builds the maps of the cookie and header split keys of the backend groups. Requests without
+// the cookie or header would all hash the empty value to the same backend, so the map falls back to the request ID
+// for them, which splits them by the weights of the backends.
+func buildSplitKeyMaps(backendGroups []dataplane.BackendGroup) []shared.Map {
+	var splitKeyMaps []shared.Map
+	seen := make(map[string]struct{})
+
+	for _, group := range backendGroups {
+		splitKey := group.SplitKey
+		if !backendGroupNeedsSplit(group) || splitKey == nil ||
+			(splitKey.Type != dataplane.SplitKeyTypeCookie && splitKey.Type != dataplane.SplitKeyTypeHeader) {
+			continue
+		}
+
+		source := splitKeySourceVariable(splitKey)
+		if _, exists := seen[source]; exists {
+			continue
+		}
+		seen[source] = struct{}{}
+
+		splitKeyMaps = append(splitKeyMaps, shared.Map{
+			Source:   source,
+			Variable: splitKeyMapVariable(source),
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: requestIDVariable},
+				{Value: "default", Result: source},
+			},
+		})
+	}
+
+	return splitKeyMaps
+}
+
 func createSplitClientDistributions(group dataplane.BackendGroup) []http.SplitClientDistribution {
 	if !backendGroupNeedsSplit(group) {
 		return nil
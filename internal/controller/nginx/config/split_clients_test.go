@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/dataplane"
 )
 
@@ -80,6 +81,27 @@ func TestExecuteSplitClients(t *testing.T) {
 			},
 			notExpStrings: nil,
 		},
+		{
+			msg: "split key",
+			backendGroups: []dataplane.BackendGroup{
+				{
+					Source:  types.NamespacedName{Namespace: "test", Name: "split-key"},
+					RuleIdx: 0,
+					SplitKey: &dataplane.SplitKey{
+						Type: dataplane.SplitKeyTypeCookie,
+						Name: "session_id",
+					},
+					Backends: []dataplane.Backend{
+						{UpstreamName: "test1", Valid: true, Weight: 1},
+						{UpstreamName: "test2", Valid: true, Weight: 1},
+					},
+				},
+			},
+			expStrings: []string{
+				"split_clients $split_key_cookie_session_id $group_test__split_key_rule0",
+			},
+			notExpStrings: []string{"$request_id"},
+		},
 		{
 			msg: "no split clients",
 			backendGroups: []dataplane.BackendGroup{
@@ -188,6 +210,7 @@ func TestCreateSplitClients(t *testing.T) {
 			},
 			expSplitClients: []http.SplitClient{
 				{
+					Key:          "$request_id",
 					VariableName: "group_test__hr_one_split_rule0",
 					Distributions: []http.SplitClientDistribution{
 						{
@@ -201,6 +224,7 @@ func TestCreateSplitClients(t *testing.T) {
 					},
 				},
 				{
+					Key:          "$request_id",
 					VariableName: "group_test__hr_two_splits_rule0",
 					Distributions: []http.SplitClientDistribution{
 						{
@@ -214,6 +238,7 @@ func TestCreateSplitClients(t *testing.T) {
 					},
 				},
 				{
+					Key:          "$request_id",
 					VariableName: "group_test__hr_two_splits_rule1",
 					Distributions: []http.SplitClientDistribution{
 						{
@@ -252,6 +277,115 @@ func TestCreateSplitClients(t *testing.T) {
 	}
 }
 
+func TestCreateSplitKeyVariable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		splitKey *dataplane.SplitKey
+		msg      string
+		expected string
+	}{
+		{
+			msg:      "nil split key",
+			expected: "$request_id",
+		},
+		{
+			msg:      "request ID",
+			splitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeRequestID},
+			expected: "$request_id",
+		},
+		{
+			msg:      "client IP",
+			splitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeClientIP},
+			expected: "$remote_addr",
+		},
+		{
+			msg:      "cookie",
+			splitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeCookie, Name: "Session_ID"},
+			expected: "$split_key_cookie_Session_ID",
+		},
+		{
+			msg:      "header",
+			splitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeHeader, Name: "X-User-ID"},
+			expected: "$split_key_http_x_user_id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createSplitKeyVariable(test.splitKey)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildSplitKeyMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	backends := []dataplane.Backend{
+		{UpstreamName: "test1", Valid: true, Weight: 1},
+		{UpstreamName: "test2", Valid: true, Weight: 1},
+	}
+
+	backendGroups := []dataplane.BackendGroup{
+		{
+			Source:   types.NamespacedName{Namespace: "test", Name: "cookie"},
+			Backends: backends,
+			SplitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeCookie, Name: "session_id"},
+		},
+		{
+			Source:   types.NamespacedName{Namespace: "test", Name: "same-cookie"},
+			Backends: backends,
+			SplitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeCookie, Name: "session_id"},
+		},
+		{
+			Source:   types.NamespacedName{Namespace: "test", Name: "header"},
+			Backends: backends,
+			SplitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeHeader, Name: "X-User-ID"},
+		},
+		{
+			Source:   types.NamespacedName{Namespace: "test", Name: "client-ip"},
+			Backends: backends,
+			SplitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeClientIP},
+		},
+		{
+			Source:   types.NamespacedName{Namespace: "test", Name: "no-split"},
+			Backends: backends[:1],
+			SplitKey: &dataplane.SplitKey{Type: dataplane.SplitKeyTypeHeader, Name: "X-Tenant"},
+		},
+	}
+
+	// requests without the cookie or header are split by the request ID
+	g.Expect(buildSplitKeyMaps(backendGroups)).To(Equal([]shared.Map{
+		{
+			Source:   "$cookie_session_id",
+			Variable: "$split_key_cookie_session_id",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: "$cookie_session_id"},
+			},
+		},
+		{
+			Source:   "$http_x_user_id",
+			Variable: "$split_key_http_x_user_id",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: "$http_x_user_id"},
+			},
+		},
+	}))
+
+	maps := string(executeMaps(dataplane.Configuration{BackendGroups: backendGroups})[0].data)
+	g.Expect(maps).To(ContainSubstring("map $cookie_session_id $split_key_cookie_session_id {"))
+	g.Expect(maps).To(ContainSubstring(`"" $request_id;`))
+	g.Expect(maps).To(ContainSubstring("default $cookie_session_id;"))
+	g.Expect(maps).To(ContainSubstring("map $http_x_user_id $split_key_http_x_user_id {"))
+	g.Expect(maps).ToNot(ContainSubstring("$http_x_tenant"))
+}
+
 func TestCreateSplitClientDistributions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -106,3 +106,26 @@ func (GenericValidator) ValidateEndpoint(endpoint string) error {
 
 	return nil
 }
+
+const (
+	contentTypeStringFmt    = `[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+-]+(;\s*[a-zA-Z0-9_-]+=[a-zA-Z0-9_.-]+)*`
+	contentTypeStringErrMsg = "must be a valid media type"
+)
+
+var contentTypeStringFmtRegexp = regexp.MustCompile("^" + contentTypeStringFmt + "$")
+
+// ValidateContentType validates a media type, with optional parameters, that is used as the value of
+// the default_type directive.
+func (GenericValidator) ValidateContentType(contentType string) error {
+	if !contentTypeStringFmtRegexp.MatchString(contentType) {
+		examples := []string{
+			"text/plain",
+			"application/json",
+			"text/html; charset=utf-8",
+		}
+
+		return errors.New(k8svalidation.RegexError(contentTypeStringErrMsg, contentTypeStringFmt, examples...))
+	}
+
+	return nil
+}
@@ -112,3 +112,26 @@ func TestValidateEndpoint(t *testing.T) {
 		`my$endpoint`,
 	)
 }
+
+func TestValidateContentType(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateContentType,
+		`text/plain`,
+		`application/vnd.api+json`,
+		`text/html; charset=utf-8`,
+		`text/html;charset=utf-8;q=1`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateContentType,
+		`text`,
+		`text/plain"`,
+		`text/plain; charset`,
+		`text/plain; return 200`,
+	)
+}
@@ -3,13 +3,18 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"regexp/syntax"
 	"strings"
+	"unicode"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config"
 )
 
+// maxPathRegexLength is the maximum length of a regular expression path.
+const maxPathRegexLength = 1024
+
 // HTTPNJSMatchValidator validates values used for matching a request.
 // The matching is implemented in NJS (except for path matching),
 // so changes to the implementation change the validation rules here.
@@ -29,6 +34,32 @@ func (HTTPNJSMatchValidator) ValidatePathInMatch(path string) error {
 	return nil
 }
 
+// ValidatePathRegexInMatch validates a regular expression path used in the location directive.
+// NGINX uses PCRE for regular expressions, while Go uses RE2. RE2 syntax is a subset of PCRE syntax,
+// so a regular expression that compiles in Go also compiles in NGINX. PCRE-only features, like lookarounds and
+// backreferences, are rejected.
+func (HTTPNJSMatchValidator) ValidatePathRegexInMatch(path string) error {
+	if path == "" {
+		return errors.New("cannot be empty")
+	}
+
+	if len(path) > maxPathRegexLength {
+		return errors.New(k8svalidation.MaxLenError(maxPathRegexLength))
+	}
+
+	for _, r := range path {
+		if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return errors.New("must not include any whitespace or non-printable characters")
+		}
+	}
+
+	if _, err := syntax.Parse(path, syntax.Perl); err != nil {
+		return fmt.Errorf("must be a valid regular expression: %w", err)
+	}
+
+	return nil
+}
+
 func (HTTPNJSMatchValidator) ValidateHeaderNameInMatch(name string) error {
 	if err := k8svalidation.IsHTTPHeaderName(name); err != nil {
 		return errors.New(err[0])
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -28,6 +29,34 @@ func TestValidatePathInMatch(t *testing.T) {
 	)
 }
 
+func TestValidatePathRegexInMatch(t *testing.T) {
+	t.Parallel()
+	validator := HTTPNJSMatchValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"/",
+		`^/legacy/(\d+)\.php$`,
+		"/(foo|bar)/.*",
+		"/path{1,3}",
+		`/path;"quoted"`,
+		"^/(?P<name>[a-z]+)$",
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"",
+		"/path ",
+		"/path\t",
+		"/(foo",
+		"/foo(?=bar)",
+		`/(a)\1`,
+		`/path\`,
+		"/"+strings.Repeat("a", 1024),
+	)
+}
+
 func TestValidateHeaderNameInMatch(t *testing.T) {
 	t.Parallel()
 	validator := HTTPNJSMatchValidator{}
@@ -0,0 +1,154 @@
+const GRPC_WEB_KEY = 'grpc_web';
+const ALLOW_ORIGINS_KEY = 'grpc_web_allow_origins';
+const GRPC_STATUS_TRAILER_KEY = 'upstream_trailer_grpc_status';
+const GRPC_MESSAGE_TRAILER_KEY = 'upstream_trailer_grpc_message';
+
+const GRPC_CONTENT_TYPE = 'application/grpc';
+const GRPC_WEB_CONTENT_TYPE = 'application/grpc-web';
+const GRPC_WEB_DEFAULT_CONTENT_TYPE = 'application/grpc-web+proto';
+const EXPOSE_HEADERS = 'grpc-status, grpc-message';
+
+// TRAILERS_FRAME_FLAG marks a gRPC-Web frame that holds the trailers instead of a message.
+const TRAILERS_FRAME_FLAG = 0x80;
+
+// isGRPCWebRequest returns true if gRPC-Web is enabled for the location
+// and the request is a gRPC-Web request.
+function isGRPCWebRequest(r) {
+	if (r.variables[GRPC_WEB_KEY] !== 'on') {
+		return false;
+	}
+
+	return requestContentType(r).startsWith(GRPC_WEB_CONTENT_TYPE);
+}
+
+function requestContentType(r) {
+	const contentType = r.headersIn['Content-Type'];
+	if (!contentType) {
+		return '';
+	}
+
+	return contentType.split(';')[0].trim().toLowerCase();
+}
+
+// grpcWebContentType returns the content type of a gRPC-Web response.
+// The response uses the same message encoding as the request.
+function grpcWebContentType(r) {
+	const contentType = requestContentType(r);
+	if (contentType === GRPC_WEB_CONTENT_TYPE) {
+		return contentType;
+	}
+
+	if (contentType.startsWith(GRPC_WEB_CONTENT_TYPE + '+')) {
+		return contentType;
+	}
+
+	return GRPC_WEB_DEFAULT_CONTENT_TYPE;
+}
+
+// contentType is used by js_set to set the content type of gRPC error responses generated by NGINX.
+function contentType(r) {
+	return isGRPCWebRequest(r) ? grpcWebContentType(r) : GRPC_CONTENT_TYPE;
+}
+
+// allowOrigin is used by js_set to set the value of the Access-Control-Allow-Origin header.
+// It returns an empty string if CORS is not configured for the location or the origin is not
+// allowed, so that NGINX doesn't add the header.
+function allowOrigin(r) {
+	const allowOrigins = r.variables[ALLOW_ORIGINS_KEY];
+	if (!allowOrigins) {
+		return '';
+	}
+
+	const origins = allowOrigins.split(' ');
+	if (origins.includes('*')) {
+		return '*';
+	}
+
+	const origin = r.headersIn['Origin'];
+	if (origin && origins.includes(origin)) {
+		return origin;
+	}
+
+	return '';
+}
+
+// exposeHeaders is used by js_set to set the value of the Access-Control-Expose-Headers header.
+function exposeHeaders(r) {
+	return allowOrigin(r) ? EXPOSE_HEADERS : '';
+}
+
+function isGRPCWebResponse(r) {
+	const contentType = r.headersOut['Content-Type'];
+	return !!contentType && contentType.toLowerCase().startsWith(GRPC_WEB_CONTENT_TYPE);
+}
+
+// headerFilter translates the headers of a gRPC response to the headers of a gRPC-Web response.
+function headerFilter(r) {
+	if (!isGRPCWebRequest(r)) {
+		return;
+	}
+
+	const contentType = r.headersOut['Content-Type'];
+	if (!contentType || !contentType.toLowerCase().startsWith(GRPC_CONTENT_TYPE)) {
+		return;
+	}
+
+	r.headersOut['Content-Type'] = grpcWebContentType(r);
+	// the trailers are appended to the body, so the length of the response is not known in advance.
+	delete r.headersOut['Content-Length'];
+}
+
+// bodyFilter appends the trailers of a gRPC response to the body of a gRPC-Web response,
+// because browser clients cannot read HTTP trailers.
+function bodyFilter(r, data, flags) {
+	if (!flags.last || !isGRPCWebRequest(r) || !isGRPCWebResponse(r)) {
+		r.sendBuffer(data, flags);
+		return;
+	}
+
+	const trailers = createTrailersFrame(r);
+	if (!trailers) {
+		r.sendBuffer(data, flags);
+		return;
+	}
+
+	r.sendBuffer(data, { last: false });
+	r.sendBuffer(trailers, flags);
+}
+
+// createTrailersFrame returns a gRPC-Web frame with the trailers of the upstream response.
+// It returns null if the upstream response has no trailers, which is the case for a trailers-only
+// response, where the status is sent in the headers.
+function createTrailersFrame(r) {
+	const status = r.variables[GRPC_STATUS_TRAILER_KEY];
+	if (!status) {
+		return null;
+	}
+
+	let trailers = `grpc-status:${status}\r\n`;
+
+	const message = r.variables[GRPC_MESSAGE_TRAILER_KEY];
+	if (message) {
+		trailers += `grpc-message:${message}\r\n`;
+	}
+
+	const payload = Buffer.from(trailers);
+	const header = Buffer.alloc(5);
+	header[0] = TRAILERS_FRAME_FLAG;
+	header.writeUInt32BE(payload.length, 1);
+
+	return Buffer.concat([header, payload]);
+}
+
+export default {
+	GRPC_WEB_KEY,
+	ALLOW_ORIGINS_KEY,
+	GRPC_STATUS_TRAILER_KEY,
+	GRPC_MESSAGE_TRAILER_KEY,
+	contentType,
+	allowOrigin,
+	exposeHeaders,
+	headerFilter,
+	bodyFilter,
+	createTrailersFrame,
+};
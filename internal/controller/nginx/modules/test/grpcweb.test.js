@@ -0,0 +1,235 @@
+import { default as gw } from '../src/grpcweb.js';
+import { describe, expect, it } from 'vitest';
+
+// Creates a NGINX HTTP Request Object for testing.
+// See documentation for all properties available: http://nginx.org/en/docs/njs/reference.html
+function createRequest({
+	grpcWeb = true,
+	allowOrigins = '',
+	headersIn = {},
+	headersOut = {},
+	trailers = {},
+} = {}) {
+	let r = {
+		// Test mocks
+		sendBuffer(data, flags) {
+			r.testSent.push({ data, flags });
+		},
+		testSent: [],
+		variables: {},
+		headersIn: headersIn,
+		headersOut: headersOut,
+	};
+
+	if (grpcWeb) {
+		r.variables[gw.GRPC_WEB_KEY] = 'on';
+	}
+
+	if (allowOrigins) {
+		r.variables[gw.ALLOW_ORIGINS_KEY] = allowOrigins;
+	}
+
+	if (trailers.status) {
+		r.variables[gw.GRPC_STATUS_TRAILER_KEY] = trailers.status;
+	}
+
+	if (trailers.message) {
+		r.variables[gw.GRPC_MESSAGE_TRAILER_KEY] = trailers.message;
+	}
+
+	return r;
+}
+
+describe('contentType', () => {
+	const tests = [
+		{
+			name: 'returns gRPC content type when gRPC-Web is not enabled',
+			request: createRequest({
+				grpcWeb: false,
+				headersIn: { 'Content-Type': 'application/grpc-web+proto' },
+			}),
+			expected: 'application/grpc',
+		},
+		{
+			name: 'returns gRPC content type for gRPC requests',
+			request: createRequest({ headersIn: { 'Content-Type': 'application/grpc' } }),
+			expected: 'application/grpc',
+		},
+		{
+			name: 'returns the request content type for gRPC-Web requests',
+			request: createRequest({ headersIn: { 'Content-Type': 'application/grpc-web' } }),
+			expected: 'application/grpc-web',
+		},
+		{
+			name: 'returns the request content type with the message encoding for gRPC-Web requests',
+			request: createRequest({
+				headersIn: { 'Content-Type': 'Application/gRPC-Web+proto; charset=utf-8' },
+			}),
+			expected: 'application/grpc-web+proto',
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			expect(gw.contentType(test.request)).to.equal(test.expected);
+		});
+	});
+});
+
+describe('allowOrigin', () => {
+	const tests = [
+		{
+			name: 'returns empty string when CORS is not configured',
+			request: createRequest({ headersIn: { Origin: 'https://app.example.com' } }),
+			expected: '',
+		},
+		{
+			name: 'returns wildcard when any origin is allowed',
+			request: createRequest({
+				allowOrigins: 'https://app.example.com *',
+				headersIn: { Origin: 'https://other.example.com' },
+			}),
+			expected: '*',
+		},
+		{
+			name: 'returns the origin when it is allowed',
+			request: createRequest({
+				allowOrigins: 'https://app.example.com https://admin.example.com',
+				headersIn: { Origin: 'https://admin.example.com' },
+			}),
+			expected: 'https://admin.example.com',
+		},
+		{
+			name: 'returns empty string when the origin is not allowed',
+			request: createRequest({
+				allowOrigins: 'https://app.example.com',
+				headersIn: { Origin: 'https://other.example.com' },
+			}),
+			expected: '',
+		},
+		{
+			name: 'returns empty string when the request has no origin',
+			request: createRequest({ allowOrigins: 'https://app.example.com' }),
+			expected: '',
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			expect(gw.allowOrigin(test.request)).to.equal(test.expected);
+		});
+	});
+});
+
+describe('exposeHeaders', () => {
+	it('returns the gRPC status headers when the origin is allowed', () => {
+		const r = createRequest({ allowOrigins: '*' });
+		expect(gw.exposeHeaders(r)).to.equal('grpc-status, grpc-message');
+	});
+
+	it('returns empty string when the origin is not allowed', () => {
+		const r = createRequest();
+		expect(gw.exposeHeaders(r)).to.equal('');
+	});
+});
+
+describe('headerFilter', () => {
+	it('translates the content type of gRPC-Web responses', () => {
+		const r = createRequest({
+			headersIn: { 'Content-Type': 'application/grpc-web+proto' },
+			headersOut: { 'Content-Type': 'application/grpc', 'Content-Length': '10' },
+		});
+
+		gw.headerFilter(r);
+
+		expect(r.headersOut['Content-Type']).to.equal('application/grpc-web+proto');
+		expect(r.headersOut['Content-Length']).to.be.undefined;
+	});
+
+	it('does not change responses to gRPC requests', () => {
+		const r = createRequest({
+			headersIn: { 'Content-Type': 'application/grpc' },
+			headersOut: { 'Content-Type': 'application/grpc', 'Content-Length': '10' },
+		});
+
+		gw.headerFilter(r);
+
+		expect(r.headersOut['Content-Type']).to.equal('application/grpc');
+		expect(r.headersOut['Content-Length']).to.equal('10');
+	});
+
+	it('does not change non-gRPC responses', () => {
+		const r = createRequest({
+			headersIn: { 'Content-Type': 'application/grpc-web+proto' },
+			headersOut: { 'Content-Type': 'text/html' },
+		});
+
+		gw.headerFilter(r);
+
+		expect(r.headersOut['Content-Type']).to.equal('text/html');
+	});
+});
+
+describe('createTrailersFrame', () => {
+	it('returns null when the response has no trailers', () => {
+		expect(gw.createTrailersFrame(createRequest())).to.be.null;
+	});
+
+	it('returns the trailers frame', () => {
+		const r = createRequest({ trailers: { status: '5', message: 'not found' } });
+		const trailers = 'grpc-status:5\r\ngrpc-message:not found\r\n';
+
+		const frame = gw.createTrailersFrame(r);
+
+		expect(frame[0]).to.equal(0x80);
+		expect(frame.readUInt32BE(1)).to.equal(trailers.length);
+		expect(frame.subarray(5).toString()).to.equal(trailers);
+	});
+});
+
+describe('bodyFilter', () => {
+	const grpcWebResponse = {
+		headersIn: { 'Content-Type': 'application/grpc-web+proto' },
+		headersOut: { 'Content-Type': 'application/grpc-web+proto' },
+		trailers: { status: '0' },
+	};
+
+	it('passes through the data that is not the last buffer', () => {
+		const r = createRequest(grpcWebResponse);
+
+		gw.bodyFilter(r, 'data', { last: false });
+
+		expect(r.testSent).to.deep.equal([{ data: 'data', flags: { last: false } }]);
+	});
+
+	it('appends the trailers to the last buffer', () => {
+		const r = createRequest(grpcWebResponse);
+
+		gw.bodyFilter(r, 'data', { last: true });
+
+		expect(r.testSent).to.have.lengthOf(2);
+		expect(r.testSent[0]).to.deep.equal({ data: 'data', flags: { last: false } });
+		expect(r.testSent[1].flags).to.deep.equal({ last: true });
+		expect(r.testSent[1].data.subarray(5).toString()).to.equal('grpc-status:0\r\n');
+	});
+
+	it('does not append trailers to responses to gRPC requests', () => {
+		const r = createRequest({
+			...grpcWebResponse,
+			headersIn: { 'Content-Type': 'application/grpc' },
+			headersOut: { 'Content-Type': 'application/grpc' },
+		});
+
+		gw.bodyFilter(r, 'data', { last: true });
+
+		expect(r.testSent).to.deep.equal([{ data: 'data', flags: { last: true } }]);
+	});
+
+	it('does not append trailers when the upstream response has no trailers', () => {
+		const r = createRequest({ ...grpcWebResponse, trailers: {} });
+
+		gw.bodyFilter(r, 'data', { last: true });
+
+		expect(r.testSent).to.deep.equal([{ data: 'data', flags: { last: true } }]);
+	});
+});
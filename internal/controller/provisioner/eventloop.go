@@ -6,7 +6,10 @@ import (
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -85,6 +88,39 @@ func newEventLoop(
 				),
 			},
 		},
+		{
+			objectType: &autoscalingv2.HorizontalPodAutoscaler{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(
+					k8spredicate.And(
+						k8spredicate.GenerationChangedPredicate{},
+						nginxResourceLabelPredicate,
+					),
+				),
+			},
+		},
+		{
+			objectType: &policyv1.PodDisruptionBudget{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(
+					k8spredicate.And(
+						k8spredicate.GenerationChangedPredicate{},
+						nginxResourceLabelPredicate,
+					),
+				),
+			},
+		},
+		{
+			objectType: &networkingv1.NetworkPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(
+					k8spredicate.And(
+						k8spredicate.GenerationChangedPredicate{},
+						nginxResourceLabelPredicate,
+					),
+				),
+			},
+		},
 		{
 			objectType: &corev1.Service{},
 			options: []controller.Option{
@@ -184,6 +220,9 @@ func newEventLoop(
 		// to provision or deprovision any nginx resources.
 		&gatewayv1.GatewayList{},
 		&appsv1.DeploymentList{},
+		&autoscalingv2.HorizontalPodAutoscalerList{},
+		&policyv1.PodDisruptionBudgetList{},
+		&networkingv1.NetworkPolicyList{},
 		&corev1.ServiceList{},
 		&corev1.ServiceAccountList{},
 		&corev1.ConfigMapList{},
@@ -9,7 +9,10 @@ import (
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -61,13 +64,21 @@ func (h *eventHandler) HandleEventBatch(ctx context.Context, logger logr.Logger,
 			switch obj := e.Resource.(type) {
 			case *gatewayv1.Gateway:
 				h.store.updateGateway(obj)
-			case *appsv1.Deployment, *appsv1.DaemonSet, *corev1.ServiceAccount,
+			case *appsv1.Deployment, *appsv1.DaemonSet, *autoscalingv2.HorizontalPodAutoscaler,
+				*policyv1.PodDisruptionBudget, *networkingv1.NetworkPolicy, *corev1.ServiceAccount,
 				*corev1.ConfigMap, *rbacv1.Role, *rbacv1.RoleBinding:
 				objLabels := labels.Set(obj.GetLabels())
 				if h.labelSelector.Matches(objLabels) {
 					gatewayName := objLabels.Get(controller.GatewayLabel)
 					gatewayNSName := types.NamespacedName{Namespace: obj.GetNamespace(), Name: gatewayName}
 
+					if isStagedDeployment(objLabels) {
+						if err := h.deleteLeftoverStagedDeployment(ctx, obj, gatewayNSName); err != nil {
+							logger.Error(err, "error deleting staged nginx Deployment")
+						}
+						continue
+					}
+
 					if err := h.updateOrDeleteResources(ctx, logger, obj, gatewayNSName); err != nil {
 						logger.Error(err, "error handling resource update")
 					}
@@ -82,6 +93,11 @@ func (h *eventHandler) HandleEventBatch(ctx context.Context, logger logr.Logger,
 						logger.Error(err, "error handling resource update")
 					}
 
+					// the Gateway addresses are only set from the main Service
+					if isInternalService(obj.GetName(), controller.CreateNginxResourceName(gatewayName, h.gcName)) {
+						continue
+					}
+
 					statusUpdate := &status.QueueObject{
 						Deployment:     client.ObjectKeyFromObject(obj),
 						UpdateType:     status.UpdateGateway,
@@ -117,8 +133,9 @@ func (h *eventHandler) HandleEventBatch(ctx context.Context, logger logr.Logger,
 					logger.Error(err, "error deprovisioning nginx resources")
 				}
 				h.store.deleteGateway(e.NamespacedName)
-			case *appsv1.Deployment, *appsv1.DaemonSet, *corev1.Service, *corev1.ServiceAccount,
-				*corev1.ConfigMap, *rbacv1.Role, *rbacv1.RoleBinding:
+			case *appsv1.Deployment, *appsv1.DaemonSet, *autoscalingv2.HorizontalPodAutoscaler,
+				*policyv1.PodDisruptionBudget, *networkingv1.NetworkPolicy, *corev1.Service,
+				*corev1.ServiceAccount, *corev1.ConfigMap, *rbacv1.Role, *rbacv1.RoleBinding:
 				if err := h.reprovisionResources(ctx, e); err != nil {
 					logger.Error(err, "error re-provisioning nginx resources")
 				}
@@ -163,6 +180,13 @@ func (h *eventHandler) updateOrDeleteResources(
 		return nil
 	}
 
+	// merged Gateways and static data planes don't have their own nginx resources, so leftover ones are removed
+	resources := h.store.getNginxResourcesForGateway(gatewayNSName)
+	if resources != nil && resources.Gateway != nil &&
+		resources.Gateway.Valid && !dataPlaneProvisioned(resources.Gateway) {
+		return h.provisioner.deleteObject(ctx, obj)
+	}
+
 	if h.store.getResourceVersionForObject(gatewayNSName, obj) == obj.GetResourceVersion() {
 		return nil
 	}
@@ -175,6 +199,21 @@ func (h *eventHandler) updateOrDeleteResources(
 	return nil
 }
 
+// deleteLeftoverStagedDeployment deletes a staged Deployment of a blue/green image upgrade that is not running
+// anymore, for example, because the control plane restarted during the upgrade. The staged Deployment of a
+// running upgrade is managed by the upgrade.
+func (h *eventHandler) deleteLeftoverStagedDeployment(
+	ctx context.Context,
+	obj client.Object,
+	gatewayNSName types.NamespacedName,
+) error {
+	if upgrade := h.provisioner.upgrades.get(gatewayNSName); upgrade != nil && upgrade.running() {
+		return nil
+	}
+
+	return h.provisioner.deleteObject(ctx, obj)
+}
+
 func (h *eventHandler) provisionResource(
 	ctx context.Context,
 	logger logr.Logger,
@@ -182,14 +221,11 @@ func (h *eventHandler) provisionResource(
 	obj client.Object,
 ) error {
 	resources := h.store.getNginxResourcesForGateway(gatewayNSName)
-	if resources != nil && resources.Gateway != nil {
+	// merged Gateways and static data planes don't have their own nginx resources
+	if resources != nil && resources.Gateway != nil && dataPlaneProvisioned(resources.Gateway) {
 		resourceName := controller.CreateNginxResourceName(gatewayNSName.Name, h.gcName)
 
-		objects, err := h.provisioner.buildNginxResourceObjects(
-			resourceName,
-			resources.Gateway.Source,
-			resources.Gateway.EffectiveNginxProxy,
-		)
+		objects, err := h.provisioner.buildNginxResourceObjects(resourceName, resources.Gateway)
 		if err != nil {
 			logger.Error(err, "error building some nginx resources")
 		}
@@ -222,14 +258,10 @@ func (h *eventHandler) provisionResource(
 
 // reprovisionResources redeploys nginx resources that have been deleted but should not have been.
 func (h *eventHandler) reprovisionResources(ctx context.Context, event *events.DeleteEvent) error {
-	if gateway := h.store.gatewayExistsForResource(event.Type, event.NamespacedName); gateway != nil && gateway.Valid {
+	gateway := h.store.gatewayExistsForResource(event.Type, event.NamespacedName)
+	if gateway != nil && gateway.Valid && dataPlaneProvisioned(gateway) && !gateway.DataPlaneQuotaExceeded {
 		resourceName := controller.CreateNginxResourceName(gateway.Source.GetName(), h.gcName)
-		if err := h.provisioner.reprovisionNginx(
-			ctx,
-			resourceName,
-			gateway.Source,
-			gateway.EffectiveNginxProxy,
-		); err != nil {
+		if err := h.provisioner.reprovisionNginx(ctx, resourceName, gateway); err != nil {
 			return err
 		}
 	}
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/status"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
@@ -23,7 +24,7 @@ func TestHandleEventBatch_Upsert(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	store := newStore([]string{dockerTestSecretName}, "", jwtTestSecretName, "", "")
+	store := newStore("nginx", []string{dockerTestSecretName}, "", jwtTestSecretName, "", "")
 	provisioner, fakeClient, _ := defaultNginxProvisioner()
 	provisioner.cfg.StatusQueue = status.NewQueue()
 
@@ -64,6 +65,15 @@ func TestHandleEventBatch_Upsert(t *testing.T) {
 		},
 	}
 
+	internalService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "gw-nginx-internal",
+			Namespace:       "default",
+			ResourceVersion: "1",
+			Labels:          map[string]string{"app": "nginx", controller.GatewayLabel: "gw"},
+		},
+	}
+
 	jwtSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            "gw-nginx-" + jwtTestSecretName,
@@ -129,12 +139,19 @@ func TestHandleEventBatch_Upsert(t *testing.T) {
 
 	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})).To(Succeed())
 
+	// Test handling internal Service, which doesn't update the Gateway status
+	upsertEvent = &events.UpsertEvent{Resource: internalService}
+	batch = events.EventBatch{upsertEvent}
+	handler.HandleEventBatch(ctx, logger, batch)
+
 	// Test handling Service
 	upsertEvent = &events.UpsertEvent{Resource: service}
 	batch = events.EventBatch{upsertEvent}
 	handler.HandleEventBatch(ctx, logger, batch)
 
-	g.Expect(provisioner.cfg.StatusQueue.Dequeue(ctx)).ToNot(BeNil())
+	statusUpdate := provisioner.cfg.StatusQueue.Dequeue(ctx)
+	g.Expect(statusUpdate).ToNot(BeNil())
+	g.Expect(statusUpdate.GatewayService).To(Equal(service))
 	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), &corev1.Service{})).To(Succeed())
 
 	// Test handling provisioned Secret
@@ -203,11 +220,123 @@ func TestHandleEventBatch_Upsert(t *testing.T) {
 	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})).To(Succeed())
 }
 
+func TestHandleEventBatch_UpsertStagedDeployment(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := newStore("nginx", nil, "", "", "", "")
+	provisioner, fakeClient, _ := defaultNginxProvisioner()
+	provisioner.cfg.StatusQueue = status.NewQueue()
+
+	labelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "nginx"},
+	}
+
+	handler, err := newEventHandler(store, provisioner, labelSelector, "nginx")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := context.TODO()
+	logger := logr.Discard()
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+	}
+	gatewayNSName := client.ObjectKeyFromObject(gateway)
+	store.updateGateway(gateway)
+	store.registerResourceInGatewayConfig(gatewayNSName, &graph.Gateway{Source: gateway, Valid: true})
+
+	staged := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw-nginx-staged",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":                         "nginx",
+				controller.GatewayLabel:       "gw",
+				controller.DataPlaneSlotLabel: dataPlaneSlotStaged,
+			},
+		},
+	}
+	g.Expect(fakeClient.Create(ctx, staged)).To(Succeed())
+
+	// the staged Deployment of a running upgrade is left to the upgrade
+	_, upgrade := provisioner.upgrades.start(ctx, gatewayNSName, "nginx:1", "nginx:2")
+
+	handler.HandleEventBatch(ctx, logger, events.EventBatch{&events.UpsertEvent{Resource: staged}})
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(staged), &appsv1.Deployment{})).To(Succeed())
+	g.Expect(store.getNginxResourcesForGateway(gatewayNSName).Deployment.Name).To(BeEmpty())
+
+	// a staged Deployment that is left over from a finished upgrade is removed
+	close(upgrade.done)
+
+	handler.HandleEventBatch(ctx, logger, events.EventBatch{&events.UpsertEvent{Resource: staged}})
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(staged), &appsv1.Deployment{})).ToNot(Succeed())
+	g.Expect(store.getNginxResourcesForGateway(gatewayNSName).Deployment.Name).To(BeEmpty())
+}
+
+func TestHandleEventBatch_UpsertStaticDataPlaneResource(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := newStore("nginx", nil, "", "", "", "")
+	provisioner, fakeClient, _ := defaultNginxProvisioner()
+	provisioner.cfg.StatusQueue = status.NewQueue()
+
+	labelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "nginx"},
+	}
+
+	handler, err := newEventHandler(store, provisioner, labelSelector, "nginx")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ctx := context.TODO()
+	logger := logr.Discard()
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+	}
+	gatewayNSName := client.ObjectKeyFromObject(gateway)
+	store.updateGateway(gateway)
+	store.registerResourceInGatewayConfig(gatewayNSName, &graph.Gateway{
+		Source: gateway,
+		Valid:  true,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			StaticDataPlane: &ngfAPIv1alpha2.StaticDataPlane{TokenSecretName: "agent-token"},
+		},
+	})
+
+	// a Deployment that is left over from before the Gateway switched to a static data plane is removed
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw-nginx",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":                   "nginx",
+				controller.GatewayLabel: "gw",
+			},
+		},
+	}
+	g.Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+	handler.HandleEventBatch(ctx, logger, events.EventBatch{&events.UpsertEvent{Resource: deployment}})
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})).ToNot(Succeed())
+	g.Expect(store.getNginxResourcesForGateway(gatewayNSName).Deployment.Name).To(BeEmpty())
+}
+
 func TestHandleEventBatch_Delete(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
 	store := newStore(
+		"nginx",
 		[]string{dockerTestSecretName},
 		agentTLSTestSecretName,
 		jwtTestSecretName,
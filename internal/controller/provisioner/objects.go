@@ -5,19 +5,30 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net"
+	"net/netip"
+	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
@@ -29,6 +40,7 @@ const (
 	defaultNginxErrorLogLevel        = "info"
 	nginxIncludesConfigMapNameSuffix = "includes-bootstrap"
 	nginxAgentConfigMapNameSuffix    = "agent-config"
+	nginxInternalServiceNameSuffix   = "internal"
 
 	defaultServiceType   = corev1.ServiceTypeLoadBalancer
 	defaultServicePolicy = corev1.ServiceExternalTrafficPolicyLocal
@@ -36,15 +48,20 @@ const (
 	defaultNginxImagePath     = "ghcr.io/nginx/nginx-gateway-fabric/nginx"
 	defaultNginxPlusImagePath = "private-registry.nginx.com/nginx-gateway-fabric/nginx-plus"
 	defaultImagePullPolicy    = corev1.PullIfNotPresent
+
+	// defaultOTLPPort is the default port of an OTLP/gRPC endpoint.
+	defaultOTLPPort = 4317
 )
 
 var emptyDirVolumeSource = corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
 
 func (p *NginxProvisioner) buildNginxResourceObjects(
 	resourceName string,
-	gateway *gatewayv1.Gateway,
-	nProxyCfg *graph.EffectiveNginxProxy,
+	graphGateway *graph.Gateway,
 ) ([]client.Object, error) {
+	gateway := graphGateway.Source
+	nProxyCfg := graphGateway.EffectiveNginxProxy
+
 	// Need to ensure nginx resource objects are generated deterministically. Specifically when generating
 	// an object's field by ranging over a map, since ranging over a map is done in random order, we need to
 	// do some processing to ensure the generated results are the same each time.
@@ -91,6 +108,9 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 		}
 	}
 
+	// the cost allocation labels are added last, so that they can't be overridden by the labels of the Gateway
+	maps.Copy(labels, costAllocationLabels(nProxyCfg))
+
 	objectMeta := metav1.ObjectMeta{
 		Name:        resourceName,
 		Namespace:   gateway.GetNamespace(),
@@ -126,17 +146,38 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 	}
 
 	ports := make(map[int32]struct{})
-	for _, listener := range gateway.Spec.Listeners {
+	// quicPorts are the UDP ports that NGINX accepts HTTP/3 connections on.
+	quicPorts := make(map[int32]struct{})
+	http3Enabled := graph.HTTP3EnabledForNginxProxy(nProxyCfg)
+	// the data plane also serves the listeners of the Gateways merged onto it
+	listeners := slices.Clone(gateway.Spec.Listeners)
+	for _, mergedGateway := range graphGateway.MergedGateways {
+		listeners = append(listeners, mergedGateway.Spec.Listeners...)
+	}
+
+	for _, listener := range listeners {
 		ports[int32(listener.Port)] = struct{}{}
+		if http3Enabled && listener.Protocol == gatewayv1.HTTPSProtocolType {
+			quicPorts[int32(listener.Port)] = struct{}{}
+		}
+	}
+
+	// With blue/green image upgrades, the Services select the Pods of the NGINX Deployment by their slot,
+	// so that the traffic can be shifted to the Pods of the staged Deployment during an upgrade.
+	serviceSelector := selectorLabels
+	if blueGreenUpgradeEnabled(nProxyCfg) {
+		serviceSelector = dataPlaneSlotSelector(selectorLabels, dataPlaneSlotPrimary)
 	}
 
-	service := buildNginxService(objectMeta, nProxyCfg, ports, selectorLabels)
+	service := buildNginxService(objectMeta, nProxyCfg, ports, quicPorts, serviceSelector)
+	internalService := buildNginxInternalService(objectMeta, nProxyCfg, service)
 	deployment := p.buildNginxDeployment(
 		objectMeta,
 		nProxyCfg,
 		ngxIncludesConfigMapName,
 		ngxAgentConfigMapName,
 		ports,
+		quicPorts,
 		selectorLabels,
 		agentTLSSecretName,
 		dockerSecretNames,
@@ -151,18 +192,55 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 	// serviceaccount
 	// role/binding (if openshift)
 	// service
+	// internal service (if enabled)
 	// deployment/daemonset
+	// horizontalpodautoscaler (if enabled)
+	// poddisruptionbudget (if configured)
+	// networkpolicy (if enabled)
+	// podmonitor (if enabled and the CRD is installed)
 
-	objects := make([]client.Object, 0, len(configmaps)+len(secrets)+len(openshiftObjs)+3)
+	objects := make([]client.Object, 0, len(configmaps)+len(secrets)+len(openshiftObjs)+8)
 	objects = append(objects, secrets...)
 	objects = append(objects, configmaps...)
 	objects = append(objects, serviceAccount)
 	if p.isOpenshift {
 		objects = append(objects, openshiftObjs...)
 	}
-	objects = append(objects, service, deployment)
+	objects = append(objects, service)
+	if internalService != nil {
+		objects = append(objects, internalService)
+	}
+	objects = append(objects, deployment)
+	if hpa := buildNginxHorizontalPodAutoscaler(objectMeta, nProxyCfg); hpa != nil {
+		objects = append(objects, hpa)
+	}
+	if pdb := buildNginxPodDisruptionBudget(objectMeta, nProxyCfg, selectorLabels); pdb != nil {
+		objects = append(objects, pdb)
+	}
+
+	networkPolicy := p.buildNginxNetworkPolicy(
+		objectMeta,
+		nProxyCfg,
+		ports,
+		quicPorts,
+		selectorLabels,
+		graphGateway.BackendServices,
+	)
+	if networkPolicy != nil {
+		objects = append(objects, networkPolicy)
+	}
+
+	podMonitor, podMonitorErr := buildNginxPodMonitor(
+		objectMeta,
+		nProxyCfg,
+		selectorLabels,
+		graphGateway.PodMonitorCRDInstalled,
+	)
+	if podMonitor != nil {
+		objects = append(objects, podMonitor)
+	}
 
-	return objects, err
+	return objects, errors.Join(err, podMonitorErr)
 }
 
 func (p *NginxProvisioner) buildNginxSecrets(
@@ -411,6 +489,7 @@ func buildNginxService(
 	objectMeta metav1.ObjectMeta,
 	nProxyCfg *graph.EffectiveNginxProxy,
 	ports map[int32]struct{},
+	quicPorts map[int32]struct{},
 	selectorLabels map[string]string,
 ) *corev1.Service {
 	var serviceCfg ngfAPIv1alpha2.ServiceSpec
@@ -431,21 +510,37 @@ func buildNginxService(
 		}
 	}
 
-	servicePorts := make([]corev1.ServicePort, 0, len(ports))
+	setNodePort := func(servicePort *corev1.ServicePort) {
+		if serviceType != corev1.ServiceTypeClusterIP {
+			for _, nodePort := range serviceCfg.NodePorts {
+				if nodePort.ListenerPort == servicePort.Port {
+					servicePort.NodePort = nodePort.Port
+				}
+			}
+		}
+	}
+
+	servicePorts := make([]corev1.ServicePort, 0, len(ports)+len(quicPorts))
 	for port := range ports {
 		servicePort := corev1.ServicePort{
 			Name:       fmt.Sprintf("port-%d", port),
 			Port:       port,
 			TargetPort: intstr.FromInt32(port),
 		}
+		setNodePort(&servicePort)
 
-		if serviceType != corev1.ServiceTypeClusterIP {
-			for _, nodePort := range serviceCfg.NodePorts {
-				if nodePort.ListenerPort == port {
-					servicePort.NodePort = nodePort.Port
-				}
-			}
+		servicePorts = append(servicePorts, servicePort)
+	}
+
+	// a node port can be used by both TCP and UDP, so the QUIC port shares the node port of the listener.
+	for port := range quicPorts {
+		servicePort := corev1.ServicePort{
+			Name:       fmt.Sprintf("port-%d-quic", port),
+			Protocol:   corev1.ProtocolUDP,
+			Port:       port,
+			TargetPort: intstr.FromInt32(port),
 		}
+		setNodePort(&servicePort)
 
 		servicePorts = append(servicePorts, servicePort)
 	}
@@ -453,20 +548,33 @@ func buildNginxService(
 	// need to sort ports so everytime buildNginxService is called it will generate the exact same
 	// array of ports. This is needed to satisfy deterministic results of the method.
 	sort.Slice(servicePorts, func(i, j int) bool {
-		return servicePorts[i].Port < servicePorts[j].Port
+		if servicePorts[i].Port != servicePorts[j].Port {
+			return servicePorts[i].Port < servicePorts[j].Port
+		}
+		return servicePorts[i].Protocol < servicePorts[j].Protocol
 	})
 
+	ipFamilyPolicy := corev1.IPFamilyPolicyPreferDualStack
+	if serviceCfg.IPFamilyPolicy != nil {
+		ipFamilyPolicy = corev1.IPFamilyPolicy(*serviceCfg.IPFamilyPolicy)
+	}
+
 	svc := &corev1.Service{
-		ObjectMeta: objectMeta,
+		ObjectMeta: buildServiceObjectMeta(objectMeta, serviceCfg.Labels, serviceCfg.Annotations),
 		Spec: corev1.ServiceSpec{
 			Type:                  serviceType,
 			Ports:                 servicePorts,
 			ExternalTrafficPolicy: servicePolicy,
 			Selector:              selectorLabels,
-			IPFamilyPolicy:        helpers.GetPointer(corev1.IPFamilyPolicyPreferDualStack),
+			IPFamilyPolicy:        &ipFamilyPolicy,
+			ExternalIPs:           serviceCfg.ExternalIPs,
 		},
 	}
 
+	if serviceCfg.SessionAffinity != nil {
+		svc.Spec.SessionAffinity = corev1.ServiceAffinity(*serviceCfg.SessionAffinity)
+	}
+
 	setIPFamily(nProxyCfg, svc)
 
 	if serviceCfg.LoadBalancerIP != nil {
@@ -482,6 +590,80 @@ func buildNginxService(
 	return svc
 }
 
+// buildNginxInternalService builds the additional ClusterIP Service for the NGINX data plane,
+// which exposes the same ports as the main Service.
+func buildNginxInternalService(
+	objectMeta metav1.ObjectMeta,
+	nProxyCfg *graph.EffectiveNginxProxy,
+	service *corev1.Service,
+) *corev1.Service {
+	if !internalServiceEnabled(nProxyCfg) {
+		return nil
+	}
+
+	internalCfg := nProxyCfg.Kubernetes.Service.InternalService
+
+	internalMeta := buildServiceObjectMeta(objectMeta, internalCfg.Labels, internalCfg.Annotations)
+	internalMeta.Name = controller.CreateNginxResourceName(objectMeta.Name, nginxInternalServiceNameSuffix)
+
+	ports := make([]corev1.ServicePort, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		port.NodePort = 0
+		ports = append(ports, port)
+	}
+
+	return &corev1.Service{
+		ObjectMeta: internalMeta,
+		Spec: corev1.ServiceSpec{
+			Type:            corev1.ServiceTypeClusterIP,
+			Ports:           ports,
+			Selector:        service.Spec.Selector,
+			SessionAffinity: service.Spec.SessionAffinity,
+			IPFamilyPolicy:  service.Spec.IPFamilyPolicy,
+			IPFamilies:      service.Spec.IPFamilies,
+		},
+	}
+}
+
+// internalServiceEnabled returns whether an additional ClusterIP Service is configured for the NGINX data plane.
+func internalServiceEnabled(nProxyCfg *graph.EffectiveNginxProxy) bool {
+	if nProxyCfg == nil || nProxyCfg.Kubernetes == nil || nProxyCfg.Kubernetes.Service == nil {
+		return false
+	}
+
+	internalCfg := nProxyCfg.Kubernetes.Service.InternalService
+	return internalCfg != nil && internalCfg.Enable
+}
+
+// isInternalService returns whether the Service is the internal ClusterIP Service of the NGINX data plane
+// with the provided Deployment name. The name is compared in full, since the name of the main Service,
+// which is the Deployment name, also ends with the suffix of the internal Service if the GatewayClass
+// is named after it.
+func isInternalService(name, deploymentName string) bool {
+	return name == controller.CreateNginxResourceName(deploymentName, nginxInternalServiceNameSuffix)
+}
+
+// buildServiceObjectMeta merges the labels and annotations configured for a Service in the NginxProxy with the
+// ones of the other NGINX resources. The latter, which include the Gateway infrastructure labels and annotations,
+// take precedence.
+func buildServiceObjectMeta(
+	objectMeta metav1.ObjectMeta,
+	labels map[string]string,
+	annotations map[string]string,
+) metav1.ObjectMeta {
+	serviceMeta := objectMeta
+
+	serviceMeta.Labels = make(map[string]string, len(labels)+len(objectMeta.Labels))
+	maps.Copy(serviceMeta.Labels, labels)
+	maps.Copy(serviceMeta.Labels, objectMeta.Labels)
+
+	serviceMeta.Annotations = make(map[string]string, len(annotations)+len(objectMeta.Annotations))
+	maps.Copy(serviceMeta.Annotations, annotations)
+	maps.Copy(serviceMeta.Annotations, objectMeta.Annotations)
+
+	return serviceMeta
+}
+
 func setIPFamily(nProxyCfg *graph.EffectiveNginxProxy, svc *corev1.Service) {
 	if nProxyCfg != nil && nProxyCfg.IPFamily != nil && *nProxyCfg.IPFamily != ngfAPIv1alpha2.Dual {
 		svc.Spec.IPFamilyPolicy = helpers.GetPointer(corev1.IPFamilyPolicySingleStack)
@@ -499,6 +681,7 @@ func (p *NginxProvisioner) buildNginxDeployment(
 	ngxIncludesConfigMapName string,
 	ngxAgentConfigMapName string,
 	ports map[int32]struct{},
+	quicPorts map[int32]struct{},
 	selectorLabels map[string]string,
 	agentTLSSecretName string,
 	dockerSecretNames map[string]string,
@@ -512,6 +695,7 @@ func (p *NginxProvisioner) buildNginxDeployment(
 		ngxIncludesConfigMapName,
 		ngxAgentConfigMapName,
 		ports,
+		quicPorts,
 		agentTLSSecretName,
 		dockerSecretNames,
 		jwtSecretName,
@@ -546,13 +730,548 @@ func (p *NginxProvisioner) buildNginxDeployment(
 		deploymentCfg = *nProxyCfg.Kubernetes.Deployment
 	}
 
-	if deploymentCfg.Replicas != nil {
+	// If autoscaling is enabled, the replicas are managed by the HorizontalPodAutoscaler.
+	if deploymentCfg.Replicas != nil && !autoscalingEnabled(nProxyCfg) {
 		deployment.Spec.Replicas = deploymentCfg.Replicas
 	}
 
+	if deploymentCfg.Strategy != nil {
+		deployment.Spec.Strategy = *deploymentCfg.Strategy
+	}
+
+	// The selector of the Deployment is immutable, so only the Pods are labeled with their slot.
+	if blueGreenUpgradeEnabled(nProxyCfg) {
+		deployment.Spec.Template.Labels = dataPlaneSlotSelector(podTemplateSpec.Labels, dataPlaneSlotPrimary)
+	}
+
 	return deployment
 }
 
+// blueGreenUpgradeEnabled returns whether the NGINX Deployment is upgraded to a new image with the blue/green
+// strategy.
+func blueGreenUpgradeEnabled(nProxyCfg *graph.EffectiveNginxProxy) bool {
+	if nProxyCfg == nil || nProxyCfg.Kubernetes == nil || nProxyCfg.Kubernetes.DaemonSet != nil {
+		return false
+	}
+
+	deploymentCfg := nProxyCfg.Kubernetes.Deployment
+	return deploymentCfg != nil && deploymentCfg.ImageUpgradeStrategy != nil &&
+		*deploymentCfg.ImageUpgradeStrategy == ngfAPIv1alpha2.ImageUpgradeStrategyBlueGreen
+}
+
+// dataPlaneSlotSelector returns a copy of the labels with the data plane slot label set to the slot.
+func dataPlaneSlotSelector(labels map[string]string, slot string) map[string]string {
+	selector := maps.Clone(labels)
+	if selector == nil {
+		selector = make(map[string]string, 1)
+	}
+	selector[controller.DataPlaneSlotLabel] = slot
+
+	return selector
+}
+
+// autoscalingEnabled returns whether a HorizontalPodAutoscaler manages the replicas of the NGINX Deployment.
+func autoscalingEnabled(nProxyCfg *graph.EffectiveNginxProxy) bool {
+	if nProxyCfg == nil || nProxyCfg.Kubernetes == nil || nProxyCfg.Kubernetes.DaemonSet != nil {
+		return false
+	}
+
+	deploymentCfg := nProxyCfg.Kubernetes.Deployment
+	return deploymentCfg != nil && deploymentCfg.Autoscaling != nil && deploymentCfg.Autoscaling.Enable
+}
+
+func buildNginxHorizontalPodAutoscaler(
+	objectMeta metav1.ObjectMeta,
+	nProxyCfg *graph.EffectiveNginxProxy,
+) client.Object {
+	if !autoscalingEnabled(nProxyCfg) {
+		return nil
+	}
+
+	autoscaling := nProxyCfg.Kubernetes.Deployment.Autoscaling
+
+	var metrics []autoscalingv2.MetricSpec
+	resourceTargets := []struct {
+		utilization *int32
+		name        corev1.ResourceName
+	}{
+		{utilization: autoscaling.TargetCPUUtilizationPercentage, name: corev1.ResourceCPU},
+		{utilization: autoscaling.TargetMemoryUtilizationPercentage, name: corev1.ResourceMemory},
+	}
+
+	for _, target := range resourceTargets {
+		if target.utilization == nil {
+			continue
+		}
+
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: target.name,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: target.utilization,
+				},
+			},
+		})
+	}
+
+	metrics = append(metrics, autoscaling.Metrics...)
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: objectMeta,
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       objectMeta.Name,
+			},
+			MinReplicas: autoscaling.MinReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+			Behavior:    autoscaling.Behavior,
+		},
+	}
+}
+
+// podDisruptionBudgetEnabled returns whether a PodDisruptionBudget is configured for the NGINX Pods.
+func podDisruptionBudgetEnabled(nProxyCfg *graph.EffectiveNginxProxy) bool {
+	return nProxyCfg != nil && nProxyCfg.Kubernetes != nil && nProxyCfg.Kubernetes.PodDisruptionBudget != nil
+}
+
+func buildNginxPodDisruptionBudget(
+	objectMeta metav1.ObjectMeta,
+	nProxyCfg *graph.EffectiveNginxProxy,
+	selectorLabels map[string]string,
+) client.Object {
+	if !podDisruptionBudgetEnabled(nProxyCfg) {
+		return nil
+	}
+
+	pdbCfg := nProxyCfg.Kubernetes.PodDisruptionBudget
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: objectMeta,
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: selectorLabels,
+			},
+			MinAvailable:   pdbCfg.MinAvailable,
+			MaxUnavailable: pdbCfg.MaxUnavailable,
+		},
+	}
+}
+
+// costAllocationLabels returns the labels that attribute the cost of the nginx resources to a team or cost center.
+func costAllocationLabels(nProxyCfg *graph.EffectiveNginxProxy) map[string]string {
+	if nProxyCfg == nil || nProxyCfg.Kubernetes == nil || nProxyCfg.Kubernetes.CostAllocation == nil {
+		return nil
+	}
+
+	costAllocation := nProxyCfg.Kubernetes.CostAllocation
+	labels := make(map[string]string)
+
+	if costAllocation.Team != nil && *costAllocation.Team != "" {
+		labels[controller.TeamLabel] = *costAllocation.Team
+	}
+
+	if costAllocation.CostCenter != nil && *costAllocation.CostCenter != "" {
+		labels[controller.CostCenterLabel] = *costAllocation.CostCenter
+	}
+
+	return labels
+}
+
+// networkPolicyEnabled returns whether a NetworkPolicy is enabled for the NGINX Pods.
+func networkPolicyEnabled(nProxyCfg *graph.EffectiveNginxProxy) bool {
+	return nProxyCfg != nil &&
+		nProxyCfg.Kubernetes != nil &&
+		nProxyCfg.Kubernetes.NetworkPolicy != nil &&
+		nProxyCfg.Kubernetes.NetworkPolicy.Enable != nil &&
+		*nProxyCfg.Kubernetes.NetworkPolicy.Enable
+}
+
+func (p *NginxProvisioner) buildNginxNetworkPolicy(
+	objectMeta metav1.ObjectMeta,
+	nProxyCfg *graph.EffectiveNginxProxy,
+	ports map[int32]struct{},
+	quicPorts map[int32]struct{},
+	selectorLabels map[string]string,
+	backendServices []graph.BackendService,
+) client.Object {
+	if !networkPolicyEnabled(nProxyCfg) {
+		return nil
+	}
+
+	tcp := corev1.ProtocolTCP
+	udp := corev1.ProtocolUDP
+
+	ingressPorts := make([]networkingv1.NetworkPolicyPort, 0, len(ports)+len(quicPorts))
+	for _, port := range slices.Sorted(maps.Keys(ports)) {
+		ingressPorts = append(ingressPorts, networkingv1.NetworkPolicyPort{
+			Protocol: &tcp,
+			Port:     helpers.GetPointer(intstr.FromInt32(port)),
+		})
+	}
+	for _, port := range slices.Sorted(maps.Keys(quicPorts)) {
+		ingressPorts = append(ingressPorts, networkingv1.NetworkPolicyPort{
+			Protocol: &udp,
+			Port:     helpers.GetPointer(intstr.FromInt32(port)),
+		})
+	}
+
+	// If the Service restricts its source ranges, only allow traffic from those ranges. Clients of the internal
+	// Service are in the cluster, so allow traffic from all Pods in that case.
+	var ingressPeers []networkingv1.NetworkPolicyPeer
+	if nProxyCfg.Kubernetes.Service != nil && len(nProxyCfg.Kubernetes.Service.LoadBalancerSourceRanges) > 0 {
+		for _, cidr := range nProxyCfg.Kubernetes.Service.LoadBalancerSourceRanges {
+			ingressPeers = append(ingressPeers, networkingv1.NetworkPolicyPeer{
+				IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+			})
+		}
+
+		if internalServiceEnabled(nProxyCfg) {
+			ingressPeers = append(ingressPeers, networkingv1.NetworkPolicyPeer{
+				NamespaceSelector: &metav1.LabelSelector{},
+			})
+		}
+	}
+
+	var ingress []networkingv1.NetworkPolicyIngressRule
+	if len(ingressPorts) > 0 {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: ingressPorts,
+			From:  ingressPeers,
+		})
+	}
+
+	// Prometheus scrapes the metrics from wherever it runs, so allow traffic to the metrics port from any source.
+	if port, enabled := graph.MetricsEnabledForNginxProxy(nProxyCfg); enabled {
+		metricsPort := config.DefaultNginxMetricsPort
+		if port != nil {
+			metricsPort = *port
+		}
+
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: &tcp,
+					Port:     helpers.GetPointer(intstr.FromInt32(metricsPort)),
+				},
+			},
+		})
+	}
+
+	egress := make([]networkingv1.NetworkPolicyEgressRule, 0, len(backendServices)+3)
+	for _, svc := range backendServices {
+		egressPorts := make([]networkingv1.NetworkPolicyPort, 0, len(svc.Ports))
+		for _, svcPort := range svc.Ports {
+			targetPort := svcPort.TargetPort
+			if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+				targetPort = intstr.FromInt32(svcPort.Port)
+			}
+
+			protocol := svcPort.Protocol
+			if protocol == "" {
+				protocol = corev1.ProtocolTCP
+			}
+
+			egressPorts = append(egressPorts, networkingv1.NetworkPolicyPort{
+				Protocol: &protocol,
+				Port:     &targetPort,
+			})
+		}
+
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			Ports: egressPorts,
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					NamespaceSelector: namespaceLabelSelector(svc.NsName.Namespace),
+					PodSelector:       &metav1.LabelSelector{MatchLabels: svc.Selector},
+				},
+			},
+		})
+	}
+
+	// the agent connects to the control plane over gRPC
+	egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{
+				Protocol: &tcp,
+				Port:     helpers.GetPointer(intstr.FromInt32(p.cfg.GRPCServerPort)),
+			},
+		},
+		To: []networkingv1.NetworkPolicyPeer{
+			{
+				NamespaceSelector: namespaceLabelSelector(p.cfg.GatewayPodConfig.Namespace),
+			},
+		},
+	})
+
+	// the agent resolves the control plane Service, and NGINX may resolve upstream hostnames
+	dnsPort := intstr.FromInt32(53)
+	egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &udp, Port: &dnsPort},
+			{Protocol: &tcp, Port: &dnsPort},
+		},
+	})
+
+	// NGINX Plus reports its usage to an external endpoint
+	if p.cfg.Plus && p.cfg.PlusUsageConfig != nil {
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: &tcp,
+					Port:     helpers.GetPointer(intstr.FromInt32(endpointPort(p.cfg.PlusUsageConfig.Endpoint, 443))),
+				},
+			},
+		})
+	}
+
+	// NGINX exports traces to the OpenTelemetry collector, which may run in or outside the cluster
+	if tel := nProxyCfg.Telemetry; tel != nil && tel.Exporter != nil && tel.Exporter.Endpoint != nil {
+		endpoint := strings.TrimPrefix(*tel.Exporter.Endpoint, "http://")
+
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: &tcp,
+					Port:     helpers.GetPointer(intstr.FromInt32(endpointPort(endpoint, defaultOTLPPort))),
+				},
+			},
+		})
+	}
+
+	// NGINX fetches the OCSP responses from the responders of the certificate authorities over HTTP,
+	// and resolves their hostnames with the configured resolver, which may listen on a port other than 53
+	if tls := nProxyCfg.TLS; tls != nil && tls.OCSPStapling != nil &&
+		tls.OCSPStapling.Enable != nil && *tls.OCSPStapling.Enable {
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: &tcp,
+					Port:     helpers.GetPointer(intstr.FromInt32(80)),
+				},
+			},
+		})
+
+		if tls.OCSPStapling.Resolver != nil {
+			egress = append(egress, resolverEgressRules(tls.OCSPStapling.Resolver.Addresses)...)
+		}
+	}
+
+	// the Pods are not selected by their Gateway, so that the policy also applies to the Pods of
+	// a staged Deployment during a blue/green upgrade, which are not labeled with the Gateway.
+	podSelector := maps.Clone(selectorLabels)
+	delete(podSelector, controller.GatewayLabel)
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: objectMeta,
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: podSelector},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}
+}
+
+// namespaceLabelSelector returns a label selector that selects the namespace with the provided name.
+func namespaceLabelSelector(namespace string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			corev1.LabelMetadataName: namespace,
+		},
+	}
+}
+
+// endpointPort returns the port of an endpoint in the host:port format, or the default port if it has none.
+func endpointPort(endpoint string, defaultPort int32) int32 {
+	if _, port, err := net.SplitHostPort(endpoint); err == nil {
+		if p, err := strconv.ParseInt(port, 10, 32); err == nil {
+			return int32(p)
+		}
+	}
+
+	return defaultPort
+}
+
+// resolverEgressRules returns the egress rules that allow DNS traffic to the resolver addresses that do not
+// listen on port 53, since DNS traffic on port 53 is already allowed to any destination.
+// The addresses are validated when the NginxProxy is processed, so invalid addresses are skipped.
+func resolverEgressRules(addresses []string) []networkingv1.NetworkPolicyEgressRule {
+	tcp := corev1.ProtocolTCP
+	udp := corev1.ProtocolUDP
+
+	var rules []networkingv1.NetworkPolicyEgressRule
+	for _, addr := range addresses {
+		addrPort, err := netip.ParseAddrPort(addr)
+		if err != nil || addrPort.Port() == 53 {
+			continue
+		}
+
+		port := intstr.FromInt32(int32(addrPort.Port()))
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: &port},
+				{Protocol: &tcp, Port: &port},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					IPBlock: &networkingv1.IPBlock{
+						CIDR: netip.PrefixFrom(addrPort.Addr(), addrPort.Addr().BitLen()).String(),
+					},
+				},
+			},
+		})
+	}
+
+	return rules
+}
+
+// podMonitorGVK is the GroupVersionKind of the Prometheus Operator PodMonitor.
+var podMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "PodMonitor",
+}
+
+// podMonitorSpec mirrors the subset of the Prometheus Operator PodMonitorSpec that is configured for NGINX.
+type podMonitorSpec struct {
+	Selector            metav1.LabelSelector `json:"selector"`
+	PodMetricsEndpoints []podMetricsEndpoint `json:"podMetricsEndpoints"`
+}
+
+type podMetricsEndpoint struct {
+	TLSConfig         *podMonitorTLSConfig           `json:"tlsConfig,omitempty"`
+	Port              string                         `json:"port"`
+	Interval          string                         `json:"interval,omitempty"`
+	ScrapeTimeout     string                         `json:"scrapeTimeout,omitempty"`
+	Scheme            string                         `json:"scheme,omitempty"`
+	Relabelings       []ngfAPIv1alpha2.RelabelConfig `json:"relabelings,omitempty"`
+	MetricRelabelings []ngfAPIv1alpha2.RelabelConfig `json:"metricRelabelings,omitempty"`
+}
+
+type podMonitorTLSConfig struct {
+	CA                 *podMonitorSecretOrConfigMap `json:"ca,omitempty"`
+	ServerName         string                       `json:"serverName,omitempty"`
+	InsecureSkipVerify bool                         `json:"insecureSkipVerify,omitempty"`
+}
+
+type podMonitorSecretOrConfigMap struct {
+	Secret *corev1.SecretKeySelector `json:"secret,omitempty"`
+}
+
+// podMonitorEnabled returns whether a PodMonitor is configured for the NGINX Pods. The PodMonitor requires
+// the NGINX metrics to be enabled.
+func podMonitorEnabled(nProxyCfg *graph.EffectiveNginxProxy) bool {
+	if _, enabled := graph.MetricsEnabledForNginxProxy(nProxyCfg); !enabled {
+		return false
+	}
+
+	return nProxyCfg != nil &&
+		nProxyCfg.Metrics != nil &&
+		nProxyCfg.Metrics.PodMonitor != nil &&
+		nProxyCfg.Metrics.PodMonitor.Enable != nil &&
+		*nProxyCfg.Metrics.PodMonitor.Enable
+}
+
+func buildNginxPodMonitor(
+	objectMeta metav1.ObjectMeta,
+	nProxyCfg *graph.EffectiveNginxProxy,
+	selectorLabels map[string]string,
+	podMonitorCRDInstalled bool,
+) (client.Object, error) {
+	if !podMonitorCRDInstalled || !podMonitorEnabled(nProxyCfg) {
+		return nil, nil
+	}
+
+	podMonitorCfg := nProxyCfg.Metrics.PodMonitor
+
+	endpoint := podMetricsEndpoint{
+		Port:              "metrics",
+		Interval:          prometheusDuration(podMonitorCfg.Interval),
+		ScrapeTimeout:     prometheusDuration(podMonitorCfg.ScrapeTimeout),
+		Relabelings:       podMonitorCfg.Relabelings,
+		MetricRelabelings: podMonitorCfg.MetricRelabelings,
+	}
+
+	if tlsCfg := podMonitorCfg.TLS; tlsCfg != nil {
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = &podMonitorTLSConfig{
+			InsecureSkipVerify: tlsCfg.InsecureSkipVerify != nil && *tlsCfg.InsecureSkipVerify,
+		}
+
+		if tlsCfg.ServerName != nil {
+			endpoint.TLSConfig.ServerName = *tlsCfg.ServerName
+		}
+
+		if tlsCfg.CASecretName != nil {
+			endpoint.TLSConfig.CA = &podMonitorSecretOrConfigMap{
+				Secret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: *tlsCfg.CASecretName},
+					Key:                  "ca.crt",
+				},
+			}
+		}
+	}
+
+	spec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&podMonitorSpec{
+		Selector:            metav1.LabelSelector{MatchLabels: selectorLabels},
+		PodMetricsEndpoints: []podMetricsEndpoint{endpoint},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error building PodMonitor spec: %w", err)
+	}
+
+	labels := make(map[string]string, len(objectMeta.Labels)+len(podMonitorCfg.Labels))
+	maps.Copy(labels, podMonitorCfg.Labels)
+	maps.Copy(labels, objectMeta.Labels)
+
+	podMonitor := &unstructured.Unstructured{}
+	podMonitor.SetGroupVersionKind(podMonitorGVK)
+	podMonitor.SetName(objectMeta.Name)
+	podMonitor.SetNamespace(objectMeta.Namespace)
+	podMonitor.SetLabels(labels)
+	if len(objectMeta.Annotations) > 0 {
+		podMonitor.SetAnnotations(objectMeta.Annotations)
+	}
+	podMonitor.Object["spec"] = spec
+
+	return podMonitor, nil
+}
+
+// newPodMonitor returns an empty PodMonitor with the provided name and namespace.
+func newPodMonitor(objectMeta metav1.ObjectMeta) *unstructured.Unstructured {
+	podMonitor := &unstructured.Unstructured{}
+	podMonitor.SetGroupVersionKind(podMonitorGVK)
+	podMonitor.SetName(objectMeta.Name)
+	podMonitor.SetNamespace(objectMeta.Namespace)
+
+	return podMonitor
+}
+
+// isPodMonitor returns whether the object is a Prometheus Operator PodMonitor.
+func isPodMonitor(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind() == podMonitorGVK
+}
+
+// prometheusDuration converts a duration to the Prometheus format, which requires a unit.
+// Durations without a unit are in seconds.
+func prometheusDuration(duration *ngfAPIv1alpha1.Duration) string {
+	if duration == nil {
+		return ""
+	}
+
+	d := string(*duration)
+	if d != "" && d[len(d)-1] >= '0' && d[len(d)-1] <= '9' {
+		return d + "s"
+	}
+
+	return d
+}
+
 //nolint:gocyclo // will refactor at some point
 func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 	objectMeta metav1.ObjectMeta,
@@ -560,13 +1279,14 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 	ngxIncludesConfigMapName string,
 	ngxAgentConfigMapName string,
 	ports map[int32]struct{},
+	quicPorts map[int32]struct{},
 	agentTLSSecretName string,
 	dockerSecretNames map[string]string,
 	jwtSecretName string,
 	caSecretName string,
 	clientSSLSecretName string,
 ) corev1.PodTemplateSpec {
-	containerPorts := make([]corev1.ContainerPort, 0, len(ports))
+	containerPorts := make([]corev1.ContainerPort, 0, len(ports)+len(quicPorts))
 	for port := range ports {
 		containerPort := corev1.ContainerPort{
 			Name:          fmt.Sprintf("port-%d", port),
@@ -575,6 +1295,15 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 		containerPorts = append(containerPorts, containerPort)
 	}
 
+	for port := range quicPorts {
+		containerPort := corev1.ContainerPort{
+			Name:          fmt.Sprintf("port-%d-quic", port),
+			ContainerPort: port,
+			Protocol:      corev1.ProtocolUDP,
+		}
+		containerPorts = append(containerPorts, containerPort)
+	}
+
 	podAnnotations := make(map[string]string)
 	maps.Copy(podAnnotations, objectMeta.Annotations)
 
@@ -596,7 +1325,10 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 	// need to sort ports so everytime buildNginxPodTemplateSpec is called it will generate the exact same
 	// array of ports. This is needed to satisfy deterministic results of the method.
 	sort.Slice(containerPorts, func(i, j int) bool {
-		return containerPorts[i].ContainerPort < containerPorts[j].ContainerPort
+		if containerPorts[i].ContainerPort != containerPorts[j].ContainerPort {
+			return containerPorts[i].ContainerPort < containerPorts[j].ContainerPort
+		}
+		return containerPorts[i].Protocol < containerPorts[j].Protocol
 	})
 
 	image, pullPolicy := p.buildImage(nProxyCfg)
@@ -766,6 +1498,25 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 			spec.Spec.Tolerations = podSpec.Tolerations
 			spec.Spec.Volumes = append(spec.Spec.Volumes, podSpec.Volumes...)
 			spec.Spec.TopologySpreadConstraints = podSpec.TopologySpreadConstraints
+
+			if podSpec.PriorityClassName != nil {
+				spec.Spec.PriorityClassName = *podSpec.PriorityClassName
+			}
+			if podSpec.SecurityContext != nil {
+				spec.Spec.SecurityContext = mergePodSecurityContext(spec.Spec.SecurityContext, podSpec.SecurityContext)
+			}
+			if podSpec.HostNetwork != nil && *podSpec.HostNetwork {
+				spec.Spec.HostNetwork = true
+				// without this policy, a pod in the host network can't resolve cluster DNS names
+				spec.Spec.DNSPolicy = corev1.DNSClusterFirstWithHostNet
+			}
+			if podSpec.DNSPolicy != nil {
+				spec.Spec.DNSPolicy = *podSpec.DNSPolicy
+			}
+
+			// the NGINX and init containers stay first, since the rest of the spec is built around them
+			spec.Spec.InitContainers = append(spec.Spec.InitContainers, podSpec.InitContainers...)
+			spec.Spec.Containers = append(spec.Spec.Containers, podSpec.Containers...)
 		}
 
 		if containerSpec != nil {
@@ -774,6 +1525,9 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 				container.Resources = *containerSpec.Resources
 			}
 			container.Lifecycle = containerSpec.Lifecycle
+			container.ReadinessProbe = containerSpec.ReadinessProbe
+			container.LivenessProbe = containerSpec.LivenessProbe
+			container.StartupProbe = containerSpec.StartupProbe
 			container.VolumeMounts = append(container.VolumeMounts, containerSpec.VolumeMounts...)
 
 			if containerSpec.Debug != nil && *containerSpec.Debug {
@@ -863,6 +1617,24 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 	return spec
 }
 
+// mergePodSecurityContext returns the configured pod security context, with the fields that are not set
+// taken from the default security context.
+func mergePodSecurityContext(
+	defaultCtx *corev1.PodSecurityContext,
+	configuredCtx *corev1.PodSecurityContext,
+) *corev1.PodSecurityContext {
+	securityContext := configuredCtx.DeepCopy()
+
+	if securityContext.FSGroup == nil {
+		securityContext.FSGroup = defaultCtx.FSGroup
+	}
+	if securityContext.RunAsNonRoot == nil {
+		securityContext.RunAsNonRoot = defaultCtx.RunAsNonRoot
+	}
+
+	return securityContext
+}
+
 func (p *NginxProvisioner) buildImage(nProxyCfg *graph.EffectiveNginxProxy) (string, corev1.PullPolicy) {
 	image := defaultNginxImagePath
 	tag := p.cfg.GatewayPodConfig.Version
@@ -900,8 +1672,13 @@ func (p *NginxProvisioner) buildImage(nProxyCfg *graph.EffectiveNginxProxy) (str
 // have everywhere.
 func (p *NginxProvisioner) buildNginxResourceObjectsForDeletion(deploymentNSName types.NamespacedName) []client.Object {
 	// order to delete:
+	// podmonitor
+	// networkpolicy
+	// poddisruptionbudget
+	// horizontalpodautoscaler
 	// deployment/daemonset
 	// service
+	// internal service
 	// role/binding (if openshift)
 	// serviceaccount
 	// configmaps
@@ -921,8 +1698,28 @@ func (p *NginxProvisioner) buildNginxResourceObjectsForDeletion(deploymentNSName
 	service := &corev1.Service{
 		ObjectMeta: objectMeta,
 	}
+	internalService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.CreateNginxResourceName(deploymentNSName.Name, nginxInternalServiceNameSuffix),
+			Namespace: deploymentNSName.Namespace,
+		},
+	}
 
-	objects := []client.Object{deployment, daemonSet, service}
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: objectMeta,
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: objectMeta,
+	}
+
+	podMonitor := newPodMonitor(objectMeta)
+
+	networkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: objectMeta,
+	}
+
+	objects := []client.Object{podMonitor, networkPolicy, pdb, hpa, deployment, daemonSet, service, internalService}
 
 	if p.isOpenshift {
 		role := &rbacv1.Role{
@@ -1013,3 +1810,52 @@ func (p *NginxProvisioner) buildNginxResourceObjectsForDeletion(deploymentNSName
 
 	return objects
 }
+
+// buildProvisionedNginxResourceObjects returns the nginx resources that were provisioned for a Gateway,
+// in the order to delete them.
+func buildProvisionedNginxResourceObjects(resources *NginxResources) []client.Object {
+	var objects []client.Object
+
+	add := func(meta metav1.ObjectMeta, newObject func(metav1.ObjectMeta) client.Object) {
+		if meta.Name == "" {
+			return
+		}
+
+		objects = append(objects, newObject(metav1.ObjectMeta{Name: meta.Name, Namespace: meta.Namespace}))
+	}
+
+	add(resources.PodMonitor, func(m metav1.ObjectMeta) client.Object { return newPodMonitor(m) })
+	add(resources.NetworkPolicy, func(m metav1.ObjectMeta) client.Object {
+		return &networkingv1.NetworkPolicy{ObjectMeta: m}
+	})
+	add(resources.PodDisruptionBudget, func(m metav1.ObjectMeta) client.Object {
+		return &policyv1.PodDisruptionBudget{ObjectMeta: m}
+	})
+	add(resources.HorizontalPodAutoscaler, func(m metav1.ObjectMeta) client.Object {
+		return &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: m}
+	})
+	add(resources.Deployment, func(m metav1.ObjectMeta) client.Object { return &appsv1.Deployment{ObjectMeta: m} })
+	add(resources.DaemonSet, func(m metav1.ObjectMeta) client.Object { return &appsv1.DaemonSet{ObjectMeta: m} })
+	add(resources.Service, func(m metav1.ObjectMeta) client.Object { return &corev1.Service{ObjectMeta: m} })
+	add(resources.InternalService, func(m metav1.ObjectMeta) client.Object { return &corev1.Service{ObjectMeta: m} })
+	add(resources.RoleBinding, func(m metav1.ObjectMeta) client.Object { return &rbacv1.RoleBinding{ObjectMeta: m} })
+	add(resources.Role, func(m metav1.ObjectMeta) client.Object { return &rbacv1.Role{ObjectMeta: m} })
+	add(resources.ServiceAccount, func(m metav1.ObjectMeta) client.Object {
+		return &corev1.ServiceAccount{ObjectMeta: m}
+	})
+
+	newConfigMap := func(m metav1.ObjectMeta) client.Object { return &corev1.ConfigMap{ObjectMeta: m} }
+	add(resources.BootstrapConfigMap, newConfigMap)
+	add(resources.AgentConfigMap, newConfigMap)
+
+	newSecret := func(m metav1.ObjectMeta) client.Object { return &corev1.Secret{ObjectMeta: m} }
+	add(resources.AgentTLSSecret, newSecret)
+	for _, secret := range resources.DockerSecrets {
+		add(secret, newSecret)
+	}
+	add(resources.PlusJWTSecret, newSecret)
+	add(resources.PlusCASecret, newSecret)
+	add(resources.PlusClientSSLSecret, newSecret)
+
+	return objects
+}
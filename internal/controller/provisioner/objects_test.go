@@ -2,20 +2,27 @@ package provisioner
 
 import (
 	"fmt"
+	"maps"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
@@ -94,23 +101,26 @@ func TestBuildNginxResourceObjects(t *testing.T) {
 	resourceName := "gw-nginx"
 	objects, err := provisioner.buildNginxResourceObjects(
 		resourceName,
-		gateway,
-		&graph.EffectiveNginxProxy{
-			Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
-				Service: &ngfAPIv1alpha2.ServiceSpec{
-					NodePorts: []ngfAPIv1alpha2.NodePort{
-						{
-							Port:         30000,
-							ListenerPort: 80,
-						},
-						{ // ignored
-							Port:         31000,
-							ListenerPort: 789,
+		&graph.Gateway{
+			Source: gateway,
+			EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Service: &ngfAPIv1alpha2.ServiceSpec{
+						NodePorts: []ngfAPIv1alpha2.NodePort{
+							{
+								Port:         30000,
+								ListenerPort: 80,
+							},
+							{ // ignored
+								Port:         31000,
+								ListenerPort: 789,
+							},
 						},
 					},
 				},
 			},
-		})
+		},
+	)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	g.Expect(objects).To(HaveLen(6))
@@ -298,7 +308,10 @@ func TestBuildNginxResourceObjects_NginxProxyConfig(t *testing.T) {
 		},
 	}
 
-	objects, err := provisioner.buildNginxResourceObjects(resourceName, gateway, nProxyCfg)
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	g.Expect(objects).To(HaveLen(6))
@@ -346,6 +359,157 @@ func TestBuildNginxResourceObjects_NginxProxyConfig(t *testing.T) {
 	g.Expect(container.Resources.Limits[corev1.ResourceCPU].Format).To(Equal(resource.Format("100m")))
 }
 
+func TestBuildNginxResourceObjects_PodCustomization(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+		},
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
+		k8sClient: fakeClient,
+	}
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+	}
+
+	maxSurge := intstr.FromInt32(1)
+	maxUnavailable := intstr.FromInt32(0)
+	strategy := appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxSurge:       &maxSurge,
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+
+	readinessProbe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(80)},
+		},
+		PeriodSeconds: 5,
+	}
+	livenessProbe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{Command: []string{"nginx", "-t"}},
+		},
+	}
+	startupProbe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(80)},
+		},
+		FailureThreshold: 30,
+	}
+
+	initContainer := corev1.Container{Name: "wait-for-network", Image: "busybox"}
+	sidecar := corev1.Container{Name: "log-shipper", Image: "fluent-bit"}
+
+	resourceName := "gw-nginx"
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+				Strategy: &strategy,
+				Pod: ngfAPIv1alpha2.PodSpec{
+					PriorityClassName: helpers.GetPointer("gateway-critical"),
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot:       helpers.GetPointer(false),
+						SupplementalGroups: []int64{2000},
+					},
+					HostNetwork:    helpers.GetPointer(true),
+					InitContainers: []corev1.Container{initContainer},
+					Containers:     []corev1.Container{sidecar},
+				},
+				Container: ngfAPIv1alpha2.ContainerSpec{
+					ReadinessProbe: readinessProbe,
+					LivenessProbe:  livenessProbe,
+					StartupProbe:   startupProbe,
+				},
+			},
+		},
+	}
+
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(6))
+
+	dep, ok := objects[5].(*appsv1.Deployment)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(dep.Spec.Strategy).To(Equal(strategy))
+
+	podSpec := dep.Spec.Template.Spec
+	g.Expect(podSpec.PriorityClassName).To(Equal("gateway-critical"))
+	g.Expect(podSpec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+		FSGroup:            helpers.GetPointer[int64](1001),
+		RunAsNonRoot:       helpers.GetPointer(false),
+		SupplementalGroups: []int64{2000},
+	}))
+	g.Expect(podSpec.HostNetwork).To(BeTrue())
+	g.Expect(podSpec.DNSPolicy).To(Equal(corev1.DNSClusterFirstWithHostNet))
+
+	g.Expect(podSpec.InitContainers).To(HaveLen(2))
+	g.Expect(podSpec.InitContainers[0].Name).To(Equal("init"))
+	g.Expect(podSpec.InitContainers[1]).To(Equal(initContainer))
+
+	g.Expect(podSpec.Containers).To(HaveLen(2))
+	g.Expect(podSpec.Containers[1]).To(Equal(sidecar))
+
+	container := podSpec.Containers[0]
+	g.Expect(container.Name).To(Equal("nginx"))
+	g.Expect(container.ReadinessProbe).To(Equal(readinessProbe))
+	g.Expect(container.LivenessProbe).To(Equal(livenessProbe))
+	g.Expect(container.StartupProbe).To(Equal(startupProbe))
+
+	// the DNS policy can be overridden for a DaemonSet in the host network
+	nProxyCfg.Kubernetes = &ngfAPIv1alpha2.KubernetesSpec{
+		DaemonSet: &ngfAPIv1alpha2.DaemonSetSpec{
+			Pod: ngfAPIv1alpha2.PodSpec{
+				HostNetwork: helpers.GetPointer(true),
+				DNSPolicy:   helpers.GetPointer(corev1.DNSDefault),
+			},
+		},
+	}
+
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ds, ok := objects[5].(*appsv1.DaemonSet)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(ds.Spec.Template.Spec.HostNetwork).To(BeTrue())
+	g.Expect(ds.Spec.Template.Spec.DNSPolicy).To(Equal(corev1.DNSDefault))
+	g.Expect(ds.Spec.Template.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+		FSGroup:      helpers.GetPointer[int64](1001),
+		RunAsNonRoot: helpers.GetPointer(true),
+	}))
+}
+
 func TestBuildNginxResourceObjects_Plus(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -422,7 +586,10 @@ func TestBuildNginxResourceObjects_Plus(t *testing.T) {
 	}
 
 	resourceName := "gw-nginx"
-	objects, err := provisioner.buildNginxResourceObjects(resourceName, gateway, &graph.EffectiveNginxProxy{})
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: &graph.EffectiveNginxProxy{}},
+	)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	g.Expect(objects).To(HaveLen(9))
@@ -563,7 +730,10 @@ func TestBuildNginxResourceObjects_DockerSecrets(t *testing.T) {
 	}
 
 	resourceName := "gw-nginx"
-	objects, err := provisioner.buildNginxResourceObjects(resourceName, gateway, &graph.EffectiveNginxProxy{})
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: &graph.EffectiveNginxProxy{}},
+	)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	g.Expect(objects).To(HaveLen(9))
@@ -676,7 +846,10 @@ func TestBuildNginxResourceObjects_DaemonSet(t *testing.T) {
 	}
 
 	resourceName := "gw-nginx"
-	objects, err := provisioner.buildNginxResourceObjects(resourceName, gateway, nProxyCfg)
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	g.Expect(objects).To(HaveLen(6))
@@ -703,7 +876,7 @@ func TestBuildNginxResourceObjects_DaemonSet(t *testing.T) {
 	g.Expect(container.Resources.Limits[corev1.ResourceCPU].Format).To(Equal(resource.Format("100m")))
 }
 
-func TestBuildNginxResourceObjects_OpenShift(t *testing.T) {
+func TestBuildNginxResourceObjects_Autoscaling(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
@@ -717,7 +890,6 @@ func TestBuildNginxResourceObjects_OpenShift(t *testing.T) {
 	fakeClient := fake.NewFakeClient(agentTLSSecret)
 
 	provisioner := &NginxProvisioner{
-		isOpenshift: true,
 		cfg: Config{
 			GatewayPodConfig: &config.GatewayPodConfig{
 				Namespace: ngfNamespace,
@@ -739,170 +911,1284 @@ func TestBuildNginxResourceObjects_OpenShift(t *testing.T) {
 		},
 	}
 
+	customMetric := autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: "nginx_http_requests_per_second"},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: helpers.GetPointer(resource.MustParse("100")),
+			},
+		},
+	}
+
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+				Replicas: helpers.GetPointer[int32](3),
+				Autoscaling: &ngfAPIv1alpha2.AutoscalingSpec{
+					Enable:                            true,
+					MinReplicas:                       helpers.GetPointer[int32](2),
+					MaxReplicas:                       10,
+					TargetCPUUtilizationPercentage:    helpers.GetPointer[int32](70),
+					TargetMemoryUtilizationPercentage: helpers.GetPointer[int32](80),
+					Metrics:                           []autoscalingv2.MetricSpec{customMetric},
+				},
+			},
+		},
+	}
+
 	resourceName := "gw-nginx"
-	objects, err := provisioner.buildNginxResourceObjects(resourceName, gateway, &graph.EffectiveNginxProxy{})
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
 	g.Expect(err).ToNot(HaveOccurred())
 
-	g.Expect(objects).To(HaveLen(8))
+	g.Expect(objects).To(HaveLen(7))
 
-	expLabels := map[string]string{
-		"app":                                    "nginx",
-		"gateway.networking.k8s.io/gateway-name": "gw",
-		"app.kubernetes.io/name":                 "gw-nginx",
-	}
+	depObj := objects[5]
+	dep, ok := depObj.(*appsv1.Deployment)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(dep.Spec.Replicas).To(BeNil())
 
-	roleObj := objects[4]
-	role, ok := roleObj.(*rbacv1.Role)
+	hpaObj := objects[6]
+	hpa, ok := hpaObj.(*autoscalingv2.HorizontalPodAutoscaler)
 	g.Expect(ok).To(BeTrue())
-	g.Expect(role.GetLabels()).To(Equal(expLabels))
+	g.Expect(hpa.GetName()).To(Equal(resourceName))
+	g.Expect(hpa.GetLabels()).To(Equal(dep.GetLabels()))
+	g.Expect(hpa.Spec).To(Equal(autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       resourceName,
+		},
+		MinReplicas: helpers.GetPointer[int32](2),
+		MaxReplicas: 10,
+		Metrics: []autoscalingv2.MetricSpec{
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: helpers.GetPointer[int32](70),
+					},
+				},
+			},
+			{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceMemory,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: helpers.GetPointer[int32](80),
+					},
+				},
+			},
+			customMetric,
+		},
+	}))
 
-	roleBindingObj := objects[5]
-	roleBinding, ok := roleBindingObj.(*rbacv1.RoleBinding)
+	// disabling autoscaling returns the replicas to the Deployment and removes the HorizontalPodAutoscaler
+	nProxyCfg.Kubernetes.Deployment.Autoscaling.Enable = false
+
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(6))
+
+	dep, ok = objects[5].(*appsv1.Deployment)
 	g.Expect(ok).To(BeTrue())
-	g.Expect(roleBinding.GetLabels()).To(Equal(expLabels))
+	g.Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](3)))
 }
 
-func TestGetAndUpdateSecret_NotFound(t *testing.T) {
+func TestBuildNginxResourceObjects_PodDisruptionBudget(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	fakeClient := fake.NewFakeClient()
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
 
 	provisioner := &NginxProvisioner{
 		cfg: Config{
 			GatewayPodConfig: &config.GatewayPodConfig{
-				Namespace: "default",
+				Namespace: ngfNamespace,
 			},
+			AgentTLSSecretName: agentTLSTestSecretName,
 		},
 		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
 	}
 
-	_, err := provisioner.getAndUpdateSecret(
-		"non-existent-secret",
-		metav1.ObjectMeta{
-			Name:      "new-secret",
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
 			Namespace: "default",
 		},
-		corev1.SecretTypeOpaque,
-	)
-
-	g.Expect(err).To(HaveOccurred())
-	g.Expect(err.Error()).To(ContainSubstring("error getting secret"))
-}
-
-func TestBuildNginxResourceObjectsForDeletion(t *testing.T) {
-	t.Parallel()
-	g := NewWithT(t)
-
-	provisioner := &NginxProvisioner{}
+	}
 
-	deploymentNSName := types.NamespacedName{
-		Name:      "gw-nginx",
-		Namespace: "default",
+	maxUnavailable := intstr.FromString("25%")
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			DaemonSet: &ngfAPIv1alpha2.DaemonSetSpec{},
+			PodDisruptionBudget: &ngfAPIv1alpha2.PodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+			},
+		},
 	}
 
-	objects := provisioner.buildNginxResourceObjectsForDeletion(deploymentNSName)
+	resourceName := "gw-nginx"
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
 
 	g.Expect(objects).To(HaveLen(7))
 
-	validateMeta := func(obj client.Object, name string) {
-		g.Expect(obj.GetName()).To(Equal(name))
-		g.Expect(obj.GetNamespace()).To(Equal(deploymentNSName.Namespace))
-	}
-
-	depObj := objects[0]
-	dep, ok := depObj.(*appsv1.Deployment)
-	g.Expect(ok).To(BeTrue())
-	validateMeta(dep, deploymentNSName.Name)
-
-	dsObj := objects[1]
+	dsObj := objects[5]
 	ds, ok := dsObj.(*appsv1.DaemonSet)
 	g.Expect(ok).To(BeTrue())
-	validateMeta(ds, deploymentNSName.Name)
 
-	svcObj := objects[2]
-	svc, ok := svcObj.(*corev1.Service)
+	pdbObj := objects[6]
+	pdb, ok := pdbObj.(*policyv1.PodDisruptionBudget)
 	g.Expect(ok).To(BeTrue())
-	validateMeta(svc, deploymentNSName.Name)
+	g.Expect(pdb.GetName()).To(Equal(resourceName))
+	g.Expect(pdb.GetLabels()).To(Equal(ds.GetLabels()))
+	g.Expect(pdb.Spec).To(Equal(policyv1.PodDisruptionBudgetSpec{
+		Selector:       ds.Spec.Selector,
+		MaxUnavailable: &maxUnavailable,
+	}))
 
-	svcAcctObj := objects[3]
-	svcAcct, ok := svcAcctObj.(*corev1.ServiceAccount)
-	g.Expect(ok).To(BeTrue())
-	validateMeta(svcAcct, deploymentNSName.Name)
+	// removing the PodDisruptionBudget configuration does not build the PodDisruptionBudget
+	nProxyCfg.Kubernetes.PodDisruptionBudget = nil
 
-	cmObj := objects[4]
-	cm, ok := cmObj.(*corev1.ConfigMap)
-	g.Expect(ok).To(BeTrue())
-	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxIncludesConfigMapNameSuffix))
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
 
-	cmObj = objects[5]
-	cm, ok = cmObj.(*corev1.ConfigMap)
-	g.Expect(ok).To(BeTrue())
-	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxAgentConfigMapNameSuffix))
+	g.Expect(objects).To(HaveLen(6))
 }
 
-func TestBuildNginxResourceObjectsForDeletion_Plus(t *testing.T) {
+func TestBuildNginxResourceObjects_CostAllocation(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
 	provisioner := &NginxProvisioner{
 		cfg: Config{
-			Plus: true,
-			PlusUsageConfig: &config.UsageReportConfig{
-				SecretName:          jwtTestSecretName,
-				CASecretName:        caTestSecretName,
-				ClientSSLSecretName: clientTestSecretName,
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+		},
+		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
 			},
-			NginxDockerSecretNames: []string{dockerTestSecretName},
-			AgentTLSSecretName:     agentTLSTestSecretName,
 		},
 	}
 
-	deploymentNSName := types.NamespacedName{
-		Name:      "gw-nginx",
-		Namespace: "default",
-	}
-
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Infrastructure: &gatewayv1.GatewayInfrastructure{
+				Labels: map[gatewayv1.LabelKey]gatewayv1.LabelValue{
+					controller.TeamLabel: "gateway-team",
+					"custom":             "label",
+				},
+			},
+		},
+	}
+
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			CostAllocation: &ngfAPIv1alpha2.CostAllocationSpec{
+				Team:       helpers.GetPointer("platform"),
+				CostCenter: helpers.GetPointer("cc-1234"),
+			},
+		},
+	}
+
+	objects, err := provisioner.buildNginxResourceObjects(
+		"gw-nginx",
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).ToNot(BeEmpty())
+
+	// the cost allocation labels take precedence over the labels of the Gateway
+	for _, obj := range objects {
+		g.Expect(obj.GetLabels()).To(HaveKeyWithValue(controller.TeamLabel, "platform"))
+		g.Expect(obj.GetLabels()).To(HaveKeyWithValue(controller.CostCenterLabel, "cc-1234"))
+		g.Expect(obj.GetLabels()).To(HaveKeyWithValue("custom", "label"))
+	}
+
+	var deployment *appsv1.Deployment
+	for _, obj := range objects {
+		if d, ok := obj.(*appsv1.Deployment); ok {
+			deployment = d
+		}
+	}
+	g.Expect(deployment).ToNot(BeNil())
+	g.Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue(controller.TeamLabel, "platform"))
+	g.Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue(controller.CostCenterLabel, "cc-1234"))
+	g.Expect(deployment.Spec.Selector.MatchLabels).ToNot(HaveKey(controller.TeamLabel))
+}
+
+func TestBuildNginxResourceObjects_BlueGreenUpgrade(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+		},
+		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
+	}
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+	}
+
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+				ImageUpgradeStrategy: helpers.GetPointer(ngfAPIv1alpha2.ImageUpgradeStrategyBlueGreen),
+			},
+			Service: &ngfAPIv1alpha2.ServiceSpec{
+				InternalService: &ngfAPIv1alpha2.InternalServiceSpec{Enable: true},
+			},
+		},
+	}
+
+	objects, err := provisioner.buildNginxResourceObjects(
+		"gw-nginx",
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var deployment *appsv1.Deployment
+	var services []*corev1.Service
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			deployment = o
+		case *corev1.Service:
+			services = append(services, o)
+		}
+	}
+
+	// the Pods are labeled with their slot, but not the immutable selector of the Deployment
+	g.Expect(deployment).ToNot(BeNil())
+	g.Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue(controller.DataPlaneSlotLabel, "primary"))
+	g.Expect(deployment.Spec.Selector.MatchLabels).ToNot(HaveKey(controller.DataPlaneSlotLabel))
+	g.Expect(deployment.Labels).ToNot(HaveKey(controller.DataPlaneSlotLabel))
+
+	g.Expect(services).To(HaveLen(2))
+	for _, svc := range services {
+		g.Expect(svc.Spec.Selector).To(HaveKeyWithValue(controller.DataPlaneSlotLabel, "primary"))
+		g.Expect(svc.Spec.Selector).To(HaveKeyWithValue(controller.AppNameLabel, "gw-nginx"))
+	}
+
+	// the NetworkPolicy selects both the primary and the staged Pods
+	nProxyCfg.Kubernetes.NetworkPolicy = &ngfAPIv1alpha2.NetworkPolicySpec{Enable: helpers.GetPointer(true)}
+
+	objects, err = provisioner.buildNginxResourceObjects(
+		"gw-nginx",
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var networkPolicy *networkingv1.NetworkPolicy
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			deployment = o
+		case *networkingv1.NetworkPolicy:
+			networkPolicy = o
+		}
+	}
+
+	g.Expect(networkPolicy).ToNot(BeNil())
+	podSelector, err := metav1.LabelSelectorAsSelector(&networkPolicy.Spec.PodSelector)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	staged := buildStagedDeployment(deployment, 1)
+	g.Expect(podSelector.Matches(labels.Set(deployment.Spec.Template.Labels))).To(BeTrue())
+	g.Expect(podSelector.Matches(labels.Set(staged.Spec.Template.Labels))).To(BeTrue())
+
+	// without blue/green upgrades, the Pods are not labeled with their slot
+	nProxyCfg.Kubernetes.NetworkPolicy = nil
+	nProxyCfg.Kubernetes.Deployment.ImageUpgradeStrategy = helpers.GetPointer(ngfAPIv1alpha2.ImageUpgradeStrategyRolling)
+
+	objects, err = provisioner.buildNginxResourceObjects(
+		"gw-nginx",
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			g.Expect(o.Spec.Template.Labels).ToNot(HaveKey(controller.DataPlaneSlotLabel))
+		case *corev1.Service:
+			g.Expect(o.Spec.Selector).ToNot(HaveKey(controller.DataPlaneSlotLabel))
+		}
+	}
+}
+
+func TestBuildNginxResourceObjects_NetworkPolicy(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+			GRPCServerPort:     8443,
+		},
+		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
+	}
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+				{Port: 443, Protocol: gatewayv1.HTTPSProtocolType},
+			},
+		},
+	}
+
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			NetworkPolicy: &ngfAPIv1alpha2.NetworkPolicySpec{
+				Enable: helpers.GetPointer(true),
+			},
+			Service: &ngfAPIv1alpha2.ServiceSpec{
+				LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+			},
+		},
+	}
+
+	backendServices := []graph.BackendService{
+		{
+			NsName:   types.NamespacedName{Namespace: "apps", Name: "coffee"},
+			Selector: map[string]string{"app": "coffee"},
+			Ports: []corev1.ServicePort{
+				{Port: 80, TargetPort: intstr.FromString("http")},
+				{Port: 8080},
+			},
+		},
+	}
+
+	resourceName := "gw-nginx"
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg, BackendServices: backendServices},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(7))
+
+	depObj := objects[5]
+	dep, ok := depObj.(*appsv1.Deployment)
+	g.Expect(ok).To(BeTrue())
+
+	networkPolicyObj := objects[6]
+	networkPolicy, ok := networkPolicyObj.(*networkingv1.NetworkPolicy)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(networkPolicy.GetName()).To(Equal(resourceName))
+	g.Expect(networkPolicy.GetLabels()).To(Equal(dep.GetLabels()))
+
+	tcp := corev1.ProtocolTCP
+	udp := corev1.ProtocolUDP
+	port := func(p intstr.IntOrString) *intstr.IntOrString { return &p }
+
+	podSelector := maps.Clone(dep.Spec.Selector.MatchLabels)
+	delete(podSelector, controller.GatewayLabel)
+
+	g.Expect(networkPolicy.Spec).To(Equal(networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{MatchLabels: podSelector},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &tcp, Port: port(intstr.FromInt32(80))},
+					{Protocol: &tcp, Port: port(intstr.FromInt32(443))},
+				},
+				From: []networkingv1.NetworkPolicyPeer{
+					{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}},
+				},
+			},
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &tcp, Port: port(intstr.FromInt32(config.DefaultNginxMetricsPort))},
+				},
+			},
+		},
+		Egress: []networkingv1.NetworkPolicyEgressRule{
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &tcp, Port: port(intstr.FromString("http"))},
+					{Protocol: &tcp, Port: port(intstr.FromInt32(8080))},
+				},
+				To: []networkingv1.NetworkPolicyPeer{
+					{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{corev1.LabelMetadataName: "apps"},
+						},
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "coffee"},
+						},
+					},
+				},
+			},
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &tcp, Port: port(intstr.FromInt32(8443))},
+				},
+				To: []networkingv1.NetworkPolicyPeer{
+					{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{corev1.LabelMetadataName: ngfNamespace},
+						},
+					},
+				},
+			},
+			{
+				Ports: []networkingv1.NetworkPolicyPort{
+					{Protocol: &udp, Port: port(intstr.FromInt32(53))},
+					{Protocol: &tcp, Port: port(intstr.FromInt32(53))},
+				},
+			},
+		},
+	}))
+
+	// the metrics port is allowed from any source, and not allowed if metrics are disabled
+	buildNetworkPolicy := func() *networkingv1.NetworkPolicy {
+		objects, err := provisioner.buildNginxResourceObjects(
+			resourceName,
+			&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg, BackendServices: backendServices},
+		)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		networkPolicy, ok := objects[len(objects)-1].(*networkingv1.NetworkPolicy)
+		g.Expect(ok).To(BeTrue())
+
+		return networkPolicy
+	}
+
+	nProxyCfg.Metrics = &ngfAPIv1alpha2.Metrics{Port: helpers.GetPointer[int32](9200)}
+	networkPolicy = buildNetworkPolicy()
+	g.Expect(networkPolicy.Spec.Ingress).To(HaveLen(2))
+	g.Expect(networkPolicy.Spec.Ingress[1]).To(Equal(networkingv1.NetworkPolicyIngressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &tcp, Port: port(intstr.FromInt32(9200))},
+		},
+	}))
+
+	nProxyCfg.Metrics = &ngfAPIv1alpha2.Metrics{Disable: helpers.GetPointer(true)}
+	networkPolicy = buildNetworkPolicy()
+	g.Expect(networkPolicy.Spec.Ingress).To(HaveLen(1))
+	g.Expect(networkPolicy.Spec.Ingress[0].Ports).To(HaveLen(2))
+
+	// egress traffic to the telemetry exporter endpoint is allowed on its port
+	nProxyCfg.Telemetry = &ngfAPIv1alpha2.Telemetry{
+		Exporter: &ngfAPIv1alpha2.TelemetryExporter{Endpoint: helpers.GetPointer("http://collector.tracing:9000")},
+	}
+	networkPolicy = buildNetworkPolicy()
+	g.Expect(networkPolicy.Spec.Egress).To(HaveLen(4))
+	g.Expect(networkPolicy.Spec.Egress[3]).To(Equal(networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &tcp, Port: port(intstr.FromInt32(9000))},
+		},
+	}))
+
+	nProxyCfg.Telemetry.Exporter.Endpoint = helpers.GetPointer("collector.tracing")
+	networkPolicy = buildNetworkPolicy()
+	g.Expect(networkPolicy.Spec.Egress).To(HaveLen(4))
+	g.Expect(networkPolicy.Spec.Egress[3].Ports[0].Port).To(Equal(port(intstr.FromInt32(4317))))
+	nProxyCfg.Telemetry = nil
+
+	// egress traffic to the OCSP responders, and to the resolvers that do not listen on port 53, is allowed
+	nProxyCfg.TLS = &ngfAPIv1alpha2.NginxTLS{
+		OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+			Enable: helpers.GetPointer(true),
+			Resolver: &ngfAPIv1alpha2.DNSResolver{
+				Addresses: []string{"10.0.0.10", "10.0.0.11:53", "10.0.0.12:5353", "[fd00::10]:5353"},
+			},
+		},
+	}
+	networkPolicy = buildNetworkPolicy()
+	g.Expect(networkPolicy.Spec.Egress).To(HaveLen(6))
+	g.Expect(networkPolicy.Spec.Egress[3:]).To(Equal([]networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &tcp, Port: port(intstr.FromInt32(80))},
+			},
+		},
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: port(intstr.FromInt32(5353))},
+				{Protocol: &tcp, Port: port(intstr.FromInt32(5353))},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.12/32"}},
+			},
+		},
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: port(intstr.FromInt32(5353))},
+				{Protocol: &tcp, Port: port(intstr.FromInt32(5353))},
+			},
+			To: []networkingv1.NetworkPolicyPeer{
+				{IPBlock: &networkingv1.IPBlock{CIDR: "fd00::10/128"}},
+			},
+		},
+	}))
+
+	nProxyCfg.TLS.OCSPStapling.Enable = helpers.GetPointer(false)
+	networkPolicy = buildNetworkPolicy()
+	g.Expect(networkPolicy.Spec.Egress).To(HaveLen(3))
+	nProxyCfg.TLS = nil
+
+	// disabling the NetworkPolicy does not build the NetworkPolicy
+	nProxyCfg.Kubernetes.NetworkPolicy.Enable = helpers.GetPointer(false)
+
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg, BackendServices: backendServices},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(HaveLen(6))
+}
+
+func TestBuildNginxResourceObjects_PodMonitor(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+		},
+		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
+	}
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+	}
+
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Metrics: &ngfAPIv1alpha2.Metrics{
+			PodMonitor: &ngfAPIv1alpha2.PodMonitor{
+				Enable:   helpers.GetPointer(true),
+				Labels:   map[string]string{"release": "prometheus"},
+				Interval: helpers.GetPointer[ngfAPIv1alpha1.Duration]("30"),
+				Relabelings: []ngfAPIv1alpha2.RelabelConfig{
+					{
+						SourceLabels: []string{"__meta_kubernetes_pod_node_name"},
+						TargetLabel:  helpers.GetPointer("node"),
+						Action:       helpers.GetPointer(ngfAPIv1alpha2.RelabelActionReplace),
+					},
+				},
+				TLS: &ngfAPIv1alpha2.PodMonitorTLS{
+					CASecretName: helpers.GetPointer("metrics-ca"),
+					ServerName:   helpers.GetPointer("nginx.example.com"),
+				},
+			},
+		},
+	}
+
+	resourceName := "gw-nginx"
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg, PodMonitorCRDInstalled: true},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(7))
+
+	podMonitorObj := objects[6]
+	podMonitor, ok := podMonitorObj.(*unstructured.Unstructured)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(podMonitor.GroupVersionKind()).To(Equal(podMonitorGVK))
+	g.Expect(podMonitor.GetName()).To(Equal(resourceName))
+	g.Expect(podMonitor.GetNamespace()).To(Equal("default"))
+	g.Expect(podMonitor.GetLabels()).To(HaveKeyWithValue("release", "prometheus"))
+	g.Expect(podMonitor.GetLabels()).To(HaveKeyWithValue("app", "nginx"))
+
+	g.Expect(podMonitor.Object["spec"]).To(Equal(map[string]any{
+		"selector": map[string]any{
+			"matchLabels": map[string]any{
+				"app":                                    "nginx",
+				"app.kubernetes.io/name":                 resourceName,
+				"gateway.networking.k8s.io/gateway-name": "gw",
+			},
+		},
+		"podMetricsEndpoints": []any{
+			map[string]any{
+				"port":     "metrics",
+				"interval": "30s",
+				"scheme":   "https",
+				"tlsConfig": map[string]any{
+					"ca": map[string]any{
+						"secret": map[string]any{
+							"name": "metrics-ca",
+							"key":  "ca.crt",
+						},
+					},
+					"serverName": "nginx.example.com",
+				},
+				"relabelings": []any{
+					map[string]any{
+						"sourceLabels": []any{"__meta_kubernetes_pod_node_name"},
+						"targetLabel":  "node",
+						"action":       "replace",
+					},
+				},
+			},
+		},
+	}))
+
+	// the PodMonitor is not built if the CRD is not installed
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(HaveLen(6))
+
+	// the PodMonitor is not built if metrics are disabled
+	nProxyCfg.Metrics.Disable = helpers.GetPointer(true)
+
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg, PodMonitorCRDInstalled: true},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(HaveLen(6))
+}
+
+func TestBuildNginxResourceObjects_Service(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+		},
+		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
+	}
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Infrastructure: &gatewayv1.GatewayInfrastructure{
+				Labels: map[gatewayv1.LabelKey]gatewayv1.LabelValue{
+					"team": "gateway",
+				},
+				Annotations: map[gatewayv1.AnnotationKey]gatewayv1.AnnotationValue{
+					"lb-type": "gateway",
+				},
+			},
+			Listeners: []gatewayv1.Listener{
+				{
+					Port: 80,
+				},
+			},
+		},
+	}
+
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			Service: &ngfAPIv1alpha2.ServiceSpec{
+				ServiceType: helpers.GetPointer(ngfAPIv1alpha2.ServiceTypeNodePort),
+				NodePorts: []ngfAPIv1alpha2.NodePort{
+					{
+						Port:         30080,
+						ListenerPort: 80,
+					},
+				},
+				Annotations: map[string]string{
+					"lb-type":  "nginxproxy",
+					"lb-scope": "external",
+				},
+				Labels: map[string]string{
+					"team": "nginxproxy",
+					"tier": "edge",
+					"app":  "overridden",
+				},
+				ExternalIPs:     []string{"10.0.0.1"},
+				SessionAffinity: helpers.GetPointer(ngfAPIv1alpha2.SessionAffinityClientIP),
+				IPFamilyPolicy:  helpers.GetPointer(ngfAPIv1alpha2.IPFamilyPolicyRequireDualStack),
+				InternalService: &ngfAPIv1alpha2.InternalServiceSpec{
+					Enable: true,
+					Annotations: map[string]string{
+						"lb-scope": "internal",
+					},
+					Labels: map[string]string{
+						"scope": "internal",
+					},
+				},
+			},
+		},
+	}
+
+	resourceName := "gw-nginx"
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(7))
+
+	expLabels := map[string]string{
+		"app":                                    "nginx",
+		"gateway.networking.k8s.io/gateway-name": "gw",
+		"app.kubernetes.io/name":                 resourceName,
+		"team":                                   "gateway",
+	}
+
+	svc, ok := objects[4].(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(svc.GetName()).To(Equal(resourceName))
+
+	expSvcLabels := maps.Clone(expLabels)
+	expSvcLabels["tier"] = "edge"
+	g.Expect(svc.GetLabels()).To(Equal(expSvcLabels))
+	g.Expect(svc.GetAnnotations()).To(Equal(map[string]string{
+		"lb-type":  "gateway",
+		"lb-scope": "external",
+	}))
+	g.Expect(svc.Spec.ExternalIPs).To(Equal([]string{"10.0.0.1"}))
+	g.Expect(svc.Spec.SessionAffinity).To(Equal(corev1.ServiceAffinityClientIP))
+	g.Expect(svc.Spec.IPFamilyPolicy).To(Equal(helpers.GetPointer(corev1.IPFamilyPolicyRequireDualStack)))
+	g.Expect(svc.Spec.Ports).To(Equal([]corev1.ServicePort{
+		{
+			Name:       "port-80",
+			Port:       80,
+			TargetPort: intstr.FromInt32(80),
+			NodePort:   30080,
+		},
+	}))
+
+	internalSvc, ok := objects[5].(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(internalSvc.GetName()).To(Equal("gw-nginx-internal"))
+
+	expInternalSvcLabels := maps.Clone(expLabels)
+	expInternalSvcLabels["scope"] = "internal"
+	g.Expect(internalSvc.GetLabels()).To(Equal(expInternalSvcLabels))
+	g.Expect(internalSvc.GetAnnotations()).To(Equal(map[string]string{
+		"lb-type":  "gateway",
+		"lb-scope": "internal",
+	}))
+	g.Expect(internalSvc.Spec).To(Equal(corev1.ServiceSpec{
+		Type: corev1.ServiceTypeClusterIP,
+		Ports: []corev1.ServicePort{
+			{
+				Name:       "port-80",
+				Port:       80,
+				TargetPort: intstr.FromInt32(80),
+			},
+		},
+		Selector:        svc.Spec.Selector,
+		SessionAffinity: corev1.ServiceAffinityClientIP,
+		IPFamilyPolicy:  helpers.GetPointer(corev1.IPFamilyPolicyRequireDualStack),
+	}))
+
+	// the NGINX data plane Service keeps the labels and annotations of the other resources
+	dep, ok := objects[6].(*appsv1.Deployment)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(dep.GetLabels()).To(Equal(expLabels))
+	g.Expect(dep.GetAnnotations()).To(Equal(map[string]string{"lb-type": "gateway"}))
+
+	// disabling the internal Service does not build it
+	nProxyCfg.Kubernetes.Service.InternalService.Enable = false
+
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(6))
+}
+
+func TestBuildNginxResourceObjects_HTTP3(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+		},
+		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
+	}
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{
+					Port:     80,
+					Protocol: gatewayv1.HTTPProtocolType,
+				},
+				{
+					Port:     443,
+					Protocol: gatewayv1.HTTPSProtocolType,
+				},
+				{
+					Port:     8443,
+					Protocol: gatewayv1.TLSProtocolType,
+				},
+			},
+		},
+	}
+
+	nProxyCfg := &graph.EffectiveNginxProxy{
+		HTTP3: &ngfAPIv1alpha2.HTTP3{
+			Enable: helpers.GetPointer(true),
+		},
+		Metrics: &ngfAPIv1alpha2.Metrics{
+			Disable: helpers.GetPointer(true),
+		},
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			Service: &ngfAPIv1alpha2.ServiceSpec{
+				ServiceType: helpers.GetPointer(ngfAPIv1alpha2.ServiceTypeNodePort),
+				NodePorts: []ngfAPIv1alpha2.NodePort{
+					{
+						Port:         30443,
+						ListenerPort: 443,
+					},
+				},
+			},
+		},
+	}
+
+	resourceName := "gw-nginx"
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: nProxyCfg},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(6))
+
+	svcObj := objects[4]
+	svc, ok := svcObj.(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(svc.Spec.Ports).To(Equal([]corev1.ServicePort{
+		{
+			Port:       80,
+			Name:       "port-80",
+			TargetPort: intstr.FromInt(80),
+		},
+		{
+			Port:       443,
+			Name:       "port-443",
+			TargetPort: intstr.FromInt(443),
+			NodePort:   30443,
+		},
+		{
+			Port:       443,
+			Name:       "port-443-quic",
+			Protocol:   corev1.ProtocolUDP,
+			TargetPort: intstr.FromInt(443),
+			NodePort:   30443,
+		},
+		{
+			Port:       8443,
+			Name:       "port-8443",
+			TargetPort: intstr.FromInt(8443),
+		},
+	}))
+
+	depObj := objects[5]
+	dep, ok := depObj.(*appsv1.Deployment)
+	g.Expect(ok).To(BeTrue())
+
+	container := dep.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Ports).To(Equal([]corev1.ContainerPort{
+		{
+			ContainerPort: 80,
+			Name:          "port-80",
+		},
+		{
+			ContainerPort: 443,
+			Name:          "port-443",
+		},
+		{
+			ContainerPort: 443,
+			Name:          "port-443-quic",
+			Protocol:      corev1.ProtocolUDP,
+		},
+		{
+			ContainerPort: 8443,
+			Name:          "port-8443",
+		},
+	}))
+}
+
+func TestBuildNginxResourceObjects_OpenShift(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("tls")},
+	}
+	fakeClient := fake.NewFakeClient(agentTLSSecret)
+
+	provisioner := &NginxProvisioner{
+		isOpenshift: true,
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: ngfNamespace,
+			},
+			AgentTLSSecretName: agentTLSTestSecretName,
+		},
+		k8sClient: fakeClient,
+		baseLabelSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"app": "nginx",
+			},
+		},
+	}
+
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+	}
+
+	resourceName := "gw-nginx"
+	objects, err := provisioner.buildNginxResourceObjects(
+		resourceName,
+		&graph.Gateway{Source: gateway, EffectiveNginxProxy: &graph.EffectiveNginxProxy{}},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(8))
+
+	expLabels := map[string]string{
+		"app":                                    "nginx",
+		"gateway.networking.k8s.io/gateway-name": "gw",
+		"app.kubernetes.io/name":                 "gw-nginx",
+	}
+
+	roleObj := objects[4]
+	role, ok := roleObj.(*rbacv1.Role)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(role.GetLabels()).To(Equal(expLabels))
+
+	roleBindingObj := objects[5]
+	roleBinding, ok := roleBindingObj.(*rbacv1.RoleBinding)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(roleBinding.GetLabels()).To(Equal(expLabels))
+}
+
+func TestGetAndUpdateSecret_NotFound(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fakeClient := fake.NewFakeClient()
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			GatewayPodConfig: &config.GatewayPodConfig{
+				Namespace: "default",
+			},
+		},
+		k8sClient: fakeClient,
+	}
+
+	_, err := provisioner.getAndUpdateSecret(
+		"non-existent-secret",
+		metav1.ObjectMeta{
+			Name:      "new-secret",
+			Namespace: "default",
+		},
+		corev1.SecretTypeOpaque,
+	)
+
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("error getting secret"))
+}
+
+func TestBuildNginxResourceObjectsForDeletion(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	provisioner := &NginxProvisioner{}
+
+	deploymentNSName := types.NamespacedName{
+		Name:      "gw-nginx",
+		Namespace: "default",
+	}
+
+	objects := provisioner.buildNginxResourceObjectsForDeletion(deploymentNSName)
+
+	g.Expect(objects).To(HaveLen(12))
+
+	validateMeta := func(obj client.Object, name string) {
+		g.Expect(obj.GetName()).To(Equal(name))
+		g.Expect(obj.GetNamespace()).To(Equal(deploymentNSName.Namespace))
+	}
+
+	podMonitorObj := objects[0]
+	podMonitor, ok := podMonitorObj.(*unstructured.Unstructured)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(podMonitor.GroupVersionKind()).To(Equal(podMonitorGVK))
+	validateMeta(podMonitor, deploymentNSName.Name)
+
+	networkPolicyObj := objects[1]
+	networkPolicy, ok := networkPolicyObj.(*networkingv1.NetworkPolicy)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(networkPolicy, deploymentNSName.Name)
+
+	pdbObj := objects[2]
+	pdb, ok := pdbObj.(*policyv1.PodDisruptionBudget)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(pdb, deploymentNSName.Name)
+
+	hpaObj := objects[3]
+	hpa, ok := hpaObj.(*autoscalingv2.HorizontalPodAutoscaler)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(hpa, deploymentNSName.Name)
+
+	depObj := objects[4]
+	dep, ok := depObj.(*appsv1.Deployment)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(dep, deploymentNSName.Name)
+
+	dsObj := objects[5]
+	ds, ok := dsObj.(*appsv1.DaemonSet)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(ds, deploymentNSName.Name)
+
+	svcObj := objects[6]
+	svc, ok := svcObj.(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(svc, deploymentNSName.Name)
+
+	internalSvcObj := objects[7]
+	internalSvc, ok := internalSvcObj.(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(internalSvc, controller.CreateNginxResourceName(deploymentNSName.Name, nginxInternalServiceNameSuffix))
+
+	svcAcctObj := objects[8]
+	svcAcct, ok := svcAcctObj.(*corev1.ServiceAccount)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(svcAcct, deploymentNSName.Name)
+
+	cmObj := objects[9]
+	cm, ok := cmObj.(*corev1.ConfigMap)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxIncludesConfigMapNameSuffix))
+
+	cmObj = objects[10]
+	cm, ok = cmObj.(*corev1.ConfigMap)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxAgentConfigMapNameSuffix))
+}
+
+func TestBuildNginxResourceObjectsForDeletion_Plus(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	provisioner := &NginxProvisioner{
+		cfg: Config{
+			Plus: true,
+			PlusUsageConfig: &config.UsageReportConfig{
+				SecretName:          jwtTestSecretName,
+				CASecretName:        caTestSecretName,
+				ClientSSLSecretName: clientTestSecretName,
+			},
+			NginxDockerSecretNames: []string{dockerTestSecretName},
+			AgentTLSSecretName:     agentTLSTestSecretName,
+		},
+	}
+
+	deploymentNSName := types.NamespacedName{
+		Name:      "gw-nginx",
+		Namespace: "default",
+	}
+
 	objects := provisioner.buildNginxResourceObjectsForDeletion(deploymentNSName)
 
-	g.Expect(objects).To(HaveLen(11))
+	g.Expect(objects).To(HaveLen(16))
 
 	validateMeta := func(obj client.Object, name string) {
 		g.Expect(obj.GetName()).To(Equal(name))
 		g.Expect(obj.GetNamespace()).To(Equal(deploymentNSName.Namespace))
 	}
 
-	depObj := objects[0]
+	depObj := objects[4]
 	dep, ok := depObj.(*appsv1.Deployment)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(dep, deploymentNSName.Name)
 
-	dsObj := objects[1]
+	dsObj := objects[5]
 	ds, ok := dsObj.(*appsv1.DaemonSet)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(ds, deploymentNSName.Name)
 
-	svcObj := objects[2]
+	svcObj := objects[6]
 	svc, ok := svcObj.(*corev1.Service)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(svc, deploymentNSName.Name)
 
-	svcAcctObj := objects[3]
+	svcAcctObj := objects[8]
 	svcAcct, ok := svcAcctObj.(*corev1.ServiceAccount)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(svcAcct, deploymentNSName.Name)
 
-	cmObj := objects[4]
+	cmObj := objects[9]
 	cm, ok := cmObj.(*corev1.ConfigMap)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxIncludesConfigMapNameSuffix))
 
-	cmObj = objects[5]
+	cmObj = objects[10]
 	cm, ok = cmObj.(*corev1.ConfigMap)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxAgentConfigMapNameSuffix))
 
-	secretObj := objects[6]
+	secretObj := objects[11]
 	secret, ok := secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -910,7 +2196,7 @@ func TestBuildNginxResourceObjectsForDeletion_Plus(t *testing.T) {
 		provisioner.cfg.AgentTLSSecretName,
 	))
 
-	secretObj = objects[7]
+	secretObj = objects[12]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -918,7 +2204,7 @@ func TestBuildNginxResourceObjectsForDeletion_Plus(t *testing.T) {
 		provisioner.cfg.NginxDockerSecretNames[0],
 	))
 
-	secretObj = objects[8]
+	secretObj = objects[13]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -926,7 +2212,7 @@ func TestBuildNginxResourceObjectsForDeletion_Plus(t *testing.T) {
 		provisioner.cfg.PlusUsageConfig.CASecretName,
 	))
 
-	secretObj = objects[9]
+	secretObj = objects[14]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -948,19 +2234,19 @@ func TestBuildNginxResourceObjectsForDeletion_OpenShift(t *testing.T) {
 
 	objects := provisioner.buildNginxResourceObjectsForDeletion(deploymentNSName)
 
-	g.Expect(objects).To(HaveLen(9))
+	g.Expect(objects).To(HaveLen(14))
 
 	validateMeta := func(obj client.Object, name string) {
 		g.Expect(obj.GetName()).To(Equal(name))
 		g.Expect(obj.GetNamespace()).To(Equal(deploymentNSName.Namespace))
 	}
 
-	roleObj := objects[3]
+	roleObj := objects[8]
 	role, ok := roleObj.(*rbacv1.Role)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(role, deploymentNSName.Name)
 
-	roleBindingObj := objects[4]
+	roleBindingObj := objects[9]
 	roleBinding, ok := roleBindingObj.(*rbacv1.RoleBinding)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(roleBinding, deploymentNSName.Name)
@@ -12,9 +12,14 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
@@ -26,6 +31,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/provisioner/openshift"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/status"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
@@ -39,6 +45,7 @@ import (
 // Provisioner is an interface for triggering NGINX resources to be created/updated/deleted.
 type Provisioner interface {
 	RegisterGateway(ctx context.Context, gateway *graph.Gateway, resourceName string) error
+	LatestDataPlaneUpgrade(gatewayNSName types.NamespacedName) *conditions.Condition
 }
 
 // Config is the configuration for the Provisioner.
@@ -55,6 +62,8 @@ type Config struct {
 	Logger                 logr.Logger
 	NginxDockerSecretNames []string
 
+	GRPCServerPort int32
+
 	Plus bool
 }
 
@@ -62,6 +71,8 @@ type Config struct {
 type NginxProvisioner struct {
 	store     *store
 	k8sClient client.Client
+	// upgrades tracks the blue/green image upgrades of the nginx Deployments.
+	upgrades dataPlaneUpgrades
 	// resourcesToDeleteOnStartup contains a list of Gateway names that no longer exist
 	// but have nginx resources tied to them that need to be deleted.
 	resourcesToDeleteOnStartup []types.NamespacedName
@@ -89,6 +100,7 @@ func NewNginxProvisioner(
 	}
 
 	store := newStore(
+		cfg.GCName,
 		cfg.NginxDockerSecretNames,
 		cfg.AgentTLSSecretName,
 		jwtSecretName,
@@ -191,6 +203,36 @@ func (p *NginxProvisioner) provisionNginx(
 		return nil
 	}
 
+	// The data plane would exceed the quota of its namespace, so none of its objects are applied.
+	// The Gateway reports the exceeded quota in its Programmed condition.
+	gatewayNSName := client.ObjectKeyFromObject(gateway)
+
+	var graphGateway *graph.Gateway
+	if resources := p.store.getNginxResourcesForGateway(gatewayNSName); resources != nil {
+		graphGateway = resources.Gateway
+	}
+
+	if graphGateway != nil && graphGateway.DataPlaneQuotaExceeded {
+		p.cfg.Logger.Info(
+			"Not creating/updating nginx resources, the data plane exceeds the quota of its namespace",
+			"namespace", gateway.GetNamespace(),
+			"name", resourceName,
+		)
+		p.cfg.EventRecorder.Eventf(
+			gateway,
+			corev1.EventTypeWarning,
+			"DataPlaneQuotaExceeded",
+			"Refused to create or update nginx resources %s: the data plane exceeds the quota of the namespace",
+			resourceName,
+		)
+
+		return nil
+	}
+
+	if graphGateway != nil {
+		objects = p.stageImageUpgrade(ctx, gateway, graphGateway.EffectiveNginxProxy, objects)
+	}
+
 	p.cfg.Logger.Info(
 		"Creating/Updating nginx resources",
 		"namespace", gateway.GetNamespace(),
@@ -245,6 +287,9 @@ func (p *NginxProvisioner) provisionNginx(
 			if res == controllerutil.OperationResultCreated {
 				deploymentCreated = true
 			}
+		case *unstructured.Unstructured:
+			// PodMonitors are not watched, so register them even if they are unchanged.
+			p.store.registerResourceInGatewayConfig(gatewayNSName, o)
 		case *corev1.ConfigMap:
 			if res == controllerutil.OperationResultUpdated &&
 				strings.Contains(obj.GetName(), nginxAgentConfigMapNameSuffix) {
@@ -262,11 +307,12 @@ func (p *NginxProvisioner) provisionNginx(
 			"namespace", gateway.GetNamespace(),
 			"name", resourceName,
 		)
-		p.store.registerResourceInGatewayConfig(client.ObjectKeyFromObject(gateway), obj)
+		p.store.registerResourceInGatewayConfig(gatewayNSName, obj)
 	}
 
-	// if agent configmap was updated, then we'll need to restart the deployment/daemonset
-	if agentConfigMapUpdated && !deploymentCreated {
+	// if agent configmap was updated, then we'll need to restart the deployment/daemonset.
+	// A Deployment that is being upgraded is not restarted, since its Pods are replaced by the upgrade anyway.
+	if agentConfigMapUpdated && !deploymentCreated && (deploymentObj != nil || daemonSetObj != nil) {
 		updateCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 		defer cancel()
 
@@ -309,14 +355,14 @@ func (p *NginxProvisioner) provisionNginx(
 func (p *NginxProvisioner) reprovisionNginx(
 	ctx context.Context,
 	resourceName string,
-	gateway *gatewayv1.Gateway,
-	nProxyCfg *graph.EffectiveNginxProxy,
+	graphGateway *graph.Gateway,
 ) error {
 	if !p.isLeader() {
 		return nil
 	}
 
-	objects, err := p.buildNginxResourceObjects(resourceName, gateway, nProxyCfg)
+	gateway := graphGateway.Source
+	objects, err := p.buildNginxResourceObjects(resourceName, graphGateway)
 	if err != nil {
 		p.cfg.Logger.Error(err, "error provisioning some nginx resources")
 	}
@@ -352,6 +398,9 @@ func (p *NginxProvisioner) deprovisionNginx(ctx context.Context, gatewayNSName t
 		Namespace: gatewayNSName.Namespace,
 	}
 
+	// a running upgrade is cancelled, which removes its staged Deployment
+	p.upgrades.stop(gatewayNSName)
+
 	if p.isLeader() {
 		p.cfg.Logger.Info(
 			"Removing nginx resources for Gateway",
@@ -365,7 +414,7 @@ func (p *NginxProvisioner) deprovisionNginx(ctx context.Context, gatewayNSName t
 		defer cancel()
 
 		for _, obj := range objects {
-			if err := p.k8sClient.Delete(deleteCtx, obj); err != nil && !apierrors.IsNotFound(err) {
+			if err := p.k8sClient.Delete(deleteCtx, obj); err != nil && !isNotFoundError(err) {
 				p.cfg.EventRecorder.Eventf(
 					obj,
 					corev1.EventTypeWarning,
@@ -384,6 +433,34 @@ func (p *NginxProvisioner) deprovisionNginx(ctx context.Context, gatewayNSName t
 	return nil
 }
 
+// deprovisionUnmanagedNginx removes the nginx resources that were provisioned for a Gateway whose data plane
+// is no longer provisioned. Only the resources that were actually provisioned are deleted. The agents of a static
+// data plane are registered under the Deployment of the Gateway, so it is kept in the DeploymentStore.
+func (p *NginxProvisioner) deprovisionUnmanagedNginx(ctx context.Context, gateway *graph.Gateway) error {
+	gatewayNSName := client.ObjectKeyFromObject(gateway.Source)
+
+	p.upgrades.stop(gatewayNSName)
+
+	if resources := p.store.getNginxResourcesForGateway(gatewayNSName); resources != nil {
+		for _, obj := range buildProvisionedNginxResourceObjects(resources) {
+			if err := p.deleteObject(ctx, obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.store.deleteResourcesForGateway(gatewayNSName)
+
+	if !graph.StaticDataPlaneEnabledForNginxProxy(gateway.EffectiveNginxProxy) {
+		p.cfg.DeploymentStore.Remove(types.NamespacedName{
+			Name:      controller.CreateNginxResourceName(gatewayNSName.Name, p.cfg.GCName),
+			Namespace: gatewayNSName.Namespace,
+		})
+	}
+
+	return nil
+}
+
 func (p *NginxProvisioner) deleteObject(ctx context.Context, obj client.Object) error {
 	if !p.isLeader() {
 		return nil
@@ -392,7 +469,7 @@ func (p *NginxProvisioner) deleteObject(ctx context.Context, obj client.Object)
 	deleteCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	if err := p.k8sClient.Delete(deleteCtx, obj); err != nil && !apierrors.IsNotFound(err) {
+	if err := p.k8sClient.Delete(deleteCtx, obj); err != nil && !isNotFoundError(err) {
 		p.cfg.EventRecorder.Eventf(
 			obj,
 			corev1.EventTypeWarning,
@@ -406,6 +483,12 @@ func (p *NginxProvisioner) deleteObject(ctx context.Context, obj client.Object)
 	return nil
 }
 
+// isNotFoundError returns whether the error indicates that the object does not exist. This includes the case
+// where the kind of the object does not exist, for example, because the PodMonitor CRD is not installed.
+func isNotFoundError(err error) bool {
+	return apierrors.IsNotFound(err) || meta.IsNoMatchError(err)
+}
+
 // isUserSecret determines if the provided secret name is a special user secret,
 // for example an NGINX docker registry secret or NGINX Plus secret.
 func (p *NginxProvisioner) isUserSecret(name string) bool {
@@ -426,6 +509,13 @@ func (p *NginxProvisioner) isUserSecret(name string) bool {
 	return false
 }
 
+// dataPlaneProvisioned returns whether the nginx resources of the Gateway are provisioned. Merged Gateways
+// are served by the data plane of the Gateway they are merged into, and static data planes are not managed
+// by the provisioner.
+func dataPlaneProvisioned(gateway *graph.Gateway) bool {
+	return gateway.MergedInto == nil && !graph.StaticDataPlaneEnabledForNginxProxy(gateway.EffectiveNginxProxy)
+}
+
 // RegisterGateway is called by the main event handler when a Gateway API resource event occurs
 // and the graph is built. The provisioner updates the Gateway config in the store and then:
 // - If it's a valid Gateway, create or update nginx resources associated with the Gateway, if necessary.
@@ -444,15 +534,27 @@ func (p *NginxProvisioner) RegisterGateway(
 		return nil
 	}
 
+	// A merged Gateway is served by the data plane of the Gateway it is merged into, and a static data plane
+	// is managed outside of the cluster, so the nginx resources of such Gateways are removed.
+	if gateway.Valid && !dataPlaneProvisioned(gateway) {
+		if err := p.deprovisionUnmanagedNginx(ctx, gateway); err != nil {
+			return fmt.Errorf("error deprovisioning nginx resources of unprovisioned Gateway: %w", err)
+		}
+		p.store.registerResourceInGatewayConfig(gatewayNSName, gateway)
+
+		return nil
+	}
+
 	if gateway.Valid {
-		objects, err := p.buildNginxResourceObjects(resourceName, gateway.Source, gateway.EffectiveNginxProxy)
+		objects, err := p.buildNginxResourceObjects(resourceName, gateway)
 		if err != nil {
 			p.cfg.Logger.Error(err, "error building some nginx resources")
 		}
 
 		// If NGINX deployment type switched between Deployment and DaemonSet, clean up the old one.
+		// A data plane that exceeds its quota keeps its current resources, since the new ones are not applied.
 		nginxResources := p.store.getNginxResourcesForGateway(gatewayNSName)
-		if nginxResources != nil {
+		if nginxResources != nil && !gateway.DataPlaneQuotaExceeded {
 			if needToDeleteDaemonSet(nginxResources) {
 				if err := p.deleteObject(ctx, &appsv1.DaemonSet{ObjectMeta: nginxResources.DaemonSet}); err != nil {
 					p.cfg.Logger.Error(err, "error deleting nginx resource")
@@ -462,6 +564,48 @@ func (p *NginxProvisioner) RegisterGateway(
 					p.cfg.Logger.Error(err, "error deleting nginx resource")
 				}
 			}
+
+			// If autoscaling was disabled, clean up the HorizontalPodAutoscaler.
+			if needToDeleteHorizontalPodAutoscaler(nginxResources) {
+				hpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: nginxResources.HorizontalPodAutoscaler}
+				if err := p.deleteObject(ctx, hpa); err != nil {
+					p.cfg.Logger.Error(err, "error deleting nginx resource")
+				}
+			}
+
+			// If the internal Service was disabled, clean it up.
+			if needToDeleteInternalService(nginxResources) {
+				svc := &corev1.Service{ObjectMeta: nginxResources.InternalService}
+				if err := p.deleteObject(ctx, svc); err != nil {
+					p.cfg.Logger.Error(err, "error deleting nginx resource")
+				}
+			}
+
+			// If the PodDisruptionBudget was removed from the configuration, clean it up.
+			if needToDeletePodDisruptionBudget(nginxResources) {
+				pdb := &policyv1.PodDisruptionBudget{ObjectMeta: nginxResources.PodDisruptionBudget}
+				if err := p.deleteObject(ctx, pdb); err != nil {
+					p.cfg.Logger.Error(err, "error deleting nginx resource")
+				}
+			}
+
+			// If the NetworkPolicy was disabled, clean it up.
+			if needToDeleteNetworkPolicy(nginxResources) {
+				networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: nginxResources.NetworkPolicy}
+				if err := p.deleteObject(ctx, networkPolicy); err != nil {
+					p.cfg.Logger.Error(err, "error deleting nginx resource")
+				}
+			}
+
+			// If the PodMonitor was disabled or its CRD was removed, clean it up. PodMonitors are not watched,
+			// so the store is updated here.
+			if needToDeletePodMonitor(nginxResources) {
+				if err := p.deleteObject(ctx, newPodMonitor(nginxResources.PodMonitor)); err != nil {
+					p.cfg.Logger.Error(err, "error deleting nginx resource")
+				} else {
+					p.store.unregisterPodMonitor(gatewayNSName)
+				}
+			}
 		}
 
 		if err := p.provisionNginx(ctx, resourceName, gateway.Source, objects); err != nil {
@@ -488,6 +632,46 @@ func needToDeleteDeployment(cfg *NginxResources) bool {
 	return false
 }
 
+func needToDeleteHorizontalPodAutoscaler(cfg *NginxResources) bool {
+	if cfg.HorizontalPodAutoscaler.Name == "" || cfg.Gateway == nil {
+		return false
+	}
+
+	return !autoscalingEnabled(cfg.Gateway.EffectiveNginxProxy)
+}
+
+func needToDeleteInternalService(cfg *NginxResources) bool {
+	if cfg.InternalService.Name == "" || cfg.Gateway == nil {
+		return false
+	}
+
+	return !internalServiceEnabled(cfg.Gateway.EffectiveNginxProxy)
+}
+
+func needToDeletePodDisruptionBudget(cfg *NginxResources) bool {
+	if cfg.PodDisruptionBudget.Name == "" || cfg.Gateway == nil {
+		return false
+	}
+
+	return !podDisruptionBudgetEnabled(cfg.Gateway.EffectiveNginxProxy)
+}
+
+func needToDeleteNetworkPolicy(cfg *NginxResources) bool {
+	if cfg.NetworkPolicy.Name == "" || cfg.Gateway == nil {
+		return false
+	}
+
+	return !networkPolicyEnabled(cfg.Gateway.EffectiveNginxProxy)
+}
+
+func needToDeletePodMonitor(cfg *NginxResources) bool {
+	if cfg.PodMonitor.Name == "" || cfg.Gateway == nil {
+		return false
+	}
+
+	return !cfg.Gateway.PodMonitorCRDInstalled || !podMonitorEnabled(cfg.Gateway.EffectiveNginxProxy)
+}
+
 func needToDeleteDaemonSet(cfg *NginxResources) bool {
 	if cfg.DaemonSet.Name != "" && cfg.Gateway != nil {
 		if cfg.Gateway.EffectiveNginxProxy != nil &&
@@ -7,10 +7,14 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
@@ -21,7 +25,9 @@ import (
 
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/agentfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent/broadcast"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/provisioner/openshift/openshiftfakes"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
@@ -43,6 +49,9 @@ func createScheme() *runtime.Scheme {
 	utilruntime.Must(gatewayv1.Install(scheme))
 	utilruntime.Must(corev1.AddToScheme(scheme))
 	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(autoscalingv2.AddToScheme(scheme))
+	utilruntime.Must(policyv1.AddToScheme(scheme))
+	utilruntime.Must(networkingv1.AddToScheme(scheme))
 
 	return scheme
 }
@@ -159,6 +168,7 @@ func defaultNginxProvisioner(
 
 	return &NginxProvisioner{
 		store: newStore(
+			"nginx",
 			[]string{dockerTestSecretName},
 			agentTLSTestSecretName,
 			jwtTestSecretName,
@@ -388,6 +398,362 @@ func TestRegisterGateway_CleansUpOldDeploymentOrDaemonSet(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 }
 
+func TestRegisterGateway_Autoscaling(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gateway := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gw",
+				Namespace: "default",
+			},
+		},
+		Valid: true,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+				Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+					Autoscaling: &ngfAPIv1alpha2.AutoscalingSpec{
+						Enable:      true,
+						MaxReplicas: 5,
+					},
+				},
+			},
+		},
+	}
+
+	// the HorizontalPodAutoscaler has scaled the Deployment
+	scaledDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw-nginx",
+			Namespace: "default",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: helpers.GetPointer[int32](4),
+		},
+	}
+
+	provisioner, fakeClient, _ := defaultNginxProvisioner(gateway.Source, scaledDeployment)
+	nsName := types.NamespacedName{Name: "gw-nginx", Namespace: "default"}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, "gw-nginx")).To(Succeed())
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	g.Expect(fakeClient.Get(t.Context(), nsName, hpa)).To(Succeed())
+	g.Expect(hpa.Spec.MaxReplicas).To(Equal(int32(5)))
+
+	// the replicas are not overwritten
+	deployment := &appsv1.Deployment{}
+	g.Expect(fakeClient.Get(t.Context(), nsName, deployment)).To(Succeed())
+	g.Expect(deployment.Spec.Replicas).To(Equal(helpers.GetPointer[int32](4)))
+
+	// disabling autoscaling deletes the HorizontalPodAutoscaler
+	gateway = &graph.Gateway{
+		Source: gateway.Source,
+		Valid:  true,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+				Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+					Replicas: helpers.GetPointer[int32](2),
+				},
+			},
+		},
+	}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, "gw-nginx")).To(Succeed())
+
+	g.Expect(fakeClient.Get(t.Context(), nsName, &autoscalingv2.HorizontalPodAutoscaler{})).ToNot(Succeed())
+
+	g.Expect(fakeClient.Get(t.Context(), nsName, deployment)).To(Succeed())
+	g.Expect(deployment.Spec.Replicas).To(Equal(helpers.GetPointer[int32](2)))
+}
+
+func TestRegisterGateway_PodDisruptionBudget(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	minAvailable := intstr.FromInt32(1)
+	gateway := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gw",
+				Namespace: "default",
+			},
+		},
+		Valid: true,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+				PodDisruptionBudget: &ngfAPIv1alpha2.PodDisruptionBudgetSpec{
+					MinAvailable: &minAvailable,
+				},
+			},
+		},
+	}
+
+	provisioner, fakeClient, _ := defaultNginxProvisioner(gateway.Source)
+	nsName := types.NamespacedName{Name: "gw-nginx", Namespace: "default"}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, "gw-nginx")).To(Succeed())
+
+	pdb := &policyv1.PodDisruptionBudget{}
+	g.Expect(fakeClient.Get(t.Context(), nsName, pdb)).To(Succeed())
+	g.Expect(pdb.Spec.MinAvailable).To(Equal(&minAvailable))
+
+	// removing the configuration deletes the PodDisruptionBudget
+	gateway = &graph.Gateway{
+		Source:              gateway.Source,
+		Valid:               true,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{},
+	}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, "gw-nginx")).To(Succeed())
+
+	g.Expect(fakeClient.Get(t.Context(), nsName, &policyv1.PodDisruptionBudget{})).ToNot(Succeed())
+}
+
+func TestRegisterGateway_MergedGateway(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	owner := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "owner",
+				Namespace: "default",
+			},
+			Spec: gatewayv1.GatewaySpec{
+				Listeners: []gatewayv1.Listener{{Port: 80}},
+			},
+		},
+		Valid: true,
+	}
+
+	member := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "member",
+				Namespace: "default",
+			},
+			Spec: gatewayv1.GatewaySpec{
+				Listeners: []gatewayv1.Listener{{Port: 8080}},
+			},
+		},
+		Valid: true,
+	}
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+	}
+
+	provisioner, fakeClient, _ := defaultNginxProvisioner(owner.Source, member.Source, agentTLSSecret)
+	provisioner.cfg.Plus = false
+	provisioner.cfg.NginxDockerSecretNames = nil
+
+	ownerNsName := types.NamespacedName{Name: "owner-nginx", Namespace: "default"}
+	memberNsName := types.NamespacedName{Name: "member-nginx", Namespace: "default"}
+
+	// both Gateways start with their own data plane
+	g.Expect(provisioner.RegisterGateway(t.Context(), owner, ownerNsName.Name)).To(Succeed())
+	g.Expect(provisioner.RegisterGateway(t.Context(), member, memberNsName.Name)).To(Succeed())
+	expectResourcesToExist(g, fakeClient, ownerNsName, false)
+	expectResourcesToExist(g, fakeClient, memberNsName, false)
+
+	// once merged, the member's data plane is removed and the owner's data plane serves both Gateways
+	owner = &graph.Gateway{
+		Source:         owner.Source,
+		Valid:          true,
+		MergedGateways: []*gatewayv1.Gateway{member.Source},
+	}
+	member = &graph.Gateway{
+		Source:         member.Source,
+		Valid:          true,
+		MergedInto:     &types.NamespacedName{Name: "owner", Namespace: "default"},
+		DeploymentName: ownerNsName,
+	}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), owner, ownerNsName.Name)).To(Succeed())
+	g.Expect(provisioner.RegisterGateway(t.Context(), member, ownerNsName.Name)).To(Succeed())
+
+	expectResourcesToExist(g, fakeClient, ownerNsName, false)
+	expectResourcesToNotExist(g, fakeClient, memberNsName)
+
+	svc := &corev1.Service{}
+	g.Expect(fakeClient.Get(t.Context(), ownerNsName, svc)).To(Succeed())
+	g.Expect(svc.Spec.Ports).To(HaveLen(2))
+	g.Expect(svc.Spec.Ports[0].Port).To(Equal(int32(80)))
+	g.Expect(svc.Spec.Ports[1].Port).To(Equal(int32(8080)))
+
+	resources := provisioner.store.getNginxResourcesForGateway(types.NamespacedName{Name: "member", Namespace: "default"})
+	g.Expect(resources).ToNot(BeNil())
+	g.Expect(resources.Gateway).To(Equal(member))
+	g.Expect(resources.Deployment.Name).To(BeEmpty())
+}
+
+func TestRegisterGateway_StaticDataPlane(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gateway := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gw",
+				Namespace: "default",
+			},
+			Spec: gatewayv1.GatewaySpec{
+				Listeners: []gatewayv1.Listener{{Port: 80}},
+			},
+		},
+		Valid: true,
+	}
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+	}
+
+	provisioner, fakeClient, _ := defaultNginxProvisioner(gateway.Source, agentTLSSecret)
+	provisioner.cfg.Plus = false
+	provisioner.cfg.NginxDockerSecretNames = nil
+
+	nsName := types.NamespacedName{Name: "gw-nginx", Namespace: "default"}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, nsName.Name)).To(Succeed())
+	expectResourcesToExist(g, fakeClient, nsName, false)
+
+	// switching to a static data plane removes the provisioned nginx resources
+	gateway = &graph.Gateway{
+		Source: gateway.Source,
+		Valid:  true,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			StaticDataPlane: &ngfAPIv1alpha2.StaticDataPlane{TokenSecretName: "agent-token"},
+		},
+		AgentTokenSecret: &types.NamespacedName{Name: "agent-token", Namespace: "default"},
+	}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, nsName.Name)).To(Succeed())
+	expectResourcesToNotExist(g, fakeClient, nsName)
+
+	resources := provisioner.store.getNginxResourcesForGateway(types.NamespacedName{Name: "gw", Namespace: "default"})
+	g.Expect(resources).ToNot(BeNil())
+	g.Expect(resources.Gateway).To(Equal(gateway))
+	g.Expect(resources.Deployment.Name).To(BeEmpty())
+}
+
+func TestRegisterGateway_StaticDataPlaneKeepsAgents(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gateway := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gw",
+				Namespace: "default",
+			},
+		},
+		Valid: true,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			StaticDataPlane: &ngfAPIv1alpha2.StaticDataPlane{TokenSecretName: "agent-token"},
+		},
+		AgentTokenSecret: &types.NamespacedName{Name: "agent-token", Namespace: "default"},
+		DeploymentName:   types.NamespacedName{Name: "gw-nginx", Namespace: "default"},
+	}
+
+	provisioner, _, _ := defaultNginxProvisioner(gateway.Source)
+	deploymentStore := agent.NewDeploymentStore(nil)
+	provisioner.cfg.DeploymentStore = deploymentStore
+
+	// a static agent is connected before the Gateway is registered
+	deployment := deploymentStore.GetOrStore(t.Context(), gateway.DeploymentName, make(chan struct{}))
+	subscriber := deployment.GetBroadcaster().Subscribe()
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, gateway.DeploymentName.Name)).To(Succeed())
+
+	g.Expect(deploymentStore.Get(gateway.DeploymentName)).To(BeIdenticalTo(deployment))
+
+	message := broadcast.NginxAgentMessage{
+		ConfigVersion: "v1",
+		Type:          broadcast.ConfigApplyRequest,
+	}
+
+	sent := make(chan bool)
+	go func() {
+		sent <- deploymentStore.Get(gateway.DeploymentName).GetBroadcaster().Send(message)
+	}()
+
+	g.Eventually(subscriber.ListenCh).Should(Receive(Equal(message)))
+	subscriber.ResponseCh <- struct{}{}
+	g.Eventually(sent).Should(Receive(BeTrue()))
+}
+
+func TestRegisterGateway_DataPlaneQuotaExceeded(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gateway := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gw",
+				Namespace: "default",
+			},
+			Spec: gatewayv1.GatewaySpec{
+				Listeners: []gatewayv1.Listener{{Port: 80}},
+			},
+		},
+		Valid:                  true,
+		DataPlaneQuotaExceeded: true,
+	}
+
+	agentTLSSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      agentTLSTestSecretName,
+			Namespace: ngfNamespace,
+		},
+	}
+
+	provisioner, fakeClient, _ := defaultNginxProvisioner(gateway.Source, agentTLSSecret)
+	provisioner.cfg.Plus = false
+	provisioner.cfg.NginxDockerSecretNames = nil
+
+	nsName := types.NamespacedName{Name: "gw-nginx", Namespace: "default"}
+
+	// the data plane is not created while it exceeds the quota
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, nsName.Name)).To(Succeed())
+	expectResourcesToNotExist(g, fakeClient, nsName)
+
+	// the data plane is created once it fits into the quota
+	gateway = &graph.Gateway{
+		Source: gateway.Source,
+		Valid:  true,
+	}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, nsName.Name)).To(Succeed())
+	expectResourcesToExist(g, fakeClient, nsName, false)
+
+	// an update that exceeds the quota is not applied, and the existing data plane is kept
+	gateway = &graph.Gateway{
+		Source: gateway.Source,
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+				Deployment: &ngfAPIv1alpha2.DeploymentSpec{Replicas: helpers.GetPointer[int32](5)},
+			},
+		},
+		Valid:                  true,
+		DataPlaneQuotaExceeded: true,
+	}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, nsName.Name)).To(Succeed())
+	expectResourcesToExist(g, fakeClient, nsName, false)
+
+	deployment := &appsv1.Deployment{}
+	g.Expect(fakeClient.Get(t.Context(), nsName, deployment)).To(Succeed())
+	g.Expect(deployment.Spec.Replicas).ToNot(Equal(helpers.GetPointer[int32](5)))
+}
+
 func TestNonLeaderProvisioner(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -402,7 +768,7 @@ func TestNonLeaderProvisioner(t *testing.T) {
 	g.Expect(provisioner.provisionNginx(context.TODO(), "gw-nginx", nil, nil)).To(Succeed())
 	expectResourcesToNotExist(g, fakeClient, nsName)
 
-	g.Expect(provisioner.reprovisionNginx(context.TODO(), "gw-nginx", nil, nil)).To(Succeed())
+	g.Expect(provisioner.reprovisionNginx(context.TODO(), "gw-nginx", nil)).To(Succeed())
 	expectResourcesToNotExist(g, fakeClient, nsName)
 
 	g.Expect(provisioner.deprovisionNginx(context.TODO(), nsName)).To(Succeed())
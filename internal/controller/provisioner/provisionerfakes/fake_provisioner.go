@@ -6,10 +6,23 @@ import (
 	"sync"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/provisioner"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 type FakeProvisioner struct {
+	LatestDataPlaneUpgradeStub        func(types.NamespacedName) *conditions.Condition
+	latestDataPlaneUpgradeMutex       sync.RWMutex
+	latestDataPlaneUpgradeArgsForCall []struct {
+		arg1 types.NamespacedName
+	}
+	latestDataPlaneUpgradeReturns struct {
+		result1 *conditions.Condition
+	}
+	latestDataPlaneUpgradeReturnsOnCall map[int]struct {
+		result1 *conditions.Condition
+	}
 	RegisterGatewayStub        func(context.Context, *graph.Gateway, string) error
 	registerGatewayMutex       sync.RWMutex
 	registerGatewayArgsForCall []struct {
@@ -27,6 +40,67 @@ type FakeProvisioner struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeProvisioner) LatestDataPlaneUpgrade(arg1 types.NamespacedName) *conditions.Condition {
+	fake.latestDataPlaneUpgradeMutex.Lock()
+	ret, specificReturn := fake.latestDataPlaneUpgradeReturnsOnCall[len(fake.latestDataPlaneUpgradeArgsForCall)]
+	fake.latestDataPlaneUpgradeArgsForCall = append(fake.latestDataPlaneUpgradeArgsForCall, struct {
+		arg1 types.NamespacedName
+	}{arg1})
+	stub := fake.LatestDataPlaneUpgradeStub
+	fakeReturns := fake.latestDataPlaneUpgradeReturns
+	fake.recordInvocation("LatestDataPlaneUpgrade", []interface{}{arg1})
+	fake.latestDataPlaneUpgradeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProvisioner) LatestDataPlaneUpgradeCallCount() int {
+	fake.latestDataPlaneUpgradeMutex.RLock()
+	defer fake.latestDataPlaneUpgradeMutex.RUnlock()
+	return len(fake.latestDataPlaneUpgradeArgsForCall)
+}
+
+func (fake *FakeProvisioner) LatestDataPlaneUpgradeCalls(stub func(types.NamespacedName) *conditions.Condition) {
+	fake.latestDataPlaneUpgradeMutex.Lock()
+	defer fake.latestDataPlaneUpgradeMutex.Unlock()
+	fake.LatestDataPlaneUpgradeStub = stub
+}
+
+func (fake *FakeProvisioner) LatestDataPlaneUpgradeArgsForCall(i int) types.NamespacedName {
+	fake.latestDataPlaneUpgradeMutex.RLock()
+	defer fake.latestDataPlaneUpgradeMutex.RUnlock()
+	argsForCall := fake.latestDataPlaneUpgradeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProvisioner) LatestDataPlaneUpgradeReturns(result1 *conditions.Condition) {
+	fake.latestDataPlaneUpgradeMutex.Lock()
+	defer fake.latestDataPlaneUpgradeMutex.Unlock()
+	fake.LatestDataPlaneUpgradeStub = nil
+	fake.latestDataPlaneUpgradeReturns = struct {
+		result1 *conditions.Condition
+	}{result1}
+}
+
+func (fake *FakeProvisioner) LatestDataPlaneUpgradeReturnsOnCall(i int, result1 *conditions.Condition) {
+	fake.latestDataPlaneUpgradeMutex.Lock()
+	defer fake.latestDataPlaneUpgradeMutex.Unlock()
+	fake.LatestDataPlaneUpgradeStub = nil
+	if fake.latestDataPlaneUpgradeReturnsOnCall == nil {
+		fake.latestDataPlaneUpgradeReturnsOnCall = make(map[int]struct {
+			result1 *conditions.Condition
+		})
+	}
+	fake.latestDataPlaneUpgradeReturnsOnCall[i] = struct {
+		result1 *conditions.Condition
+	}{result1}
+}
+
 func (fake *FakeProvisioner) RegisterGateway(arg1 context.Context, arg2 *graph.Gateway, arg3 string) error {
 	fake.registerGatewayMutex.Lock()
 	ret, specificReturn := fake.registerGatewayReturnsOnCall[len(fake.registerGatewayArgsForCall)]
@@ -93,6 +167,8 @@ func (fake *FakeProvisioner) RegisterGatewayReturnsOnCall(i int, result1 error)
 func (fake *FakeProvisioner) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.latestDataPlaneUpgradeMutex.RLock()
+	defer fake.latestDataPlaneUpgradeMutex.RUnlock()
 	fake.registerGatewayMutex.RLock()
 	defer fake.registerGatewayMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -4,9 +4,13 @@ import (
 	"maps"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -18,6 +22,12 @@ func objectSpecSetter(object client.Object) controllerutil.MutateFn {
 		return deploymentSpecSetter(obj, obj.Spec, obj.ObjectMeta)
 	case *appsv1.DaemonSet:
 		return daemonSetSpecSetter(obj, obj.Spec, obj.ObjectMeta)
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		return horizontalPodAutoscalerSpecSetter(obj, obj.Spec, obj.ObjectMeta)
+	case *policyv1.PodDisruptionBudget:
+		return podDisruptionBudgetSpecSetter(obj, obj.Spec, obj.ObjectMeta)
+	case *networkingv1.NetworkPolicy:
+		return networkPolicySpecSetter(obj, obj.Spec, obj.ObjectMeta)
 	case *corev1.Service:
 		return serviceSpecSetter(obj, obj.Spec, obj.ObjectMeta)
 	case *corev1.ServiceAccount:
@@ -30,6 +40,10 @@ func objectSpecSetter(object client.Object) controllerutil.MutateFn {
 		return roleSpecSetter(obj, obj.Rules, obj.ObjectMeta)
 	case *rbacv1.RoleBinding:
 		return roleBindingSpecSetter(obj, obj.RoleRef, obj.Subjects, obj.ObjectMeta)
+	case *unstructured.Unstructured:
+		if isPodMonitor(obj) {
+			return podMonitorSpecSetter(obj, obj.Object["spec"], obj.GetLabels(), obj.GetAnnotations())
+		}
 	}
 
 	return nil
@@ -41,6 +55,12 @@ func deploymentSpecSetter(
 	objectMeta metav1.ObjectMeta,
 ) controllerutil.MutateFn {
 	return func() error {
+		// If the replicas are not set, for example because they are managed by a HorizontalPodAutoscaler,
+		// keep the current replicas instead of resetting them to the default.
+		if spec.Replicas == nil {
+			spec.Replicas = deployment.Spec.Replicas
+		}
+
 		deployment.Labels = objectMeta.Labels
 		deployment.Annotations = objectMeta.Annotations
 		deployment.Spec = spec
@@ -48,6 +68,59 @@ func deploymentSpecSetter(
 	}
 }
 
+func horizontalPodAutoscalerSpecSetter(
+	hpa *autoscalingv2.HorizontalPodAutoscaler,
+	spec autoscalingv2.HorizontalPodAutoscalerSpec,
+	objectMeta metav1.ObjectMeta,
+) controllerutil.MutateFn {
+	return func() error {
+		hpa.Labels = objectMeta.Labels
+		hpa.Annotations = objectMeta.Annotations
+		hpa.Spec = spec
+		return nil
+	}
+}
+
+func podDisruptionBudgetSpecSetter(
+	pdb *policyv1.PodDisruptionBudget,
+	spec policyv1.PodDisruptionBudgetSpec,
+	objectMeta metav1.ObjectMeta,
+) controllerutil.MutateFn {
+	return func() error {
+		pdb.Labels = objectMeta.Labels
+		pdb.Annotations = objectMeta.Annotations
+		pdb.Spec = spec
+		return nil
+	}
+}
+
+func networkPolicySpecSetter(
+	networkPolicy *networkingv1.NetworkPolicy,
+	spec networkingv1.NetworkPolicySpec,
+	objectMeta metav1.ObjectMeta,
+) controllerutil.MutateFn {
+	return func() error {
+		networkPolicy.Labels = objectMeta.Labels
+		networkPolicy.Annotations = objectMeta.Annotations
+		networkPolicy.Spec = spec
+		return nil
+	}
+}
+
+func podMonitorSpecSetter(
+	podMonitor *unstructured.Unstructured,
+	spec any,
+	labels map[string]string,
+	annotations map[string]string,
+) controllerutil.MutateFn {
+	return func() error {
+		podMonitor.SetLabels(labels)
+		podMonitor.SetAnnotations(annotations)
+		podMonitor.Object["spec"] = spec
+		return nil
+	}
+}
+
 func daemonSetSpecSetter(
 	daemonSet *appsv1.DaemonSet,
 	spec appsv1.DaemonSetSpec,
@@ -6,32 +6,42 @@ import (
 	"sync"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
 )
 
 // NginxResources are all of the NGINX resources deployed in relation to a Gateway.
 type NginxResources struct {
-	Gateway             *graph.Gateway
-	Deployment          metav1.ObjectMeta
-	DaemonSet           metav1.ObjectMeta
-	Service             metav1.ObjectMeta
-	ServiceAccount      metav1.ObjectMeta
-	Role                metav1.ObjectMeta
-	RoleBinding         metav1.ObjectMeta
-	BootstrapConfigMap  metav1.ObjectMeta
-	AgentConfigMap      metav1.ObjectMeta
-	AgentTLSSecret      metav1.ObjectMeta
-	PlusJWTSecret       metav1.ObjectMeta
-	PlusClientSSLSecret metav1.ObjectMeta
-	PlusCASecret        metav1.ObjectMeta
-	DockerSecrets       []metav1.ObjectMeta
+	Gateway                 *graph.Gateway
+	Deployment              metav1.ObjectMeta
+	DaemonSet               metav1.ObjectMeta
+	HorizontalPodAutoscaler metav1.ObjectMeta
+	PodDisruptionBudget     metav1.ObjectMeta
+	PodMonitor              metav1.ObjectMeta
+	NetworkPolicy           metav1.ObjectMeta
+	Service                 metav1.ObjectMeta
+	InternalService         metav1.ObjectMeta
+	ServiceAccount          metav1.ObjectMeta
+	Role                    metav1.ObjectMeta
+	RoleBinding             metav1.ObjectMeta
+	BootstrapConfigMap      metav1.ObjectMeta
+	AgentConfigMap          metav1.ObjectMeta
+	AgentTLSSecret          metav1.ObjectMeta
+	PlusJWTSecret           metav1.ObjectMeta
+	PlusClientSSLSecret     metav1.ObjectMeta
+	PlusCASecret            metav1.ObjectMeta
+	DockerSecrets           []metav1.ObjectMeta
 }
 
 // store stores the cluster state needed by the provisioner and allows to update it from the events.
@@ -42,6 +52,9 @@ type store struct {
 	// nginxResources is a map of Gateway NamespacedNames and their associated nginx resources.
 	nginxResources map[types.NamespacedName]*NginxResources
 
+	// gcName is the name of the GatewayClass, which is part of the names of the nginx resources.
+	gcName string
+
 	dockerSecretNames  map[string]struct{}
 	agentTLSSecretName string
 
@@ -54,6 +67,7 @@ type store struct {
 }
 
 func newStore(
+	gcName string,
 	dockerSecretNames []string,
 	agentTLSSecretName,
 	jwtSecretName,
@@ -68,6 +82,7 @@ func newStore(
 	return &store{
 		gateways:            make(map[types.NamespacedName]*gatewayv1.Gateway),
 		nginxResources:      make(map[types.NamespacedName]*NginxResources),
+		gcName:              gcName,
 		dockerSecretNames:   dockerSecretNamesMap,
 		agentTLSSecretName:  agentTLSSecretName,
 		jwtSecretName:       jwtSecretName,
@@ -141,14 +156,49 @@ func (s *store) registerResourceInGatewayConfig(gatewayNSName types.NamespacedNa
 		} else {
 			cfg.DaemonSet = obj.ObjectMeta
 		}
-	case *corev1.Service:
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		if cfg, ok := s.nginxResources[gatewayNSName]; !ok {
+			s.nginxResources[gatewayNSName] = &NginxResources{
+				HorizontalPodAutoscaler: obj.ObjectMeta,
+			}
+		} else {
+			cfg.HorizontalPodAutoscaler = obj.ObjectMeta
+		}
+	case *policyv1.PodDisruptionBudget:
+		if cfg, ok := s.nginxResources[gatewayNSName]; !ok {
+			s.nginxResources[gatewayNSName] = &NginxResources{
+				PodDisruptionBudget: obj.ObjectMeta,
+			}
+		} else {
+			cfg.PodDisruptionBudget = obj.ObjectMeta
+		}
+	case *networkingv1.NetworkPolicy:
+		if cfg, ok := s.nginxResources[gatewayNSName]; !ok {
+			s.nginxResources[gatewayNSName] = &NginxResources{
+				NetworkPolicy: obj.ObjectMeta,
+			}
+		} else {
+			cfg.NetworkPolicy = obj.ObjectMeta
+		}
+	case *unstructured.Unstructured:
+		if !isPodMonitor(obj) {
+			break
+		}
+
+		objectMeta := metav1.ObjectMeta{
+			Name:            obj.GetName(),
+			Namespace:       obj.GetNamespace(),
+			ResourceVersion: obj.GetResourceVersion(),
+		}
 		if cfg, ok := s.nginxResources[gatewayNSName]; !ok {
 			s.nginxResources[gatewayNSName] = &NginxResources{
-				Service: obj.ObjectMeta,
+				PodMonitor: objectMeta,
 			}
 		} else {
-			cfg.Service = obj.ObjectMeta
+			cfg.PodMonitor = objectMeta
 		}
+	case *corev1.Service:
+		s.registerServiceInGatewayConfig(obj, gatewayNSName)
 	case *corev1.ServiceAccount:
 		if cfg, ok := s.nginxResources[gatewayNSName]; !ok {
 			s.nginxResources[gatewayNSName] = &NginxResources{
@@ -182,6 +232,21 @@ func (s *store) registerResourceInGatewayConfig(gatewayNSName types.NamespacedNa
 	return true
 }
 
+func (s *store) registerServiceInGatewayConfig(obj *corev1.Service, gatewayNSName types.NamespacedName) {
+	cfg, ok := s.nginxResources[gatewayNSName]
+	if !ok {
+		cfg = &NginxResources{}
+		s.nginxResources[gatewayNSName] = cfg
+	}
+
+	deploymentName := controller.CreateNginxResourceName(gatewayNSName.Name, s.gcName)
+	if isInternalService(obj.GetName(), deploymentName) {
+		cfg.InternalService = obj.ObjectMeta
+	} else {
+		cfg.Service = obj.ObjectMeta
+	}
+}
+
 func (s *store) registerConfigMapInGatewayConfig(obj *corev1.ConfigMap, gatewayNSName types.NamespacedName) {
 	if cfg, ok := s.nginxResources[gatewayNSName]; !ok {
 		if strings.HasSuffix(obj.GetName(), nginxIncludesConfigMapNameSuffix) {
@@ -273,6 +338,27 @@ func gatewayChanged(original, updated *graph.Gateway) bool {
 		return true
 	}
 
+	if original.PodMonitorCRDInstalled != updated.PodMonitorCRDInstalled {
+		return true
+	}
+
+	// The quota of a data plane depends on the other data planes of the namespace,
+	// so it can change even if the Gateway didn't.
+	if original.DataPlaneQuotaExceeded != updated.DataPlaneQuotaExceeded {
+		return true
+	}
+
+	if !reflect.DeepEqual(original.MergedInto, updated.MergedInto) ||
+		!reflect.DeepEqual(original.MergedGateways, updated.MergedGateways) {
+		return true
+	}
+
+	// The backend Services only affect the NetworkPolicy, so ignore them if it is disabled.
+	if networkPolicyEnabled(updated.EffectiveNginxProxy) &&
+		!reflect.DeepEqual(original.BackendServices, updated.BackendServices) {
+		return true
+	}
+
 	return !reflect.DeepEqual(original.EffectiveNginxProxy, updated.EffectiveNginxProxy)
 }
 
@@ -290,6 +376,16 @@ func (s *store) deleteResourcesForGateway(nsName types.NamespacedName) {
 	delete(s.nginxResources, nsName)
 }
 
+// unregisterPodMonitor removes the PodMonitor from the nginx resources of the Gateway.
+func (s *store) unregisterPodMonitor(gatewayNSName types.NamespacedName) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if cfg, ok := s.nginxResources[gatewayNSName]; ok {
+		cfg.PodMonitor = metav1.ObjectMeta{}
+	}
+}
+
 //nolint:gocyclo // will refactor at some point
 func (s *store) gatewayExistsForResource(object client.Object, nsName types.NamespacedName) *graph.Gateway {
 	s.lock.RLock()
@@ -305,8 +401,20 @@ func (s *store) gatewayExistsForResource(object client.Object, nsName types.Name
 			if resourceMatches(resources.DaemonSet, nsName) {
 				return resources.Gateway
 			}
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			if resourceMatches(resources.HorizontalPodAutoscaler, nsName) {
+				return resources.Gateway
+			}
+		case *policyv1.PodDisruptionBudget:
+			if resourceMatches(resources.PodDisruptionBudget, nsName) {
+				return resources.Gateway
+			}
+		case *networkingv1.NetworkPolicy:
+			if resourceMatches(resources.NetworkPolicy, nsName) {
+				return resources.Gateway
+			}
 		case *corev1.Service:
-			if resourceMatches(resources.Service, nsName) {
+			if resourceMatches(resources.Service, nsName) || resourceMatches(resources.InternalService, nsName) {
 				return resources.Gateway
 			}
 		case *corev1.ServiceAccount:
@@ -383,10 +491,25 @@ func (s *store) getResourceVersionForObject(gatewayNSName types.NamespacedName,
 		if resources.DaemonSet.GetName() == obj.GetName() {
 			return resources.DaemonSet.GetResourceVersion()
 		}
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		if resources.HorizontalPodAutoscaler.GetName() == obj.GetName() {
+			return resources.HorizontalPodAutoscaler.GetResourceVersion()
+		}
+	case *policyv1.PodDisruptionBudget:
+		if resources.PodDisruptionBudget.GetName() == obj.GetName() {
+			return resources.PodDisruptionBudget.GetResourceVersion()
+		}
+	case *networkingv1.NetworkPolicy:
+		if resources.NetworkPolicy.GetName() == obj.GetName() {
+			return resources.NetworkPolicy.GetResourceVersion()
+		}
 	case *corev1.Service:
 		if resources.Service.GetName() == obj.GetName() {
 			return resources.Service.GetResourceVersion()
 		}
+		if resources.InternalService.GetName() == obj.GetName() {
+			return resources.InternalService.GetResourceVersion()
+		}
 	case *corev1.ServiceAccount:
 		if resources.ServiceAccount.GetName() == obj.GetName() {
 			return resources.ServiceAccount.GetResourceVersion()
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -23,7 +24,7 @@ func TestNewStore(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	store := newStore([]string{"docker-secret"}, "agent-tls-secret", "jwt-secret", "ca-secret", "client-ssl-secret")
+	store := newStore("nginx", []string{"docker-secret"}, "agent-tls-secret", "jwt-secret", "ca-secret", "client-ssl-secret")
 
 	g.Expect(store).NotTo(BeNil())
 	g.Expect(store.dockerSecretNames).To(HaveKey("docker-secret"))
@@ -37,7 +38,7 @@ func TestUpdateGateway(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	store := newStore(nil, "", "", "", "")
+	store := newStore("nginx", nil, "", "", "", "")
 	gateway := &gatewayv1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-gateway",
@@ -56,7 +57,7 @@ func TestDeleteGateway(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	store := newStore(nil, "", "", "", "")
+	store := newStore("nginx", nil, "", "", "", "")
 	nsName := types.NamespacedName{Name: "test-gateway", Namespace: "default"}
 	store.gateways[nsName] = &gatewayv1.Gateway{}
 
@@ -70,7 +71,7 @@ func TestGetGateways(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	store := newStore(nil, "", "", "", "")
+	store := newStore("nginx", nil, "", "", "", "")
 	gateway1 := &gatewayv1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-gateway-1",
@@ -101,7 +102,7 @@ func TestRegisterResourceInGatewayConfig(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	store := newStore([]string{"docker-secret"}, "agent-tls-secret", "jwt-secret", "ca-secret", "client-ssl-secret")
+	store := newStore("nginx", []string{"docker-secret"}, "agent-tls-secret", "jwt-secret", "ca-secret", "client-ssl-secret")
 	nsName := types.NamespacedName{Name: "test-gateway", Namespace: "default"}
 
 	registerAndGetResources := func(obj any) *NginxResources {
@@ -156,6 +157,18 @@ func TestRegisterResourceInGatewayConfig(t *testing.T) {
 	// clear out resources before next test
 	store.deleteResourcesForGateway(nsName)
 
+	// PodDisruptionBudget
+	pdb := &policyv1.PodDisruptionBudget{ObjectMeta: defaultMeta}
+	resources = registerAndGetResources(pdb)
+	g.Expect(resources.PodDisruptionBudget).To(Equal(defaultMeta))
+
+	// PodDisruptionBudget again, already exists
+	resources = registerAndGetResources(pdb)
+	g.Expect(resources.PodDisruptionBudget).To(Equal(defaultMeta))
+
+	// clear out resources before next test
+	store.deleteResourcesForGateway(nsName)
+
 	// Service
 	svc := &corev1.Service{ObjectMeta: defaultMeta}
 	resources = registerAndGetResources(svc)
@@ -168,6 +181,33 @@ func TestRegisterResourceInGatewayConfig(t *testing.T) {
 	// clear out resources before next test
 	store.deleteResourcesForGateway(nsName)
 
+	// internal Service
+	internalMeta := metav1.ObjectMeta{
+		Name:      "test-gateway-nginx-internal",
+		Namespace: "default",
+	}
+	internalSvc := &corev1.Service{ObjectMeta: internalMeta}
+	resources = registerAndGetResources(internalSvc)
+	g.Expect(resources.InternalService).To(Equal(internalMeta))
+	g.Expect(resources.Service).To(Equal(metav1.ObjectMeta{}))
+
+	// Service alongside the internal Service
+	resources = registerAndGetResources(svc)
+	g.Expect(resources.Service).To(Equal(defaultMeta))
+	g.Expect(resources.InternalService).To(Equal(internalMeta))
+
+	// clear out resources before next test
+	store.deleteResourcesForGateway(nsName)
+
+	// main Service of a GatewayClass named after the suffix of the internal Service
+	internalGCStore := newStore("internal", nil, "", "", "", "")
+	mainMeta := metav1.ObjectMeta{Name: "test-gateway-internal", Namespace: "default"}
+	g.Expect(internalGCStore.registerResourceInGatewayConfig(nsName, &corev1.Service{ObjectMeta: mainMeta})).To(BeTrue())
+
+	resources = internalGCStore.getNginxResourcesForGateway(nsName)
+	g.Expect(resources.Service).To(Equal(mainMeta))
+	g.Expect(resources.InternalService).To(Equal(metav1.ObjectMeta{}))
+
 	// ServiceAccount
 	svcAcct := &corev1.ServiceAccount{ObjectMeta: defaultMeta}
 	resources = registerAndGetResources(svcAcct)
@@ -378,6 +418,65 @@ func TestGatewayChanged(t *testing.T) {
 			},
 			changed: true,
 		},
+		{
+			name:     "pod monitor CRD installed changes",
+			original: &graph.Gateway{PodMonitorCRDInstalled: false},
+			updated:  &graph.Gateway{PodMonitorCRDInstalled: true},
+			changed:  true,
+		},
+		{
+			name:     "data plane quota exceeded changes",
+			original: &graph.Gateway{DataPlaneQuotaExceeded: false},
+			updated:  &graph.Gateway{DataPlaneQuotaExceeded: true},
+			changed:  true,
+		},
+		{
+			name: "backend services change with network policy enabled",
+			original: &graph.Gateway{
+				EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+					Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+						NetworkPolicy: &ngfAPIv1alpha2.NetworkPolicySpec{Enable: helpers.GetPointer(true)},
+					},
+				},
+			},
+			updated: &graph.Gateway{
+				EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+					Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+						NetworkPolicy: &ngfAPIv1alpha2.NetworkPolicySpec{Enable: helpers.GetPointer(true)},
+					},
+				},
+				BackendServices: []graph.BackendService{
+					{NsName: types.NamespacedName{Namespace: "default", Name: "coffee"}},
+				},
+			},
+			changed: true,
+		},
+		{
+			name:     "backend services change with network policy disabled",
+			original: &graph.Gateway{},
+			updated: &graph.Gateway{
+				BackendServices: []graph.BackendService{
+					{NsName: types.NamespacedName{Namespace: "default", Name: "coffee"}},
+				},
+			},
+			changed: false,
+		},
+		{
+			name:     "merged into another gateway",
+			original: &graph.Gateway{},
+			updated:  &graph.Gateway{MergedInto: &types.NamespacedName{Namespace: "default", Name: "owner"}},
+			changed:  true,
+		},
+		{
+			name:     "merged gateways change",
+			original: &graph.Gateway{},
+			updated: &graph.Gateway{
+				MergedGateways: []*gatewayv1.Gateway{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "member"}},
+				},
+			},
+			changed: true,
+		},
 		{
 			name: "no changes",
 			original: &graph.Gateway{Source: &gatewayv1.Gateway{
@@ -415,7 +514,7 @@ func TestDeleteResourcesForGateway(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	store := newStore(nil, "", "", "", "")
+	store := newStore("nginx", nil, "", "", "", "")
 	nsName := types.NamespacedName{Name: "test-gateway", Namespace: "default"}
 	store.nginxResources[nsName] = &NginxResources{}
 
@@ -424,10 +523,25 @@ func TestDeleteResourcesForGateway(t *testing.T) {
 	g.Expect(store.nginxResources).NotTo(HaveKey(nsName))
 }
 
+func TestUnregisterPodMonitor(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := newStore("nginx", nil, "", "", "", "")
+	nsName := types.NamespacedName{Name: "test-gateway", Namespace: "default"}
+
+	podMonitor := newPodMonitor(metav1.ObjectMeta{Name: "test-gateway-nginx", Namespace: "default"})
+	store.registerResourceInGatewayConfig(nsName, podMonitor)
+	g.Expect(store.nginxResources[nsName].PodMonitor.GetName()).To(Equal("test-gateway-nginx"))
+
+	store.unregisterPodMonitor(nsName)
+	g.Expect(store.nginxResources[nsName].PodMonitor).To(Equal(metav1.ObjectMeta{}))
+}
+
 func TestGatewayExistsForResource(t *testing.T) {
 	t.Parallel()
 
-	store := newStore(nil, "", "", "", "")
+	store := newStore("nginx", nil, "", "", "", "")
 	gateway := &graph.Gateway{}
 	store.nginxResources[types.NamespacedName{Name: "test-gateway", Namespace: "default"}] = &NginxResources{
 		Gateway: gateway,
@@ -439,6 +553,10 @@ func TestGatewayExistsForResource(t *testing.T) {
 			Name:      "test-daemonset",
 			Namespace: "default",
 		},
+		PodDisruptionBudget: metav1.ObjectMeta{
+			Name:      "test-pdb",
+			Namespace: "default",
+		},
 		Service: metav1.ObjectMeta{
 			Name:      "test-service",
 			Namespace: "default",
@@ -512,6 +630,16 @@ func TestGatewayExistsForResource(t *testing.T) {
 			},
 			expected: gateway,
 		},
+		{
+			name: "PodDisruptionBudget exists",
+			object: &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pdb",
+					Namespace: "default",
+				},
+			},
+			expected: gateway,
+		},
 		{
 			name: "Service exists",
 			object: &corev1.Service{
@@ -648,7 +776,7 @@ func TestGatewayExistsForResource(t *testing.T) {
 func TestGetResourceVersionForObject(t *testing.T) {
 	t.Parallel()
 
-	store := newStore(nil, "", "", "", "")
+	store := newStore("nginx", nil, "", "", "", "")
 	nsName := types.NamespacedName{Name: "test-gateway", Namespace: "default"}
 	store.nginxResources[nsName] = &NginxResources{
 		Deployment: metav1.ObjectMeta{
@@ -718,6 +846,11 @@ func TestGetResourceVersionForObject(t *testing.T) {
 				ResourceVersion: "13",
 			},
 		},
+		PodDisruptionBudget: metav1.ObjectMeta{
+			Name:            "test-pdb",
+			Namespace:       "default",
+			ResourceVersion: "14",
+		},
 	}
 
 	tests := []struct {
@@ -745,6 +878,16 @@ func TestGetResourceVersionForObject(t *testing.T) {
 			},
 			expectedResult: "2",
 		},
+		{
+			name: "PodDisruptionBudget resource version",
+			object: &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-pdb",
+					Namespace: "default",
+				},
+			},
+			expectedResult: "14",
+		},
 		{
 			name: "Service resource version",
 			object: &corev1.Service{
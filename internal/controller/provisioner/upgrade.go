@@ -0,0 +1,678 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/status"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
+)
+
+const (
+	// dataPlaneSlotPrimary is the slot of the Pods of the NGINX Deployment.
+	dataPlaneSlotPrimary = "primary"
+	// dataPlaneSlotStaged is the slot of the Pods of the Deployment that stages a blue/green image upgrade.
+	dataPlaneSlotStaged = "staged"
+	// stagedDeploymentNameSuffix is the suffix of the name of the Deployment that stages a blue/green image upgrade.
+	stagedDeploymentNameSuffix = "staged"
+
+	// defaultUpgradeTimeout is the time that each Deployment of a blue/green image upgrade has to become
+	// ready and apply the configuration.
+	defaultUpgradeTimeout      = 10 * time.Minute
+	defaultUpgradePollInterval = 2 * time.Second
+)
+
+// dataPlaneUpgrade is a blue/green upgrade of the image of an NGINX Deployment.
+type dataPlaneUpgrade struct {
+	// cancel cancels the upgrade.
+	cancel context.CancelFunc
+	// done is closed once the upgrade is finished and its staged Deployment is removed.
+	done chan struct{}
+	// condition is the latest condition of the upgrade, which is reported in the status of the Gateway.
+	condition conditions.Condition
+	// image is the image that the NGINX Deployment is upgraded to.
+	image string
+	// previousImage is the image that the NGINX Deployment is rolled back to if it fails to update.
+	previousImage string
+	// failed indicates whether the upgrade failed.
+	failed bool
+}
+
+// running returns whether the upgrade is still running.
+func (u *dataPlaneUpgrade) running() bool {
+	select {
+	case <-u.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// dataPlaneUpgrades tracks the latest blue/green image upgrade of the NGINX Deployment of each Gateway.
+type dataPlaneUpgrades struct {
+	upgrades map[types.NamespacedName]*dataPlaneUpgrade
+	// timeout and pollInterval default to defaultUpgradeTimeout and defaultUpgradePollInterval if unset.
+	timeout      time.Duration
+	pollInterval time.Duration
+
+	lock sync.Mutex
+	// stageLock serializes the decisions to start upgrades, since the resources of a Gateway can be
+	// provisioned concurrently.
+	stageLock sync.Mutex
+}
+
+// get returns the latest upgrade of the Gateway, or nil if its NGINX Deployment was never upgraded.
+func (u *dataPlaneUpgrades) get(gatewayNSName types.NamespacedName) *dataPlaneUpgrade {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	return u.upgrades[gatewayNSName]
+}
+
+// start records a new upgrade of the Gateway from the previous image to the image, and returns it with
+// the context it runs with.
+func (u *dataPlaneUpgrades) start(
+	ctx context.Context,
+	gatewayNSName types.NamespacedName,
+	previousImage string,
+	image string,
+) (context.Context, *dataPlaneUpgrade) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	upgradeCtx, cancel := context.WithCancel(ctx)
+	upgrade := &dataPlaneUpgrade{
+		cancel:        cancel,
+		done:          make(chan struct{}),
+		image:         image,
+		previousImage: previousImage,
+		condition: conditions.NewGatewayDataPlaneUpgradeProgressing(
+			fmt.Sprintf("The NGINX data plane is being upgraded to image %s", image),
+		),
+	}
+
+	if u.upgrades == nil {
+		u.upgrades = make(map[types.NamespacedName]*dataPlaneUpgrade)
+	}
+	u.upgrades[gatewayNSName] = upgrade
+
+	return upgradeCtx, upgrade
+}
+
+// stop cancels the upgrade of the Gateway, if it's running, waits for its cleanup and forgets about it.
+func (u *dataPlaneUpgrades) stop(gatewayNSName types.NamespacedName) {
+	upgrade := u.get(gatewayNSName)
+	if upgrade == nil {
+		return
+	}
+
+	upgrade.cancel()
+	<-upgrade.done
+
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if u.upgrades[gatewayNSName] == upgrade {
+		delete(u.upgrades, gatewayNSName)
+	}
+}
+
+// setCondition sets the latest condition of the upgrade.
+func (u *dataPlaneUpgrades) setCondition(upgrade *dataPlaneUpgrade, condition conditions.Condition, failed bool) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	upgrade.condition = condition
+	upgrade.failed = failed
+}
+
+// failed returns whether the upgrade failed.
+func (u *dataPlaneUpgrades) failed(upgrade *dataPlaneUpgrade) bool {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	return upgrade.failed
+}
+
+// condition returns a copy of the latest condition of the upgrade of the Gateway, or nil if there is none.
+func (u *dataPlaneUpgrades) condition(gatewayNSName types.NamespacedName) *conditions.Condition {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	upgrade, ok := u.upgrades[gatewayNSName]
+	if !ok {
+		return nil
+	}
+
+	condition := upgrade.condition
+	return &condition
+}
+
+func (u *dataPlaneUpgrades) getTimeout() time.Duration {
+	if u.timeout == 0 {
+		return defaultUpgradeTimeout
+	}
+	return u.timeout
+}
+
+func (u *dataPlaneUpgrades) getPollInterval() time.Duration {
+	if u.pollInterval == 0 {
+		return defaultUpgradePollInterval
+	}
+	return u.pollInterval
+}
+
+// LatestDataPlaneUpgrade returns the condition of the latest blue/green image upgrade of the NGINX Deployment
+// of the Gateway, or nil if the Deployment was never upgraded with the blue/green strategy.
+func (p *NginxProvisioner) LatestDataPlaneUpgrade(gatewayNSName types.NamespacedName) *conditions.Condition {
+	return p.upgrades.condition(gatewayNSName)
+}
+
+// stageImageUpgrade starts a blue/green upgrade of the NGINX Deployment if the image of its NGINX container
+// changed, and returns the objects to create or update. While an upgrade is running, the Deployment and the
+// Services that select its Pods are managed by the upgrade, so they are not returned.
+func (p *NginxProvisioner) stageImageUpgrade(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	nProxyCfg *graph.EffectiveNginxProxy,
+	objects []client.Object,
+) []client.Object {
+	p.upgrades.stageLock.Lock()
+	defer p.upgrades.stageLock.Unlock()
+
+	gatewayNSName := client.ObjectKeyFromObject(gateway)
+
+	var deployment *appsv1.Deployment
+	for _, obj := range objects {
+		if d, ok := obj.(*appsv1.Deployment); ok {
+			deployment = d
+			break
+		}
+	}
+
+	if upgrade := p.upgrades.get(gatewayNSName); upgrade != nil && upgrade.running() {
+		if deployment == nil || nginxImage(deployment.Spec.Template) == upgrade.image {
+			return withoutUpgradedObjects(objects)
+		}
+
+		// the image changed again, so the running upgrade is replaced with an upgrade to the new image
+		p.upgrades.stop(gatewayNSName)
+	}
+
+	if deployment == nil || !blueGreenUpgradeEnabled(nProxyCfg) {
+		return objects
+	}
+
+	getCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var current appsv1.Deployment
+	if err := p.k8sClient.Get(getCtx, client.ObjectKeyFromObject(deployment), &current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			p.cfg.Logger.Error(err, "error getting nginx Deployment, upgrading it with a rolling update")
+		}
+		return objects
+	}
+
+	// The Pods of a Deployment created before blue/green upgrades were enabled are not labeled with their slot,
+	// so the Services can't switch between them and the Pods of a staged Deployment. Such a Deployment is
+	// upgraded with a rolling update, which also labels its Pods.
+	if _, ok := current.Spec.Template.Labels[controller.DataPlaneSlotLabel]; !ok {
+		return objects
+	}
+
+	currentImage := nginxImage(current.Spec.Template)
+	image := nginxImage(deployment.Spec.Template)
+	if currentImage == "" || currentImage == image {
+		return objects
+	}
+
+	// A failed upgrade is not retried until the image changes again, and the Deployment keeps its current image.
+	if upgrade := p.upgrades.get(gatewayNSName); upgrade != nil && upgrade.image == image && p.upgrades.failed(upgrade) {
+		setNginxImage(&deployment.Spec.Template, currentImage)
+		return objects
+	}
+
+	replicas := int32(1)
+	if current.Spec.Replicas != nil {
+		replicas = *current.Spec.Replicas
+	}
+
+	var services []*corev1.Service
+	for _, obj := range objects {
+		if svc, ok := obj.(*corev1.Service); ok && selectsDataPlaneSlot(svc) {
+			services = append(services, svc.DeepCopy())
+		}
+	}
+
+	p.cfg.Logger.Info(
+		"Upgrading nginx with a staged Deployment",
+		"namespace", deployment.GetNamespace(),
+		"name", deployment.GetName(),
+		"image", image,
+	)
+
+	upgradeCtx, upgrade := p.upgrades.start(ctx, gatewayNSName, currentImage, image)
+	go p.upgradeDataPlane(upgradeCtx, ctx, upgrade, deployment.DeepCopy(), services, replicas)
+
+	return withoutUpgradedObjects(objects)
+}
+
+// upgradeDataPlane runs the blue/green upgrade of the NGINX Deployment to the image of the deployment:
+//   - a staged Deployment with the new image is created next to the NGINX Deployment.
+//   - once the agents of all its Pods have applied the configuration, the Services select the staged Pods.
+//   - the NGINX Deployment is updated to the new image, and once the agents of all its Pods have applied the
+//     configuration, the Services select its Pods again.
+//
+// The staged Deployment is not promoted in place of the NGINX Deployment, because the name of the NGINX Deployment
+// identifies the data plane: the agents of its Pods are registered under it, the HorizontalPodAutoscaler scales it
+// and the provisioner manages it, and its selector is immutable. Instead, the NGINX Deployment is rolled out while
+// the staged Pods serve the traffic, so the rollout never serves traffic from Pods that didn't apply
+// the configuration. If the rollout fails, the NGINX Deployment is rolled back to its previous image before
+// the traffic is shifted back to it.
+//
+// The staged Deployment is removed afterward, and the Services select the Pods of the NGINX Deployment,
+// even if the upgrade failed or was cancelled.
+func (p *NginxProvisioner) upgradeDataPlane(
+	ctx context.Context,
+	parentCtx context.Context,
+	upgrade *dataPlaneUpgrade,
+	deployment *appsv1.Deployment,
+	services []*corev1.Service,
+	replicas int32,
+) {
+	defer close(upgrade.done)
+
+	primaryNSName := client.ObjectKeyFromObject(deployment)
+	staged := buildStagedDeployment(deployment, replicas)
+
+	// The broadcaster of the staged Deployment outlives the upgrade, like the broadcasters of the NGINX
+	// Deployments, since the agents of the staged Pods may still cancel their subscriptions afterward.
+	p.cfg.DeploymentStore.StoreStaged(
+		context.WithoutCancel(parentCtx),
+		primaryNSName,
+		client.ObjectKeyFromObject(staged),
+		make(chan struct{}),
+	)
+
+	upgradeErr := p.runDataPlaneUpgrade(ctx, upgrade, deployment, staged, services)
+
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+
+	var cleanupErrs []error
+	if err := p.switchServices(cleanupCtx, services, dataPlaneSlotPrimary); err != nil {
+		cleanupErrs = append(cleanupErrs, err)
+	}
+	if err := p.k8sClient.Delete(cleanupCtx, staged); err != nil && !isNotFoundError(err) {
+		cleanupErrs = append(cleanupErrs, fmt.Errorf("error deleting staged Deployment: %w", err))
+	}
+	p.cfg.DeploymentStore.RemoveStaged(primaryNSName)
+
+	if err := errors.Join(cleanupErrs...); err != nil {
+		p.cfg.Logger.Error(err, "error cleaning up nginx upgrade", "namespace", staged.Namespace, "name", staged.Name)
+	}
+
+	if upgradeErr != nil {
+		p.cfg.Logger.Error(
+			upgradeErr,
+			"error upgrading nginx",
+			"namespace", deployment.GetNamespace(),
+			"name", deployment.GetName(),
+			"image", upgrade.image,
+		)
+		p.cfg.EventRecorder.Eventf(
+			deployment,
+			corev1.EventTypeWarning,
+			"UpgradeFailed",
+			"Failed to upgrade nginx to image %s: %s",
+			upgrade.image,
+			upgradeErr.Error(),
+		)
+		p.upgrades.setCondition(upgrade, conditions.NewGatewayDataPlaneUpgradeFailed(upgradeErr.Error()), true)
+	} else {
+		p.upgrades.setCondition(upgrade, conditions.NewGatewayDataPlaneUpgraded(upgrade.image), false)
+	}
+
+	p.enqueueDataPlaneUpgradeStatus(primaryNSName)
+}
+
+// runDataPlaneUpgrade shifts the traffic of the NGINX Deployment to the staged Deployment while the NGINX
+// Deployment is updated to the new image.
+func (p *NginxProvisioner) runDataPlaneUpgrade(
+	ctx context.Context,
+	upgrade *dataPlaneUpgrade,
+	deployment *appsv1.Deployment,
+	staged *appsv1.Deployment,
+	services []*corev1.Service,
+) error {
+	primaryNSName := client.ObjectKeyFromObject(deployment)
+	stagedNSName := client.ObjectKeyFromObject(staged)
+
+	p.setDataPlaneUpgradeProgress(upgrade, primaryNSName, fmt.Sprintf(
+		"Waiting for the NGINX agents of the staged Deployment %s with image %s to apply the configuration",
+		stagedNSName,
+		upgrade.image,
+	))
+
+	if err := p.applyDeployment(ctx, staged); err != nil {
+		return fmt.Errorf("error creating staged Deployment: %w", err)
+	}
+
+	if err := p.waitForDataPlane(ctx, stagedNSName); err != nil {
+		return fmt.Errorf("staged Deployment %s is not ready: %w", stagedNSName, err)
+	}
+
+	if err := p.switchServices(ctx, services, dataPlaneSlotStaged); err != nil {
+		return err
+	}
+
+	p.setDataPlaneUpgradeProgress(upgrade, primaryNSName, fmt.Sprintf(
+		"The traffic is shifted to the staged Deployment %s while the Deployment %s is updated to image %s",
+		stagedNSName,
+		primaryNSName,
+		upgrade.image,
+	))
+
+	var rolloutErr error
+	if err := p.applyDeployment(ctx, deployment); err != nil {
+		rolloutErr = fmt.Errorf("error updating Deployment: %w", err)
+	} else if err := p.waitForDataPlane(ctx, primaryNSName); err != nil {
+		rolloutErr = fmt.Errorf("deployment %s is not ready: %w", primaryNSName, err)
+	}
+
+	if rolloutErr == nil {
+		return nil
+	}
+
+	// A cancelled upgrade is replaced by a new upgrade or the removal of the nginx resources,
+	// so the Deployment is not rolled back.
+	if ctx.Err() != nil {
+		return rolloutErr
+	}
+
+	p.setDataPlaneUpgradeProgress(upgrade, primaryNSName, fmt.Sprintf(
+		"The Deployment %s failed to update to image %s and is rolled back to image %s, "+
+			"while the traffic is served by the staged Deployment %s",
+		primaryNSName,
+		upgrade.image,
+		upgrade.previousImage,
+		stagedNSName,
+	))
+
+	if err := p.rollBackDeployment(ctx, deployment, upgrade.previousImage); err != nil {
+		return errors.Join(rolloutErr, err)
+	}
+
+	return rolloutErr
+}
+
+// rollBackDeployment rolls the Deployment back to the previous image of its NGINX container, and waits until
+// it's ready again.
+func (p *NginxProvisioner) rollBackDeployment(
+	ctx context.Context,
+	deployment *appsv1.Deployment,
+	previousImage string,
+) error {
+	rollback := deployment.DeepCopy()
+	setNginxImage(&rollback.Spec.Template, previousImage)
+
+	if err := p.applyDeployment(ctx, rollback); err != nil {
+		return fmt.Errorf("error rolling back Deployment: %w", err)
+	}
+
+	if err := p.waitForDataPlane(ctx, client.ObjectKeyFromObject(rollback)); err != nil {
+		return fmt.Errorf("rolled back Deployment %s is not ready: %w", client.ObjectKeyFromObject(rollback), err)
+	}
+
+	return nil
+}
+
+// setDataPlaneUpgradeProgress reports the progress of the upgrade in the status of the Gateway.
+func (p *NginxProvisioner) setDataPlaneUpgradeProgress(
+	upgrade *dataPlaneUpgrade,
+	deploymentNSName types.NamespacedName,
+	msg string,
+) {
+	p.upgrades.setCondition(upgrade, conditions.NewGatewayDataPlaneUpgradeProgressing(msg), false)
+	p.enqueueDataPlaneUpgradeStatus(deploymentNSName)
+}
+
+// enqueueDataPlaneUpgradeStatus triggers a status update of the Gateways of the NGINX Deployment, keeping the
+// latest configuration errors of the Deployment.
+func (p *NginxProvisioner) enqueueDataPlaneUpgradeStatus(deploymentNSName types.NamespacedName) {
+	var err error
+	if deployment := p.cfg.DeploymentStore.Get(deploymentNSName); deployment != nil {
+		err = errors.Join(deployment.GetLatestConfigError(), deployment.GetLatestUpstreamError())
+	}
+
+	p.cfg.StatusQueue.Enqueue(&status.QueueObject{
+		Deployment: deploymentNSName,
+		UpdateType: status.UpdateAll,
+		Error:      err,
+	})
+}
+
+// applyDeployment creates or updates the Deployment.
+func (p *NginxProvisioner) applyDeployment(ctx context.Context, deployment *appsv1.Deployment) error {
+	return wait.PollUntilContextTimeout(
+		ctx,
+		500*time.Millisecond,
+		30*time.Second,
+		true, /* poll immediately */
+		func(ctx context.Context) (bool, error) {
+			obj := deployment.DeepCopy()
+			if _, err := controllerutil.CreateOrUpdate(ctx, p.k8sClient, obj, objectSpecSetter(obj)); err != nil {
+				if apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		},
+	)
+}
+
+// waitForDataPlane waits until all Pods of the Deployment are updated and ready, and their NGINX agents have
+// applied the configuration.
+func (p *NginxProvisioner) waitForDataPlane(ctx context.Context, deploymentNSName types.NamespacedName) error {
+	var notReadyErr error
+
+	err := wait.PollUntilContextTimeout(
+		ctx,
+		p.upgrades.getPollInterval(),
+		p.upgrades.getTimeout(),
+		true, /* poll immediately */
+		func(ctx context.Context) (bool, error) {
+			var deployment appsv1.Deployment
+			if err := p.k8sClient.Get(ctx, deploymentNSName, &deployment); err != nil {
+				notReadyErr = err
+				return false, nil
+			}
+
+			replicas := int32(1)
+			if deployment.Spec.Replicas != nil {
+				replicas = *deployment.Spec.Replicas
+			}
+
+			if deployment.Status.ObservedGeneration < deployment.Generation ||
+				deployment.Status.UpdatedReplicas < replicas ||
+				deployment.Status.ReadyReplicas < replicas ||
+				deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
+				notReadyErr = fmt.Errorf(
+					"%d of %d Pods are updated and ready",
+					min(deployment.Status.UpdatedReplicas, deployment.Status.ReadyReplicas),
+					replicas,
+				)
+				return false, nil
+			}
+
+			agentDeployment := p.cfg.DeploymentStore.Get(deploymentNSName)
+			if agentDeployment == nil {
+				notReadyErr = errors.New("no NGINX agent is connected")
+				return false, nil
+			}
+
+			if podCount := agentDeployment.GetPodCount(); podCount < int(replicas) {
+				notReadyErr = fmt.Errorf("%d of %d NGINX agents applied the configuration", podCount, replicas)
+				return false, nil
+			}
+
+			if err := agentDeployment.GetConfigurationStatus(); err != nil {
+				notReadyErr = fmt.Errorf("the NGINX agents failed to apply the configuration: %w", err)
+				return false, nil
+			}
+
+			return true, nil
+		},
+	)
+	if err != nil && notReadyErr != nil {
+		return fmt.Errorf("%w: %w", err, notReadyErr)
+	}
+
+	return err
+}
+
+// switchServices updates the selectors of the Services to select the Pods of the slot. The services are the
+// Services as built for the NGINX Deployment, which select the Pods of the primary slot.
+func (p *NginxProvisioner) switchServices(ctx context.Context, services []*corev1.Service, slot string) error {
+	for _, service := range services {
+		selector := service.Spec.Selector
+		if slot == dataPlaneSlotStaged {
+			selector = stagedPodLabels(selector)
+		}
+
+		nsName := client.ObjectKeyFromObject(service)
+		if err := wait.PollUntilContextTimeout(
+			ctx,
+			500*time.Millisecond,
+			30*time.Second,
+			true, /* poll immediately */
+			func(ctx context.Context) (bool, error) {
+				var svc corev1.Service
+				if err := p.k8sClient.Get(ctx, nsName, &svc); err != nil {
+					if apierrors.IsNotFound(err) {
+						return true, nil
+					}
+					return false, err
+				}
+
+				if maps.Equal(svc.Spec.Selector, selector) {
+					return true, nil
+				}
+
+				svc.Spec.Selector = maps.Clone(selector)
+				if err := p.k8sClient.Update(ctx, &svc); err != nil {
+					if apierrors.IsConflict(err) {
+						return false, nil
+					}
+					return false, err
+				}
+
+				return true, nil
+			},
+		); err != nil {
+			return fmt.Errorf("error switching Service %s to the %s Pods: %w", nsName, slot, err)
+		}
+	}
+
+	return nil
+}
+
+// buildStagedDeployment builds the Deployment that stages the upgrade of the NGINX Deployment. Its Pods are the
+// Pods of the upgraded NGINX Deployment, labeled with the staged slot, see stagedPodLabels.
+func buildStagedDeployment(deployment *appsv1.Deployment, replicas int32) *appsv1.Deployment {
+	staged := &appsv1.Deployment{
+		ObjectMeta: *deployment.ObjectMeta.DeepCopy(),
+		Spec:       *deployment.Spec.DeepCopy(),
+	}
+
+	staged.Name = controller.CreateNginxResourceName(deployment.GetName(), stagedDeploymentNameSuffix)
+	staged.ResourceVersion = ""
+	staged.Labels = dataPlaneSlotSelector(deployment.Labels, dataPlaneSlotStaged)
+	staged.Spec.Replicas = &replicas
+	staged.Spec.Template.Labels = stagedPodLabels(deployment.Spec.Template.Labels)
+
+	if deployment.Spec.Selector != nil {
+		staged.Spec.Selector.MatchLabels = stagedPodLabels(deployment.Spec.Selector.MatchLabels)
+	}
+
+	return staged
+}
+
+// stagedPodLabels returns a copy of the labels of the NGINX Pods for the Pods of a staged Deployment.
+// The staged Pods are labeled with the staged slot, and not with the Gateway, so that they are not selected by
+// the NGINX Deployment or its PodDisruptionBudget, which select the Pods of the Gateway.
+// The app name label is kept, since the agents of the staged Pods authenticate with it, and the NetworkPolicy
+// selects the staged Pods with it.
+func stagedPodLabels(labels map[string]string) map[string]string {
+	staged := dataPlaneSlotSelector(labels, dataPlaneSlotStaged)
+	delete(staged, controller.GatewayLabel)
+
+	return staged
+}
+
+// isStagedDeployment returns whether the labels are the labels of a Deployment that stages a blue/green upgrade.
+func isStagedDeployment(labels map[string]string) bool {
+	return labels[controller.DataPlaneSlotLabel] == dataPlaneSlotStaged
+}
+
+// selectsDataPlaneSlot returns whether the Service selects the NGINX Pods by their slot.
+func selectsDataPlaneSlot(svc *corev1.Service) bool {
+	_, ok := svc.Spec.Selector[controller.DataPlaneSlotLabel]
+	return ok
+}
+
+// withoutUpgradedObjects returns the objects without the Deployment and the Services that are managed by
+// a running upgrade.
+func withoutUpgradedObjects(objects []client.Object) []client.Object {
+	filtered := make([]client.Object, 0, len(objects))
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			continue
+		case *corev1.Service:
+			if selectsDataPlaneSlot(o) {
+				continue
+			}
+		}
+		filtered = append(filtered, obj)
+	}
+
+	return filtered
+}
+
+// nginxImage returns the image of the NGINX container of the Pod template.
+func nginxImage(template corev1.PodTemplateSpec) string {
+	for _, container := range template.Spec.Containers {
+		if container.Name == "nginx" {
+			return container.Image
+		}
+	}
+
+	return ""
+}
+
+// setNginxImage sets the image of the NGINX container of the Pod template.
+func setNginxImage(template *corev1.PodTemplateSpec, image string) {
+	for i := range template.Spec.Containers {
+		if template.Spec.Containers[i].Name == "nginx" {
+			template.Spec.Containers[i].Image = image
+		}
+	}
+}
@@ -0,0 +1,452 @@
+package provisioner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/agent"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/status"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+var (
+	upgradeTestGateway = &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+	}
+	upgradeTestDeploymentNSName = types.NamespacedName{Namespace: "default", Name: "gw-nginx"}
+	upgradeTestStagedNSName     = types.NamespacedName{Namespace: "default", Name: "gw-nginx-staged"}
+	blueGreenNginxProxy         = &graph.EffectiveNginxProxy{
+		Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+			Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+				ImageUpgradeStrategy: helpers.GetPointer(ngfAPIv1alpha2.ImageUpgradeStrategyBlueGreen),
+			},
+		},
+	}
+)
+
+func createUpgradeTestDeployment(image string, podLabels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      upgradeTestDeploymentNSName.Name,
+			Namespace: upgradeTestDeploymentNSName.Namespace,
+			Labels:    map[string]string{"app": "nginx", controller.GatewayLabel: "gw"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: helpers.GetPointer[int32](1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "nginx", controller.GatewayLabel: "gw"},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "nginx", Image: image}},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:        1,
+			UpdatedReplicas: 1,
+			ReadyReplicas:   1,
+		},
+	}
+}
+
+func createUpgradeTestPodLabels() map[string]string {
+	return map[string]string{
+		"app":                         "nginx",
+		controller.GatewayLabel:       "gw",
+		controller.DataPlaneSlotLabel: dataPlaneSlotPrimary,
+	}
+}
+
+func createUpgradeTestService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      upgradeTestDeploymentNSName.Name,
+			Namespace: upgradeTestDeploymentNSName.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: createUpgradeTestPodLabels(),
+		},
+	}
+}
+
+func upgradeTestProvisioner(objects ...client.Object) (*NginxProvisioner, client.Client, *agent.DeploymentStore) {
+	provisioner, fakeClient, _ := defaultNginxProvisioner(objects...)
+
+	deploymentStore := agent.NewDeploymentStore(nil)
+	provisioner.cfg.DeploymentStore = deploymentStore
+	provisioner.cfg.StatusQueue = status.NewQueue()
+	provisioner.upgrades.pollInterval = 10 * time.Millisecond
+	provisioner.upgrades.timeout = 5 * time.Second
+
+	return provisioner, fakeClient, deploymentStore
+}
+
+func TestStageImageUpgrade_NotStarted(t *testing.T) {
+	t.Parallel()
+
+	slotLabels := createUpgradeTestPodLabels()
+
+	tests := []struct {
+		current   *appsv1.Deployment
+		nProxyCfg *graph.EffectiveNginxProxy
+		name      string
+	}{
+		{
+			name:      "deployment does not exist yet",
+			nProxyCfg: blueGreenNginxProxy,
+		},
+		{
+			name:      "image is unchanged",
+			current:   createUpgradeTestDeployment("nginx:2", slotLabels),
+			nProxyCfg: blueGreenNginxProxy,
+		},
+		{
+			name:      "pods are not labeled with their slot",
+			current:   createUpgradeTestDeployment("nginx:1", map[string]string{"app": "nginx"}),
+			nProxyCfg: blueGreenNginxProxy,
+		},
+		{
+			name:    "rolling upgrade strategy",
+			current: createUpgradeTestDeployment("nginx:1", slotLabels),
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+						ImageUpgradeStrategy: helpers.GetPointer(ngfAPIv1alpha2.ImageUpgradeStrategyRolling),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var existing []client.Object
+			if test.current != nil {
+				existing = append(existing, test.current)
+			}
+			provisioner, _, _ := upgradeTestProvisioner(existing...)
+
+			objects := []client.Object{
+				createUpgradeTestDeployment("nginx:2", slotLabels),
+				createUpgradeTestService(),
+			}
+
+			result := provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, test.nProxyCfg, objects)
+			g.Expect(result).To(Equal(objects))
+			g.Expect(provisioner.LatestDataPlaneUpgrade(client.ObjectKeyFromObject(upgradeTestGateway))).To(BeNil())
+		})
+	}
+}
+
+func TestStageImageUpgrade_FailedUpgradeIsNotRetried(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	slotLabels := createUpgradeTestPodLabels()
+	provisioner, _, _ := upgradeTestProvisioner(createUpgradeTestDeployment("nginx:1", slotLabels))
+
+	gatewayNSName := client.ObjectKeyFromObject(upgradeTestGateway)
+	_, upgrade := provisioner.upgrades.start(context.Background(), gatewayNSName, "nginx:1", "nginx:2")
+	provisioner.upgrades.setCondition(upgrade, conditions.NewGatewayDataPlaneUpgradeFailed("test"), true)
+	close(upgrade.done)
+
+	deployment := createUpgradeTestDeployment("nginx:2", slotLabels)
+	objects := []client.Object{deployment, createUpgradeTestService()}
+
+	result := provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, blueGreenNginxProxy, objects)
+	g.Expect(result).To(Equal(objects))
+	g.Expect(nginxImage(deployment.Spec.Template)).To(Equal("nginx:1"))
+
+	// a new image is upgraded again
+	deployment = createUpgradeTestDeployment("nginx:3", slotLabels)
+	objects = []client.Object{deployment, createUpgradeTestService()}
+
+	result = provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, blueGreenNginxProxy, objects)
+	g.Expect(result).To(BeEmpty())
+
+	provisioner.upgrades.stop(gatewayNSName)
+}
+
+func TestStageImageUpgrade(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	slotLabels := createUpgradeTestPodLabels()
+	provisioner, fakeClient, deploymentStore := upgradeTestProvisioner(
+		createUpgradeTestDeployment("nginx:1", slotLabels),
+		createUpgradeTestService(),
+	)
+
+	primary := deploymentStore.GetOrStore(context.Background(), upgradeTestDeploymentNSName, nil)
+	gatewayNSName := client.ObjectKeyFromObject(upgradeTestGateway)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw-nginx-agent-config", Namespace: "default"},
+	}
+	objects := []client.Object{
+		configMap,
+		createUpgradeTestService(),
+		createUpgradeTestDeployment("nginx:2", slotLabels),
+	}
+
+	// the Deployment and the Service are managed by the upgrade
+	result := provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, blueGreenNginxProxy, objects)
+	g.Expect(result).To(Equal([]client.Object{configMap}))
+
+	var staged appsv1.Deployment
+	g.Eventually(func() error {
+		return fakeClient.Get(context.Background(), upgradeTestStagedNSName, &staged)
+	}).Should(Succeed())
+
+	stagedPodSelector := map[string]string{"app": "nginx", controller.DataPlaneSlotLabel: dataPlaneSlotStaged}
+	g.Expect(staged.Labels).To(HaveKeyWithValue(controller.DataPlaneSlotLabel, dataPlaneSlotStaged))
+	g.Expect(staged.Spec.Selector.MatchLabels).To(Equal(stagedPodSelector))
+	g.Expect(staged.Spec.Template.Labels).To(Equal(stagedPodSelector))
+	g.Expect(staged.Spec.Replicas).To(Equal(helpers.GetPointer[int32](1)))
+	g.Expect(nginxImage(staged.Spec.Template)).To(Equal("nginx:2"))
+
+	condition := provisioner.LatestDataPlaneUpgrade(gatewayNSName)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Reason).To(Equal(string(conditions.GatewayReasonDataPlaneUpgradeProgressing)))
+
+	// while the upgrade is running, the Deployment and the Service are not updated
+	result = provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, blueGreenNginxProxy, objects)
+	g.Expect(result).To(Equal([]client.Object{configMap}))
+
+	// the staged Pods become ready and apply the configuration
+	staged.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1}
+	g.Expect(fakeClient.Status().Update(context.Background(), &staged)).To(Succeed())
+	g.Expect(deploymentStore.GetStaged(upgradeTestDeploymentNSName)).ToNot(BeNil())
+	deploymentStore.GetStaged(upgradeTestDeploymentNSName).SetPodErrorStatus("staged-pod", nil)
+
+	// the traffic is shifted to the staged Pods while the Deployment is updated
+	g.Eventually(func() map[string]string {
+		var svc corev1.Service
+		g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &svc)).To(Succeed())
+		return svc.Spec.Selector
+	}).Should(Equal(stagedPodSelector))
+
+	var deployment appsv1.Deployment
+	g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &deployment)).To(Succeed())
+	g.Expect(nginxImage(deployment.Spec.Template)).To(Equal("nginx:2"))
+
+	// the Pods of the Deployment apply the configuration
+	primary.SetPodErrorStatus("pod", nil)
+
+	g.Eventually(func() string {
+		if condition := provisioner.LatestDataPlaneUpgrade(gatewayNSName); condition != nil {
+			return condition.Reason
+		}
+		return ""
+	}).Should(Equal(string(conditions.GatewayReasonDataPlaneUpgraded)))
+
+	var svc corev1.Service
+	g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &svc)).To(Succeed())
+	g.Expect(svc.Spec.Selector).To(Equal(createUpgradeTestPodLabels()))
+
+	err := fakeClient.Get(context.Background(), upgradeTestStagedNSName, &appsv1.Deployment{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(deploymentStore.GetStaged(upgradeTestDeploymentNSName)).To(BeNil())
+
+	g.Expect(provisioner.LatestDataPlaneUpgrade(gatewayNSName)).To(Equal(
+		helpers.GetPointer(conditions.NewGatewayDataPlaneUpgraded("nginx:2")),
+	))
+}
+
+func TestStageImageUpgrade_Failed(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	slotLabels := createUpgradeTestPodLabels()
+	provisioner, fakeClient, deploymentStore := upgradeTestProvisioner(
+		createUpgradeTestDeployment("nginx:1", slotLabels),
+		createUpgradeTestService(),
+	)
+	provisioner.upgrades.timeout = 100 * time.Millisecond
+
+	gatewayNSName := client.ObjectKeyFromObject(upgradeTestGateway)
+	objects := []client.Object{
+		createUpgradeTestService(),
+		createUpgradeTestDeployment("nginx:2", slotLabels),
+	}
+
+	// the staged Pods never become ready
+	result := provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, blueGreenNginxProxy, objects)
+	g.Expect(result).To(BeEmpty())
+
+	g.Eventually(func() string {
+		if condition := provisioner.LatestDataPlaneUpgrade(gatewayNSName); condition != nil {
+			return condition.Reason
+		}
+		return ""
+	}).Should(Equal(string(conditions.GatewayReasonDataPlaneUpgradeFailed)))
+
+	condition := provisioner.LatestDataPlaneUpgrade(gatewayNSName)
+	g.Expect(condition.Message).To(ContainSubstring("staged Deployment default/gw-nginx-staged is not ready"))
+	g.Expect(condition.Message).To(ContainSubstring("0 of 1 Pods are updated and ready"))
+
+	var deployment appsv1.Deployment
+	g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &deployment)).To(Succeed())
+	g.Expect(nginxImage(deployment.Spec.Template)).To(Equal("nginx:1"))
+
+	var svc corev1.Service
+	g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &svc)).To(Succeed())
+	g.Expect(svc.Spec.Selector).To(HaveKeyWithValue(controller.DataPlaneSlotLabel, dataPlaneSlotPrimary))
+
+	err := fakeClient.Get(context.Background(), upgradeTestStagedNSName, &appsv1.Deployment{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(deploymentStore.GetStaged(upgradeTestDeploymentNSName)).To(BeNil())
+}
+
+func TestStageImageUpgrade_RolledBack(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	slotLabels := createUpgradeTestPodLabels()
+	provisioner, fakeClient, deploymentStore := upgradeTestProvisioner(
+		createUpgradeTestDeployment("nginx:1", slotLabels),
+		createUpgradeTestService(),
+	)
+	provisioner.upgrades.timeout = time.Second
+
+	primary := deploymentStore.GetOrStore(context.Background(), upgradeTestDeploymentNSName, nil)
+	gatewayNSName := client.ObjectKeyFromObject(upgradeTestGateway)
+	objects := []client.Object{
+		createUpgradeTestService(),
+		createUpgradeTestDeployment("nginx:2", slotLabels),
+	}
+
+	provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, blueGreenNginxProxy, objects)
+
+	var staged appsv1.Deployment
+	g.Eventually(func() error {
+		return fakeClient.Get(context.Background(), upgradeTestStagedNSName, &staged)
+	}).Should(Succeed())
+
+	staged.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1}
+	g.Expect(fakeClient.Status().Update(context.Background(), &staged)).To(Succeed())
+	deploymentStore.GetStaged(upgradeTestDeploymentNSName).SetPodErrorStatus("staged-pod", nil)
+
+	// the Pods of the Deployment never apply the configuration with the new image, so the Deployment is
+	// rolled back while the traffic is served by the staged Pods
+	g.Eventually(func() string {
+		if condition := provisioner.LatestDataPlaneUpgrade(gatewayNSName); condition != nil {
+			return condition.Message
+		}
+		return ""
+	}).WithTimeout(5 * time.Second).Should(ContainSubstring("is rolled back to image nginx:1"))
+
+	var svc corev1.Service
+	g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &svc)).To(Succeed())
+	g.Expect(svc.Spec.Selector).To(HaveKeyWithValue(controller.DataPlaneSlotLabel, dataPlaneSlotStaged))
+
+	var deployment appsv1.Deployment
+	g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &deployment)).To(Succeed())
+	g.Expect(nginxImage(deployment.Spec.Template)).To(Equal("nginx:1"))
+
+	// the rolled back Pods apply the configuration
+	primary.SetPodErrorStatus("pod", nil)
+
+	g.Eventually(func() string {
+		if condition := provisioner.LatestDataPlaneUpgrade(gatewayNSName); condition != nil {
+			return condition.Reason
+		}
+		return ""
+	}).WithTimeout(5 * time.Second).Should(Equal(string(conditions.GatewayReasonDataPlaneUpgradeFailed)))
+
+	condition := provisioner.LatestDataPlaneUpgrade(gatewayNSName)
+	g.Expect(condition.Message).To(ContainSubstring("deployment default/gw-nginx is not ready"))
+	g.Expect(condition.Message).ToNot(ContainSubstring("rolled back Deployment"))
+
+	g.Expect(fakeClient.Get(context.Background(), upgradeTestDeploymentNSName, &svc)).To(Succeed())
+	g.Expect(svc.Spec.Selector).To(Equal(createUpgradeTestPodLabels()))
+
+	err := fakeClient.Get(context.Background(), upgradeTestStagedNSName, &appsv1.Deployment{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestStageImageUpgrade_Deprovisioned(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	slotLabels := createUpgradeTestPodLabels()
+	provisioner, fakeClient, _ := upgradeTestProvisioner(
+		createUpgradeTestDeployment("nginx:1", slotLabels),
+		createUpgradeTestService(),
+	)
+
+	gatewayNSName := client.ObjectKeyFromObject(upgradeTestGateway)
+	objects := []client.Object{
+		createUpgradeTestService(),
+		createUpgradeTestDeployment("nginx:2", slotLabels),
+	}
+
+	provisioner.stageImageUpgrade(context.Background(), upgradeTestGateway, blueGreenNginxProxy, objects)
+
+	g.Eventually(func() error {
+		return fakeClient.Get(context.Background(), upgradeTestStagedNSName, &appsv1.Deployment{})
+	}).Should(Succeed())
+
+	// removing the nginx resources cancels the upgrade and removes the staged Deployment
+	g.Expect(provisioner.deprovisionNginx(context.Background(), gatewayNSName)).To(Succeed())
+
+	err := fakeClient.Get(context.Background(), upgradeTestStagedNSName, &appsv1.Deployment{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(provisioner.LatestDataPlaneUpgrade(gatewayNSName)).To(BeNil())
+}
+
+func TestBuildStagedDeployment(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	slotLabels := createUpgradeTestPodLabels()
+	deployment := createUpgradeTestDeployment("nginx:2", slotLabels)
+	deployment.ResourceVersion = "1"
+
+	staged := buildStagedDeployment(deployment, 3)
+
+	g.Expect(staged.Name).To(Equal("gw-nginx-staged"))
+	g.Expect(staged.Namespace).To(Equal("default"))
+	g.Expect(staged.ResourceVersion).To(BeEmpty())
+	g.Expect(staged.Labels).To(Equal(map[string]string{
+		"app":                         "nginx",
+		controller.GatewayLabel:       "gw",
+		controller.DataPlaneSlotLabel: "staged",
+	}))
+	g.Expect(staged.Spec.Replicas).To(Equal(helpers.GetPointer[int32](3)))
+	g.Expect(nginxImage(staged.Spec.Template)).To(Equal("nginx:2"))
+
+	// the staged Pods are not labeled with the Gateway, so that the selectors of the NGINX Pods don't select them
+	stagedPodSelector := map[string]string{"app": "nginx", controller.DataPlaneSlotLabel: "staged"}
+	g.Expect(staged.Spec.Selector.MatchLabels).To(Equal(stagedPodSelector))
+	g.Expect(staged.Spec.Template.Labels).To(Equal(stagedPodSelector))
+
+	primarySelector := labels.SelectorFromSet(deployment.Spec.Selector.MatchLabels)
+	g.Expect(primarySelector.Matches(labels.Set(staged.Spec.Template.Labels))).To(BeFalse())
+
+	// the Deployment is not modified
+	g.Expect(deployment.Name).To(Equal("gw-nginx"))
+	g.Expect(deployment.Labels).ToNot(HaveKey(controller.DataPlaneSlotLabel))
+	g.Expect(deployment.Spec.Selector.MatchLabels).ToNot(HaveKey(controller.DataPlaneSlotLabel))
+	g.Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue(controller.DataPlaneSlotLabel, "primary"))
+}
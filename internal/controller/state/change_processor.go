@@ -83,21 +83,24 @@ type ChangeProcessorImpl struct {
 // NewChangeProcessorImpl creates a new ChangeProcessorImpl for the Gateway resource with the configured namespace name.
 func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 	clusterStore := graph.ClusterState{
-		GatewayClasses:     make(map[types.NamespacedName]*v1.GatewayClass),
-		Gateways:           make(map[types.NamespacedName]*v1.Gateway),
-		HTTPRoutes:         make(map[types.NamespacedName]*v1.HTTPRoute),
-		Services:           make(map[types.NamespacedName]*apiv1.Service),
-		Namespaces:         make(map[types.NamespacedName]*apiv1.Namespace),
-		ReferenceGrants:    make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
-		Secrets:            make(map[types.NamespacedName]*apiv1.Secret),
-		CRDMetadata:        make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
-		BackendTLSPolicies: make(map[types.NamespacedName]*v1alpha3.BackendTLSPolicy),
-		ConfigMaps:         make(map[types.NamespacedName]*apiv1.ConfigMap),
-		NginxProxies:       make(map[types.NamespacedName]*ngfAPIv1alpha2.NginxProxy),
-		GRPCRoutes:         make(map[types.NamespacedName]*v1.GRPCRoute),
-		TLSRoutes:          make(map[types.NamespacedName]*v1alpha2.TLSRoute),
-		NGFPolicies:        make(map[graph.PolicyKey]policies.Policy),
-		SnippetsFilters:    make(map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter),
+		GatewayClasses:        make(map[types.NamespacedName]*v1.GatewayClass),
+		Gateways:              make(map[types.NamespacedName]*v1.Gateway),
+		HTTPRoutes:            make(map[types.NamespacedName]*v1.HTTPRoute),
+		Services:              make(map[types.NamespacedName]*apiv1.Service),
+		Namespaces:            make(map[types.NamespacedName]*apiv1.Namespace),
+		ReferenceGrants:       make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
+		Secrets:               make(map[types.NamespacedName]*apiv1.Secret),
+		CRDMetadata:           make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+		BackendTLSPolicies:    make(map[types.NamespacedName]*v1alpha3.BackendTLSPolicy),
+		ConfigMaps:            make(map[types.NamespacedName]*apiv1.ConfigMap),
+		NginxProxies:          make(map[types.NamespacedName]*ngfAPIv1alpha2.NginxProxy),
+		GRPCRoutes:            make(map[types.NamespacedName]*v1.GRPCRoute),
+		TLSRoutes:             make(map[types.NamespacedName]*v1alpha2.TLSRoute),
+		NGFPolicies:           make(map[graph.PolicyKey]policies.Policy),
+		SnippetsFilters:       make(map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter),
+		DirectResponseFilters: make(map[types.NamespacedName]*ngfAPIv1alpha1.DirectResponseFilter),
+		GRPCRewriteFilters:    make(map[types.NamespacedName]*ngfAPIv1alpha1.GRPCRewriteFilter),
+		GRPCWebFilters:        make(map[types.NamespacedName]*ngfAPIv1alpha1.GRPCWebFilter),
 	}
 
 	processor := &ChangeProcessorImpl{
@@ -196,16 +199,35 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     commonPolicyObjectStore,
 				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ErrorPagePolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha2.ObservabilityPolicy{}),
 				store:     commonPolicyObjectStore,
 				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ProxySettingsPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			}, {
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.TrafficSplitPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.UpstreamSettingsPolicy{}),
 				store:     commonPolicyObjectStore,
 				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.WebSocketPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&v1alpha2.TLSRoute{}),
 				store:     newObjectStoreMapAdapter(clusterStore.TLSRoutes),
@@ -216,6 +238,24 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     newObjectStoreMapAdapter(clusterStore.SnippetsFilters),
 				predicate: nil, // we always want to write status to SnippetsFilters so we don't filter them out
 			},
+			{
+				gvk:   cfg.MustExtractGVK(&ngfAPIv1alpha1.DirectResponseFilter{}),
+				store: newObjectStoreMapAdapter(clusterStore.DirectResponseFilters),
+				// we always want to write status to DirectResponseFilters so we don't filter them out
+				predicate: nil,
+			},
+			{
+				gvk:   cfg.MustExtractGVK(&ngfAPIv1alpha1.GRPCRewriteFilter{}),
+				store: newObjectStoreMapAdapter(clusterStore.GRPCRewriteFilters),
+				// we always want to write status to GRPCRewriteFilters so we don't filter them out
+				predicate: nil,
+			},
+			{
+				gvk:   cfg.MustExtractGVK(&ngfAPIv1alpha1.GRPCWebFilter{}),
+				store: newObjectStoreMapAdapter(clusterStore.GRPCWebFilters),
+				// we always want to write status to GRPCWebFilters so we don't filter them out
+				predicate: nil,
+			},
 		},
 	)
 
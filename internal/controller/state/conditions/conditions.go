@@ -61,6 +61,14 @@ const (
 	// invalid. Used with ResolvedRefs (false).
 	RouteReasonInvalidFilter v1.RouteConditionReason = "InvalidFilter"
 
+	// RouteMirrorPercentRounded condition indicates that the percentage of requests to mirror in a RequestMirror
+	// filter of the Route cannot be represented exactly by NGINX and has been rounded.
+	RouteMirrorPercentRounded v1.RouteConditionType = "MirrorPercentRounded"
+
+	// RouteReasonMirrorPercentRounded is used with the "RouteMirrorPercentRounded" condition when the condition
+	// is true.
+	RouteReasonMirrorPercentRounded v1.RouteConditionReason = "MirrorPercentRounded"
+
 	// GatewayReasonUnsupportedValue is used with GatewayConditionAccepted (false) when a value of a field in a Gateway
 	// is invalid or not supported.
 	GatewayReasonUnsupportedValue v1.GatewayConditionReason = "UnsupportedValue"
@@ -121,6 +129,30 @@ const (
 	// GatewayReasonParamsRefInvalid is used with the "GatewayResolvedRefs" condition when the
 	// parametersRef resource is invalid.
 	GatewayReasonParamsRefInvalid v1.GatewayConditionReason = "ParametersRefInvalid"
+
+	// GatewayReasonSessionTicketKeysInvalid is used with the "GatewayResolvedRefs" condition when the
+	// Secret with the TLS session ticket keys, configured in the NginxProxy, is invalid or does not exist.
+	GatewayReasonSessionTicketKeysInvalid v1.GatewayConditionReason = "SessionTicketKeysInvalid"
+
+	// GatewayReasonAgentTokenInvalid is used with the "GatewayResolvedRefs" condition when the
+	// Secret with the token of a static data plane, configured in the NginxProxy, is invalid or does not exist.
+	GatewayReasonAgentTokenInvalid v1.GatewayConditionReason = "AgentTokenInvalid"
+
+	// GatewayDataPlaneUpgraded condition indicates whether the blue/green upgrade of the NGINX data plane
+	// to a new image succeeded.
+	GatewayDataPlaneUpgraded v1.GatewayConditionType = "DataPlaneUpgraded"
+
+	// GatewayReasonDataPlaneUpgraded is used with the "GatewayDataPlaneUpgraded" condition when the condition
+	// is true.
+	GatewayReasonDataPlaneUpgraded v1.GatewayConditionReason = "Upgraded"
+
+	// GatewayReasonDataPlaneUpgradeProgressing is used with the "GatewayDataPlaneUpgraded" condition while the
+	// upgrade is in progress.
+	GatewayReasonDataPlaneUpgradeProgressing v1.GatewayConditionReason = "Progressing"
+
+	// GatewayReasonDataPlaneUpgradeFailed is used with the "GatewayDataPlaneUpgraded" condition when the
+	// upgrade failed and the data plane keeps running the previous image.
+	GatewayReasonDataPlaneUpgradeFailed v1.GatewayConditionReason = "Failed"
 )
 
 // Condition defines a condition to be reported in the status of resources.
@@ -464,6 +496,17 @@ func NewRouteResolvedRefsInvalidFilter(msg string) Condition {
 	}
 }
 
+// NewRouteMirrorPercentRounded returns a Condition that indicates that the percentage of requests to mirror
+// in a RequestMirror filter of the Route has been rounded.
+func NewRouteMirrorPercentRounded(msg string) Condition {
+	return Condition{
+		Type:    string(RouteMirrorPercentRounded),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonMirrorPercentRounded),
+		Message: msg,
+	}
+}
+
 // NewDefaultListenerConditions returns the default Conditions that must be present in the status of a Listener.
 func NewDefaultListenerConditions() []Condition {
 	return []Condition{
@@ -842,6 +885,72 @@ func NewGatewayRefInvalid(msg string) Condition {
 	}
 }
 
+// NewGatewaySessionTicketKeysInvalid returns a Condition that indicates that the Secret with the TLS session
+// ticket keys could not be resolved. NGINX generates its own keys instead.
+func NewGatewaySessionTicketKeysInvalid(msg string) Condition {
+	return Condition{
+		Type:    string(GatewayResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonSessionTicketKeysInvalid),
+		Message: fmt.Sprintf("Session ticket keys are ignored due to an error: %s", msg),
+	}
+}
+
+// NewGatewayAgentTokenInvalid returns a Condition that indicates that the Secret with the token of a static
+// data plane could not be resolved. The NGINX agents of the Gateway cannot connect to the control plane.
+func NewGatewayAgentTokenInvalid(msg string) Condition {
+	return Condition{
+		Type:    string(GatewayResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonAgentTokenInvalid),
+		Message: fmt.Sprintf("NGINX agents cannot connect due to an error: %s", msg),
+	}
+}
+
+// NewGatewayDataPlaneQuotaExceeded returns a Condition that indicates that the Gateway is not programmed,
+// because its data plane exceeds the quota of its namespace.
+func NewGatewayDataPlaneQuotaExceeded(msg string) Condition {
+	return Condition{
+		Type:    string(v1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1.GatewayReasonNoResources),
+		Message: fmt.Sprintf("The NGINX data plane is not created or updated: %s", msg),
+	}
+}
+
+// NewGatewayDataPlaneUpgraded returns a Condition that indicates that the NGINX data plane was upgraded to
+// the image.
+func NewGatewayDataPlaneUpgraded(image string) Condition {
+	return Condition{
+		Type:    string(GatewayDataPlaneUpgraded),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonDataPlaneUpgraded),
+		Message: fmt.Sprintf("The NGINX data plane is upgraded to image %s", image),
+	}
+}
+
+// NewGatewayDataPlaneUpgradeProgressing returns a Condition that indicates that the upgrade of the NGINX
+// data plane is in progress.
+func NewGatewayDataPlaneUpgradeProgressing(msg string) Condition {
+	return Condition{
+		Type:    string(GatewayDataPlaneUpgraded),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonDataPlaneUpgradeProgressing),
+		Message: msg,
+	}
+}
+
+// NewGatewayDataPlaneUpgradeFailed returns a Condition that indicates that the upgrade of the NGINX
+// data plane failed.
+func NewGatewayDataPlaneUpgradeFailed(msg string) Condition {
+	return Condition{
+		Type:    string(GatewayDataPlaneUpgraded),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonDataPlaneUpgradeFailed),
+		Message: fmt.Sprintf("The NGINX data plane is not upgraded and keeps its previous image: %s", msg),
+	}
+}
+
 // NewGatewayInvalidParameters returns a Condition that indicates that the Gateway has invalid parameters.
 // We are allowing Accepted to still be true to prevent nullifying the entire Gateway config if a parametersRef
 // is updated to something invalid.
@@ -940,3 +1049,69 @@ func NewSnippetsFilterAccepted() Condition {
 		Message: "SnippetsFilter is accepted",
 	}
 }
+
+// NewDirectResponseFilterInvalid returns a Condition that indicates that the DirectResponseFilter is not accepted
+// because it is syntactically or semantically invalid, or its body cannot be resolved.
+func NewDirectResponseFilterInvalid(msg string) Condition {
+	return Condition{
+		Type:    string(ngfAPI.DirectResponseFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.DirectResponseFilterConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewDirectResponseFilterAccepted returns a Condition that indicates that the DirectResponseFilter is accepted
+// because it is valid.
+func NewDirectResponseFilterAccepted() Condition {
+	return Condition{
+		Type:    string(ngfAPI.DirectResponseFilterConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.DirectResponseFilterConditionReasonAccepted),
+		Message: "DirectResponseFilter is accepted",
+	}
+}
+
+// NewGRPCRewriteFilterInvalid returns a Condition that indicates that the GRPCRewriteFilter is not accepted
+// because it is syntactically or semantically invalid.
+func NewGRPCRewriteFilterInvalid(msg string) Condition {
+	return Condition{
+		Type:    string(ngfAPI.GRPCRewriteFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.GRPCRewriteFilterConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewGRPCRewriteFilterAccepted returns a Condition that indicates that the GRPCRewriteFilter is accepted
+// because it is valid.
+func NewGRPCRewriteFilterAccepted() Condition {
+	return Condition{
+		Type:    string(ngfAPI.GRPCRewriteFilterConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.GRPCRewriteFilterConditionReasonAccepted),
+		Message: "GRPCRewriteFilter is accepted",
+	}
+}
+
+// NewGRPCWebFilterInvalid returns a Condition that indicates that the GRPCWebFilter is not accepted
+// because it is syntactically or semantically invalid.
+func NewGRPCWebFilterInvalid(msg string) Condition {
+	return Condition{
+		Type:    string(ngfAPI.GRPCWebFilterConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.GRPCWebFilterConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewGRPCWebFilterAccepted returns a Condition that indicates that the GRPCWebFilter is accepted
+// because it is valid.
+func NewGRPCWebFilterAccepted() Condition {
+	return Condition{
+		Type:    string(ngfAPI.GRPCWebFilterConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.GRPCWebFilterConditionReasonAccepted),
+		Message: "GRPCWebFilter is accepted",
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"maps"
+	"net/netip"
 	"slices"
 	"sort"
+	"strings"
 
 	discoveryV1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,12 +22,18 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/resolver"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 )
 
 const (
 	wildcardHostname     = "~^"
 	alpineSSLRootCAPath  = "/etc/ssl/cert.pem"
 	defaultErrorLogLevel = "info"
+
+	defaultErrorPageContentType  = "text/html"
+	defaultErrorPageRedirectCode = 302
+
+	defaultAltSvcMaxAge int32 = 86400
 )
 
 // BuildConfiguration builds the Configuration from the Graph.
@@ -46,13 +55,14 @@ func BuildConfiguration(
 
 	baseHTTPConfig := buildBaseHTTPConfig(g, gateway)
 
-	httpServers, sslServers := buildServers(gateway)
+	httpServers, sslServers := buildServers(gateway, g.ErrorPageResources)
 	backendGroups := buildBackendGroups(append(httpServers, sslServers...))
 	upstreams := buildUpstreams(
 		ctx,
 		gateway,
 		serviceResolver,
 		g.ReferencedServices,
+		buildErrorPageBackendRefs(gateway, g.ErrorPageResources),
 		baseHTTPConfig.IPFamily,
 	)
 
@@ -84,6 +94,96 @@ func BuildConfiguration(
 	return config
 }
 
+// MergeConfigurations merges the Configurations of Gateways that are served by the same data plane into
+// the Configuration of the Gateway that owns the data plane. The servers, upstreams, backend groups, and
+// certificates of the merged Configurations are added to the owner's Configuration, skipping the ones that the
+// owner's Configuration already has. Every other setting is taken from the owner's Configuration.
+// Apart from the default servers, the servers never collide: the listeners that would generate a colliding server
+// are invalidated and reported as conflicted when the Gateways are merged in the graph.
+func MergeConfigurations(owner Configuration, merged ...Configuration) Configuration {
+	if len(merged) == 0 {
+		return owner
+	}
+
+	type serverKey struct {
+		hostname  string
+		port      int32
+		isDefault bool
+	}
+
+	virtualServerKey := func(s VirtualServer) serverKey {
+		return serverKey{hostname: s.Hostname, port: s.Port, isDefault: s.IsDefault}
+	}
+
+	layer4ServerKey := func(s Layer4VirtualServer) serverKey {
+		return serverKey{hostname: s.Hostname, port: s.Port, isDefault: s.IsDefault}
+	}
+
+	upstreamName := func(u Upstream) string { return u.Name }
+	backendGroupName := func(bg BackendGroup) string { return bg.Name() }
+
+	result := owner
+	result.SSLKeyPairs = maps.Clone(owner.SSLKeyPairs)
+	result.CertBundles = maps.Clone(owner.CertBundles)
+
+	for _, cfg := range merged {
+		result.HTTPServers = appendUnique(result.HTTPServers, cfg.HTTPServers, virtualServerKey)
+		result.SSLServers = appendUnique(result.SSLServers, cfg.SSLServers, virtualServerKey)
+		result.TLSPassthroughServers = appendUnique(
+			result.TLSPassthroughServers,
+			cfg.TLSPassthroughServers,
+			layer4ServerKey,
+		)
+		result.Upstreams = appendUnique(result.Upstreams, cfg.Upstreams, upstreamName)
+		result.StreamUpstreams = appendUnique(result.StreamUpstreams, cfg.StreamUpstreams, upstreamName)
+		result.BackendGroups = appendUnique(result.BackendGroups, cfg.BackendGroups, backendGroupName)
+
+		if len(cfg.SSLKeyPairs) > 0 && result.SSLKeyPairs == nil {
+			result.SSLKeyPairs = make(map[SSLKeyPairID]SSLKeyPair, len(cfg.SSLKeyPairs))
+		}
+		for id, pair := range cfg.SSLKeyPairs {
+			if _, exists := result.SSLKeyPairs[id]; !exists {
+				result.SSLKeyPairs[id] = pair
+			}
+		}
+
+		if len(cfg.CertBundles) > 0 && result.CertBundles == nil {
+			result.CertBundles = make(map[CertBundleID]CertBundle, len(cfg.CertBundles))
+		}
+		for id, bundle := range cfg.CertBundles {
+			if _, exists := result.CertBundles[id]; !exists {
+				result.CertBundles[id] = bundle
+			}
+		}
+	}
+
+	return result
+}
+
+// appendUnique returns a new slice with the elements of dst followed by the elements of src whose key is not
+// already present.
+func appendUnique[T any, K comparable](dst, src []T, key func(T) K) []T {
+	seen := make(map[K]struct{}, len(dst)+len(src))
+	result := make([]T, 0, len(dst)+len(src))
+
+	for _, elem := range dst {
+		seen[key(elem)] = struct{}{}
+		result = append(result, elem)
+	}
+
+	for _, elem := range src {
+		k := key(elem)
+		if _, exists := seen[k]; exists {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		result = append(result, elem)
+	}
+
+	return result
+}
+
 // buildPassthroughServers builds TLSPassthroughServers from TLSRoutes attaches to listeners.
 func buildPassthroughServers(gateway *graph.Gateway) []Layer4VirtualServer {
 	passthroughServersMap := make(map[graph.L4RouteKey][]Layer4VirtualServer)
@@ -290,7 +390,7 @@ func buildCertBundles(
 	}
 
 	for _, bundle := range refCertBundles {
-		id := generateCertBundleID(bundle.Name)
+		id := generateCertBundleID(bundle.Kind, bundle.Name)
 		if _, exists := refByBG[id]; exists {
 			// the cert could be base64 encoded or plaintext
 			data := make([]byte, base64.StdEncoding.DecodedLen(len(bundle.Cert.CACert)))
@@ -348,6 +448,7 @@ func newBackendGroup(
 	gatewayName types.NamespacedName,
 	sourceNsName types.NamespacedName,
 	ruleIdx int,
+	splitKey *SplitKey,
 ) BackendGroup {
 	var backends []Backend
 
@@ -377,6 +478,7 @@ func newBackendGroup(
 		Backends: backends,
 		Source:   sourceNsName,
 		RuleIdx:  ruleIdx,
+		SplitKey: splitKey,
 	}
 }
 
@@ -386,7 +488,7 @@ func convertBackendTLS(btp *graph.BackendTLSPolicy) *VerifyTLS {
 	}
 	verify := &VerifyTLS{}
 	if btp.CaCertRef.Name != "" {
-		verify.CertBundleID = generateCertBundleID(btp.CaCertRef)
+		verify.CertBundleID = generateCertBundleID(btp.CaCertKind, btp.CaCertRef)
 	} else {
 		verify.RootCAPath = alpineSSLRootCAPath
 	}
@@ -394,12 +496,17 @@ func convertBackendTLS(btp *graph.BackendTLSPolicy) *VerifyTLS {
 	return verify
 }
 
-func buildServers(gateway *graph.Gateway) (http, ssl []VirtualServer) {
+func buildServers(
+	gateway *graph.Gateway,
+	errorPageResources map[types.NamespacedName]*graph.ErrorPageResources,
+) (http, ssl []VirtualServer) {
 	rulesForProtocol := map[v1.ProtocolType]portPathRules{
 		v1.HTTPProtocolType:  make(portPathRules),
 		v1.HTTPSProtocolType: make(portPathRules),
 	}
 
+	serverErrorPages := buildErrorPages(gateway, gateway.Policies, errorPageResources)
+
 	for _, l := range gateway.Listeners {
 		if l.Source.Protocol == v1.TLSProtocolType {
 			continue
@@ -407,7 +514,7 @@ func buildServers(gateway *graph.Gateway) (http, ssl []VirtualServer) {
 		if l.Valid {
 			rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
 			if rules == nil {
-				rules = newHostPathRules()
+				rules = newHostPathRules(serverErrorPages, errorPageResources)
 				rulesForProtocol[l.Source.Protocol][l.Source.Port] = rules
 			}
 
@@ -424,15 +531,58 @@ func buildServers(gateway *graph.Gateway) (http, ssl []VirtualServer) {
 
 	for i := range httpServers {
 		httpServers[i].Policies = pols
+		httpServers[i].ErrorPages = serverErrorPages
 	}
 
 	for i := range sslServers {
 		sslServers[i].Policies = pols
+		sslServers[i].ErrorPages = serverErrorPages
 	}
 
 	return httpServers, sslServers
 }
 
+// buildSSL builds the SSL configuration of a server for an HTTPS listener with a resolved Secret.
+// The TLS settings of the NginxProxy are overridden by the TLS options of the listener.
+func buildSSL(l *graph.Listener, np *graph.EffectiveNginxProxy) *SSL {
+	ssl := &SSL{
+		KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
+	}
+
+	if np != nil && np.TLS != nil {
+		for _, protocol := range np.TLS.Protocols {
+			ssl.Protocols = append(ssl.Protocols, string(protocol))
+		}
+
+		if np.TLS.Ciphers != nil {
+			ssl.Ciphers = *np.TLS.Ciphers
+		}
+
+		ssl.PreferServerCiphers = np.TLS.PreferServerCiphers
+	}
+
+	if l.Source.TLS == nil {
+		return ssl
+	}
+
+	// the options are validated when the listener is built, so we don't need to validate them here.
+	options := l.Source.TLS.Options
+
+	if protocols, ok := options[ngfAPIv1alpha2.TLSOptionSSLProtocols]; ok {
+		ssl.Protocols = strings.Fields(string(protocols))
+	}
+
+	if ciphers, ok := options[ngfAPIv1alpha2.TLSOptionSSLCiphers]; ok {
+		ssl.Ciphers = string(ciphers)
+	}
+
+	if prefer, ok := options[ngfAPIv1alpha2.TLSOptionSSLPreferServerCiphers]; ok {
+		ssl.PreferServerCiphers = helpers.GetPointer(prefer == "true")
+	}
+
+	return ssl
+}
+
 // portPathRules keeps track of hostPathRules per port.
 type portPathRules map[v1.PortNumber]*hostPathRules
 
@@ -457,24 +607,33 @@ type pathAndType struct {
 }
 
 type hostPathRules struct {
-	rulesPerHost     map[string]map[pathAndType]PathRule
-	listenersForHost map[string]*graph.Listener
-	httpsListeners   []*graph.Listener
-	port             int32
-	listenersExist   bool
+	rulesPerHost       map[string]map[pathAndType]PathRule
+	listenersForHost   map[string]*graph.Listener
+	serverErrorPages   *ErrorPages
+	errorPageResources map[types.NamespacedName]*graph.ErrorPageResources
+	httpsListeners     []*graph.Listener
+	nginxProxy         *graph.EffectiveNginxProxy
+	port               int32
+	listenersExist     bool
 }
 
-func newHostPathRules() *hostPathRules {
+func newHostPathRules(
+	serverErrorPages *ErrorPages,
+	errorPageResources map[types.NamespacedName]*graph.ErrorPageResources,
+) *hostPathRules {
 	return &hostPathRules{
-		rulesPerHost:     make(map[string]map[pathAndType]PathRule),
-		listenersForHost: make(map[string]*graph.Listener),
-		httpsListeners:   make([]*graph.Listener, 0),
+		rulesPerHost:       make(map[string]map[pathAndType]PathRule),
+		listenersForHost:   make(map[string]*graph.Listener),
+		serverErrorPages:   serverErrorPages,
+		errorPageResources: errorPageResources,
+		httpsListeners:     make([]*graph.Listener, 0),
 	}
 }
 
 func (hpr *hostPathRules) upsertListener(l *graph.Listener, gateway *graph.Gateway) {
 	hpr.listenersExist = true
 	hpr.port = int32(l.Source.Port)
+	hpr.nginxProxy = gateway.EffectiveNginxProxy
 
 	if l.Source.Protocol == v1.HTTPSProtocolType {
 		hpr.httpsListeners = append(hpr.httpsListeners, l)
@@ -544,6 +703,12 @@ func (hpr *hostPathRules) upsertRoute(
 		}
 
 		pols := buildPolicies(gateway, route.Policies)
+		splitKey := buildSplitKey(gateway, pols)
+		upgrade := buildUpgrade(pols)
+		errorPages := mergeErrorPages(
+			buildErrorPages(gateway, route.Policies, hpr.errorPageResources),
+			hpr.serverErrorPages,
+		)
 
 		for _, h := range hostnames {
 			for _, m := range rule.Matches {
@@ -564,12 +729,14 @@ func (hpr *hostPathRules) upsertRoute(
 
 				hostRule.GRPC = GRPC
 				hostRule.Policies = append(hostRule.Policies, pols...)
+				hostRule.ErrorPages = combineErrorPages(hostRule.ErrorPages, errorPages)
 
 				hostRule.MatchRules = append(hostRule.MatchRules, MatchRule{
 					Source:       objectSrc,
-					BackendGroup: newBackendGroup(rule.BackendRefs, listener.GatewayName, routeNsName, idx),
+					BackendGroup: newBackendGroup(rule.BackendRefs, listener.GatewayName, routeNsName, idx, splitKey),
 					Filters:      filters,
 					Match:        convertMatch(m),
+					Upgrade:      upgrade,
 				})
 
 				hpr.rulesPerHost[h][key] = hostRule
@@ -594,9 +761,7 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 		}
 
 		if l.ResolvedSecret != nil {
-			s.SSL = &SSL{
-				KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
-			}
+			s.SSL = buildSSL(l, hpr.nginxProxy)
 		}
 
 		for _, r := range rules {
@@ -605,14 +770,7 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			s.PathRules = append(s.PathRules, r)
 		}
 
-		// We sort the path rules so the order is preserved after reconfiguration.
-		sort.Slice(s.PathRules, func(i, j int) bool {
-			if s.PathRules[i].Path != s.PathRules[j].Path {
-				return s.PathRules[i].Path < s.PathRules[j].Path
-			}
-
-			return s.PathRules[i].PathType < s.PathRules[j].PathType
-		})
+		sortPathRules(s.PathRules)
 
 		servers = append(servers, s)
 	}
@@ -628,9 +786,7 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			}
 
 			if l.ResolvedSecret != nil {
-				s.SSL = &SSL{
-					KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
-				}
+				s.SSL = buildSSL(l, hpr.nginxProxy)
 			}
 
 			servers = append(servers, s)
@@ -667,6 +823,7 @@ func buildUpstreams(
 	gateway *graph.Gateway,
 	svcResolver resolver.ServiceResolver,
 	referencedServices map[types.NamespacedName]*graph.ReferencedService,
+	errorPageBackendRefs []graph.BackendRef,
 	ipFamily IPFamilyType,
 ) []Upstream {
 	// There can be duplicate upstreams if multiple routes reference the same upstream.
@@ -709,6 +866,20 @@ func buildUpstreams(
 		}
 	}
 
+	for _, br := range errorPageBackendRefs {
+		if upstream := buildUpstream(
+			ctx,
+			br,
+			gateway,
+			svcResolver,
+			referencedServices,
+			uniqueUpstreams,
+			allowedAddressType,
+		); upstream != nil {
+			uniqueUpstreams[upstream.Name] = *upstream
+		}
+	}
+
 	if len(uniqueUpstreams) == 0 {
 		return nil
 	}
@@ -831,12 +1002,31 @@ func createHTTPFilters(filters []graph.Filter, ruleIdx int) HTTPFilters {
 				result.ResponseHeaderModifiers = convertHTTPHeaderFilter(f.ResponseHeaderModifier)
 			}
 		case graph.FilterExtensionRef:
-			if f.ResolvedExtensionRef != nil && f.ResolvedExtensionRef.SnippetsFilter != nil {
+			if f.ResolvedExtensionRef == nil {
+				continue
+			}
+
+			if f.ResolvedExtensionRef.SnippetsFilter != nil {
 				result.SnippetsFilters = append(
 					result.SnippetsFilters,
 					convertSnippetsFilter(f.ResolvedExtensionRef.SnippetsFilter),
 				)
 			}
+
+			if f.ResolvedExtensionRef.DirectResponseFilter != nil && result.DirectResponse == nil {
+				// using the first filter
+				result.DirectResponse = convertDirectResponseFilter(f.ResolvedExtensionRef.DirectResponseFilter)
+			}
+
+			if f.ResolvedExtensionRef.GRPCRewriteFilter != nil && result.GRPCRewrite == nil {
+				// using the first filter
+				result.GRPCRewrite = convertGRPCRewriteFilter(f.ResolvedExtensionRef.GRPCRewriteFilter)
+			}
+
+			if f.ResolvedExtensionRef.GRPCWebFilter != nil && result.GRPCWeb == nil {
+				// using the first filter
+				result.GRPCWeb = convertGRPCWebFilter(f.ResolvedExtensionRef.GRPCWebFilter)
+			}
 		}
 	}
 
@@ -865,9 +1055,14 @@ func generateSSLKeyPairID(secret types.NamespacedName) SSLKeyPairID {
 }
 
 // generateCertBundleID generates an ID for the certificate bundle based on the ConfigMap/Secret namespaced name.
-// It is guaranteed to be unique per unique namespaced name.
+// It is guaranteed to be unique per unique kind and namespaced name, so that a ConfigMap and a Secret
+// with the same name don't overwrite each other's bundle.
 // The ID is safe to use as a file name.
-func generateCertBundleID(caCertRef types.NamespacedName) CertBundleID {
+func generateCertBundleID(kind v1.Kind, caCertRef types.NamespacedName) CertBundleID {
+	if kind == kinds.Secret {
+		return CertBundleID(fmt.Sprintf("cert_bundle_secret_%s_%s", caCertRef.Namespace, caCertRef.Name))
+	}
+
 	return CertBundleID(fmt.Sprintf("cert_bundle_%s_%s", caCertRef.Namespace, caCertRef.Name))
 }
 
@@ -981,6 +1176,25 @@ func buildBaseHTTPConfig(g *graph.Graph, gateway *graph.Gateway) BaseHTTPConfig
 		baseConfig.HTTP2 = false
 	}
 
+	if graph.HTTP3EnabledForNginxProxy(np) {
+		baseConfig.HTTP3Settings = HTTP3Settings{
+			Enabled:      true,
+			AltSvcMaxAge: defaultAltSvcMaxAge,
+		}
+
+		if np.HTTP3.AltSvcMaxAge != nil {
+			baseConfig.HTTP3Settings.AltSvcMaxAge = *np.HTTP3.AltSvcMaxAge
+		}
+	}
+
+	if np.TLS != nil {
+		baseConfig.TLSSettings = buildTLSSettings(np.TLS, gateway.SessionTicketKeys, g.ReferencedSecrets)
+	}
+
+	if np.HTTPMatchMode != nil && *np.HTTPMatchMode == ngfAPIv1alpha2.HTTPMatchModeNative {
+		baseConfig.NativeHTTPMatching = true
+	}
+
 	if np.IPFamily != nil {
 		switch *np.IPFamily {
 		case ngfAPIv1alpha2.IPv4:
@@ -1014,6 +1228,103 @@ func buildBaseHTTPConfig(g *graph.Graph, gateway *graph.Gateway) BaseHTTPConfig
 	return baseConfig
 }
 
+// defaultSessionCacheSize is the size of the shared TLS session cache if the NginxProxy doesn't set one.
+const defaultSessionCacheSize = "10m"
+
+func buildTLSSettings(
+	tls *ngfAPIv1alpha2.NginxTLS,
+	sessionTicketKeys *types.NamespacedName,
+	secrets map[types.NamespacedName]*graph.Secret,
+) TLSSettings {
+	var settings TLSSettings
+
+	if cache := tls.SessionCache; cache != nil {
+		if cache.Disable != nil && *cache.Disable {
+			settings.SessionCache = "off"
+		} else {
+			size := defaultSessionCacheSize
+			if cache.Size != nil {
+				size = string(*cache.Size)
+			}
+			settings.SessionCache = "shared:SSL:" + size
+		}
+
+		if cache.Timeout != nil {
+			settings.SessionTimeout = string(*cache.Timeout)
+		}
+	}
+
+	if tickets := tls.SessionTickets; tickets != nil {
+		settings.SessionTicketsDisabled = tickets.Disable != nil && *tickets.Disable
+	}
+
+	if !settings.SessionTicketsDisabled && sessionTicketKeys != nil {
+		settings.SessionTicketKeys = buildSessionTicketKeys(*sessionTicketKeys, secrets)
+	}
+
+	if stapling := tls.OCSPStapling; stapling != nil && stapling.Enable != nil && *stapling.Enable {
+		settings.OCSPStapling = true
+
+		if stapling.Resolver != nil {
+			settings.OCSPResolver = buildDNSResolver(stapling.Resolver)
+		}
+	}
+
+	return settings
+}
+
+// buildSessionTicketKeys builds the session ticket keys from the Secret, which is validated by the graph.
+// The last key in the lexicographical order of the key names is the first one, because NGINX encrypts session
+// tickets with the first key.
+func buildSessionTicketKeys(
+	nsname types.NamespacedName,
+	secrets map[types.NamespacedName]*graph.Secret,
+) []SessionTicketKey {
+	secret, exists := secrets[nsname]
+	if !exists || secret.Source == nil {
+		return nil
+	}
+
+	names := slices.Sorted(maps.Keys(secret.Source.Data))
+	slices.Reverse(names)
+
+	keys := make([]SessionTicketKey, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, SessionTicketKey{
+			ID: SessionTicketKeyID(
+				fmt.Sprintf("session_ticket_key_%s_%s_%s", nsname.Namespace, nsname.Name, name),
+			),
+			Data: secret.Source.Data[name],
+		})
+	}
+
+	return keys
+}
+
+func buildDNSResolver(resolver *ngfAPIv1alpha2.DNSResolver) *DNSResolver {
+	dnsResolver := &DNSResolver{
+		Addresses: make([]string, 0, len(resolver.Addresses)),
+	}
+
+	for _, addr := range resolver.Addresses {
+		// NGINX requires IPv6 addresses to be enclosed in square brackets.
+		if ip, err := netip.ParseAddr(addr); err == nil && ip.Is6() {
+			addr = "[" + addr + "]"
+		}
+		dnsResolver.Addresses = append(dnsResolver.Addresses, addr)
+	}
+
+	if resolver.Valid != nil {
+		dnsResolver.Valid = string(*resolver.Valid)
+	}
+
+	if resolver.Timeout != nil {
+		dnsResolver.Timeout = string(*resolver.Timeout)
+	}
+
+	return dnsResolver
+}
+
 func createSnippetName(nc ngfAPIv1alpha1.NginxContext, nsname types.NamespacedName) string {
 	return fmt.Sprintf(
 		"SnippetsFilter_%s_%s_%s",
@@ -1074,6 +1385,282 @@ func buildPolicies(gateway *graph.Gateway, graphPolicies []*graph.Policy) []poli
 	return finalPolicies
 }
 
+// buildSplitKey returns the SplitKey for the BackendGroups of a Route. The split key of a TrafficSplitPolicy
+// attached to the Route takes precedence over the split key set in the NginxProxy of the Gateway.
+// It returns nil if neither is set.
+func buildSplitKey(gateway *graph.Gateway, routePolicies []policies.Policy) *SplitKey {
+	for _, pol := range routePolicies {
+		if tsp, ok := pol.(*ngfAPIv1alpha1.TrafficSplitPolicy); ok {
+			return convertSplitKey(tsp.Spec.SplitKey)
+		}
+	}
+
+	if gateway == nil || gateway.EffectiveNginxProxy == nil || gateway.EffectiveNginxProxy.SplitKey == nil {
+		return nil
+	}
+
+	return convertSplitKey(*gateway.EffectiveNginxProxy.SplitKey)
+}
+
+func convertSplitKey(key ngfAPIv1alpha1.SplitKey) *SplitKey {
+	splitKey := &SplitKey{Type: SplitKeyType(key.Type)}
+	if key.Name != nil {
+		splitKey.Name = *key.Name
+	}
+
+	return splitKey
+}
+
+// buildUpgrade returns whether connection upgrades are enabled by a WebSocketPolicy attached to a Route.
+// It returns nil if no such policy sets the upgrade handling.
+func buildUpgrade(routePolicies []policies.Policy) *bool {
+	for _, pol := range routePolicies {
+		if wsp, ok := pol.(*ngfAPIv1alpha1.WebSocketPolicy); ok && wsp.Spec.Upgrade != nil {
+			return wsp.Spec.Upgrade
+		}
+	}
+
+	return nil
+}
+
+// buildErrorPages builds the ErrorPages from the valid ErrorPagePolicies in the provided policies.
+// It returns nil if there are no such policies.
+func buildErrorPages(
+	gateway *graph.Gateway,
+	graphPolicies []*graph.Policy,
+	resources map[types.NamespacedName]*graph.ErrorPageResources,
+) *ErrorPages {
+	var errorPages *ErrorPages
+
+	for _, pol := range buildPolicies(gateway, graphPolicies) {
+		epp, ok := pol.(*ngfAPIv1alpha1.ErrorPagePolicy)
+		if !ok {
+			continue
+		}
+
+		nsname := client.ObjectKeyFromObject(epp)
+
+		res, exists := resources[nsname]
+		if !exists {
+			continue
+		}
+
+		if errorPages == nil {
+			errorPages = &ErrorPages{}
+		}
+
+		if epp.Spec.InterceptUpstreamErrors != nil {
+			errorPages.InterceptUpstreamErrors = epp.Spec.InterceptUpstreamErrors
+		}
+
+		for idx, page := range epp.Spec.ErrorPages {
+			errorPages.Pages = append(errorPages.Pages, convertErrorPage(nsname, idx, page, res))
+		}
+	}
+
+	if errorPages != nil {
+		// Preserve order so that this doesn't trigger an unnecessary reload.
+		sort.Slice(errorPages.Pages, func(i, j int) bool {
+			return errorPages.Pages[i].Name < errorPages.Pages[j].Name
+		})
+	}
+
+	return errorPages
+}
+
+func convertErrorPage(
+	policyNsName types.NamespacedName,
+	idx int,
+	page ngfAPIv1alpha1.ErrorPage,
+	res *graph.ErrorPageResources,
+) ErrorPage {
+	errorPage := ErrorPage{
+		Name:  fmt.Sprintf("%s_%s_%d", policyNsName.Namespace, policyNsName.Name, idx),
+		Codes: make([]int, 0, len(page.Codes)),
+	}
+
+	for _, code := range page.Codes {
+		errorPage.Codes = append(errorPage.Codes, int(code))
+	}
+
+	if page.ResponseCode != nil {
+		errorPage.ResponseCode = helpers.GetPointer(int(*page.ResponseCode))
+	}
+
+	switch {
+	case page.Static != nil:
+		contentType := defaultErrorPageContentType
+		if page.Static.ContentType != nil {
+			contentType = *page.Static.ContentType
+		}
+
+		errorPage.Static = &StaticErrorPage{
+			ContentType: contentType,
+			Body:        res.StaticBodies[idx],
+		}
+	case page.Redirect != nil:
+		statusCode := defaultErrorPageRedirectCode
+		if page.Redirect.StatusCode != nil {
+			statusCode = int(*page.Redirect.StatusCode)
+		}
+
+		errorPage.Redirect = &ErrorPageRedirect{
+			URL:        page.Redirect.URL,
+			StatusCode: statusCode,
+		}
+	case page.Service != nil:
+		path := "/"
+		if page.Service.Path != nil {
+			path = *page.Service.Path
+		}
+
+		br := res.BackendRefs[idx]
+		errorPage.Backend = &ErrorPageBackend{
+			UpstreamName: br.ServicePortReference(),
+			Path:         path,
+		}
+	}
+
+	return errorPage
+}
+
+// mergeErrorPages merges the error pages of a route with the error pages of the server. Error pages configured
+// in a location replace all error pages inherited from the server, so the error pages of the server must be added
+// to the location for the status codes that the route doesn't override.
+// It returns nil if the route doesn't have any error pages, in which case the error pages of the server are inherited.
+func mergeErrorPages(route, server *ErrorPages) *ErrorPages {
+	if route == nil {
+		return nil
+	}
+
+	merged := &ErrorPages{
+		InterceptUpstreamErrors: route.InterceptUpstreamErrors,
+		Pages:                   slices.Clone(route.Pages),
+	}
+
+	if server == nil {
+		return merged
+	}
+
+	overridden := make(map[int]struct{})
+	for _, page := range route.Pages {
+		for _, code := range page.Codes {
+			overridden[code] = struct{}{}
+		}
+	}
+
+	for _, page := range server.Pages {
+		codes := make([]int, 0, len(page.Codes))
+		for _, code := range page.Codes {
+			if _, exists := overridden[code]; !exists {
+				codes = append(codes, code)
+			}
+		}
+
+		if len(codes) == 0 {
+			continue
+		}
+
+		page.Codes = codes
+		merged.Pages = append(merged.Pages, page)
+	}
+
+	return merged
+}
+
+// combineErrorPages combines the error pages of the routes that share a path rule, so that the result doesn't
+// depend on the order in which the routes are processed. For each status code, the error page with the lowest name
+// is used. Upstream errors are intercepted if the error pages of any of the routes intercept them.
+func combineErrorPages(a, b *ErrorPages) *ErrorPages {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	combined := &ErrorPages{InterceptUpstreamErrors: a.InterceptUpstreamErrors}
+	if b.InterceptUpstreamErrors != nil && (combined.InterceptUpstreamErrors == nil || *b.InterceptUpstreamErrors) {
+		combined.InterceptUpstreamErrors = b.InterceptUpstreamErrors
+	}
+
+	pages := slices.Concat(a.Pages, b.Pages)
+	slices.SortStableFunc(pages, func(x, y ErrorPage) int {
+		return strings.Compare(x.Name, y.Name)
+	})
+
+	used := make(map[int]struct{})
+
+	for _, page := range pages {
+		codes := make([]int, 0, len(page.Codes))
+		for _, code := range page.Codes {
+			if _, exists := used[code]; !exists {
+				used[code] = struct{}{}
+				codes = append(codes, code)
+			}
+		}
+
+		if len(codes) == 0 {
+			continue
+		}
+
+		// the same error page of the server can be added to the path rule by several routes,
+		// each with the codes that the route doesn't override.
+		if last := len(combined.Pages) - 1; last >= 0 && combined.Pages[last].Name == page.Name {
+			combined.Pages[last].Codes = append(combined.Pages[last].Codes, codes...)
+			slices.Sort(combined.Pages[last].Codes)
+			continue
+		}
+
+		page.Codes = codes
+		combined.Pages = append(combined.Pages, page)
+	}
+
+	return combined
+}
+
+// buildErrorPageBackendRefs returns the BackendRefs of the error pages that apply to the Gateway.
+func buildErrorPageBackendRefs(
+	gateway *graph.Gateway,
+	resources map[types.NamespacedName]*graph.ErrorPageResources,
+) []graph.BackendRef {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	graphPolicies := slices.Clone(gateway.Policies)
+	for _, l := range gateway.Listeners {
+		if !l.Valid {
+			continue
+		}
+
+		for _, route := range l.Routes {
+			if route.Valid {
+				graphPolicies = append(graphPolicies, route.Policies...)
+			}
+		}
+	}
+
+	var backendRefs []graph.BackendRef
+	for _, pol := range buildPolicies(gateway, graphPolicies) {
+		if _, ok := pol.(*ngfAPIv1alpha1.ErrorPagePolicy); !ok {
+			continue
+		}
+
+		res, exists := resources[client.ObjectKeyFromObject(pol)]
+		if !exists {
+			continue
+		}
+
+		for _, br := range res.BackendRefs {
+			backendRefs = append(backendRefs, br)
+		}
+	}
+
+	return backendRefs
+}
+
 func convertAddresses(addresses []ngfAPIv1alpha2.RewriteClientIPAddress) []string {
 	trustedAddresses := make([]string, len(addresses))
 	for i, addr := range addresses {
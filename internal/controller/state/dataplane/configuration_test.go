@@ -598,12 +598,13 @@ func TestBuildConfiguration(t *testing.T) {
 				},
 			},
 		},
-		CaCertRef: types.NamespacedName{Namespace: "test", Name: "configmap-1"},
-		Valid:     true,
+		CaCertRef:  types.NamespacedName{Namespace: "test", Name: "configmap-1"},
+		CaCertKind: kinds.ConfigMap,
+		Valid:      true,
 	}
 
 	expHTTPSHR8Groups[0].Backends[0].VerifyTLS = &VerifyTLS{
-		CertBundleID: generateCertBundleID(types.NamespacedName{Namespace: "test", Name: "configmap-1"}),
+		CertBundleID: generateCertBundleID(kinds.ConfigMap, types.NamespacedName{Namespace: "test", Name: "configmap-1"}),
 		Hostname:     "foo.example.com",
 	}
 
@@ -652,12 +653,13 @@ func TestBuildConfiguration(t *testing.T) {
 				},
 			},
 		},
-		CaCertRef: types.NamespacedName{Namespace: "test", Name: "configmap-2"},
-		Valid:     true,
+		CaCertRef:  types.NamespacedName{Namespace: "test", Name: "configmap-2"},
+		CaCertKind: kinds.ConfigMap,
+		Valid:      true,
 	}
 
 	expHTTPSHR9Groups[0].Backends[0].VerifyTLS = &VerifyTLS{
-		CertBundleID: generateCertBundleID(types.NamespacedName{Namespace: "test", Name: "configmap-2"}),
+		CertBundleID: generateCertBundleID(kinds.ConfigMap, types.NamespacedName{Namespace: "test", Name: "configmap-2"}),
 		Hostname:     "foo.example.com",
 	}
 
@@ -928,8 +930,12 @@ func TestBuildConfiguration(t *testing.T) {
 			},
 			ServiceName: helpers.GetPointer("my-svc"),
 		},
-		DisableHTTP2: helpers.GetPointer(true),
-		IPFamily:     helpers.GetPointer(ngfAPIv1alpha2.Dual),
+		DisableHTTP2:  helpers.GetPointer(true),
+		IPFamily:      helpers.GetPointer(ngfAPIv1alpha2.Dual),
+		HTTPMatchMode: helpers.GetPointer(ngfAPIv1alpha2.HTTPMatchModeNative),
+		HTTP3: &ngfAPIv1alpha2.HTTP3{
+			Enable: helpers.GetPointer(true),
+		},
 	}
 
 	nginxProxyIPv4 := &graph.EffectiveNginxProxy{
@@ -2226,10 +2232,18 @@ func TestBuildConfiguration(t *testing.T) {
 					Ratios:         []Ratio{},
 					SpanAttributes: []SpanAttribute{},
 				}
-				conf.BaseHTTPConfig = BaseHTTPConfig{HTTP2: false, IPFamily: Dual}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2:              false,
+					IPFamily:           Dual,
+					NativeHTTPMatching: true,
+					HTTP3Settings: HTTP3Settings{
+						Enabled:      true,
+						AltSvcMaxAge: 86400,
+					},
+				}
 				return conf
 			}),
-			msg: "EffectiveNginxProxy with tracing config and http2 disabled",
+			msg: "EffectiveNginxProxy with tracing config, http2 disabled, http3 enabled and native http matching",
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
@@ -2675,7 +2689,7 @@ func TestNewBackendGroup_Mirror(t *testing.T) {
 		IsMirrorBackend: true,
 	}
 
-	group := newBackendGroup([]graph.BackendRef{backendRef}, types.NamespacedName{}, types.NamespacedName{}, 0)
+	group := newBackendGroup([]graph.BackendRef{backendRef}, types.NamespacedName{}, types.NamespacedName{}, 0, nil)
 
 	g.Expect(group.Backends).To(BeEmpty())
 }
@@ -2903,6 +2917,58 @@ func TestCreateFilters(t *testing.T) {
 		},
 	}
 
+	createDirectResponseFilter := func(name string, statusCode int) graph.Filter {
+		return graph.Filter{
+			FilterType: graph.FilterExtensionRef,
+			ExtensionRef: &v1.LocalObjectReference{
+				Group: ngfAPIv1alpha1.GroupName,
+				Kind:  kinds.DirectResponseFilter,
+				Name:  v1.ObjectName(name),
+			},
+			ResolvedExtensionRef: &graph.ExtensionRefFilter{
+				Valid: true,
+				DirectResponseFilter: &graph.DirectResponseFilter{
+					Source: &ngfAPIv1alpha1.DirectResponseFilter{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+					},
+					StatusCode:  statusCode,
+					ContentType: "text/plain",
+					Body:        "body of " + name,
+					Valid:       true,
+					Referenced:  true,
+				},
+			},
+		}
+	}
+
+	createGRPCRewriteFilter := func(name string, spec ngfAPIv1alpha1.GRPCRewriteFilterSpec) graph.Filter {
+		return graph.Filter{
+			FilterType: graph.FilterExtensionRef,
+			ExtensionRef: &v1.LocalObjectReference{
+				Group: ngfAPIv1alpha1.GroupName,
+				Kind:  kinds.GRPCRewriteFilter,
+				Name:  v1.ObjectName(name),
+			},
+			ResolvedExtensionRef: &graph.ExtensionRefFilter{
+				Valid: true,
+				GRPCRewriteFilter: &graph.GRPCRewriteFilter{
+					Source: &ngfAPIv1alpha1.GRPCRewriteFilter{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+						Spec: spec,
+					},
+					Valid:      true,
+					Referenced: true,
+				},
+			},
+		}
+	}
+
 	tests := []struct {
 		expected HTTPFilters
 		msg      string
@@ -2913,6 +2979,99 @@ func TestCreateFilters(t *testing.T) {
 			expected: HTTPFilters{},
 			msg:      "no filters",
 		},
+		{
+			filters: []graph.Filter{
+				createGRPCRewriteFilter("grf1", ngfAPIv1alpha1.GRPCRewriteFilterSpec{
+					Hostname: helpers.GetPointer[v1.PreciseHostname]("billing.example.com"),
+					Service:  helpers.GetPointer("acme.billing.v2.Billing"),
+				}),
+				createGRPCRewriteFilter("grf2", ngfAPIv1alpha1.GRPCRewriteFilterSpec{
+					Method: helpers.GetPointer("GetInvoice"),
+				}),
+			},
+			expected: HTTPFilters{
+				GRPCRewrite: &GRPCRewriteFilter{
+					Hostname: helpers.GetPointer("billing.example.com"),
+					Service:  helpers.GetPointer("acme.billing.v2.Billing"),
+				},
+			},
+			msg: "two grpc rewrite filters, first one wins",
+		},
+		{
+			filters: []graph.Filter{
+				{
+					FilterType: graph.FilterExtensionRef,
+					ExtensionRef: &v1.LocalObjectReference{
+						Group: ngfAPIv1alpha1.GroupName,
+						Kind:  kinds.GRPCWebFilter,
+						Name:  "gwf",
+					},
+					ResolvedExtensionRef: &graph.ExtensionRefFilter{
+						Valid: true,
+						GRPCWebFilter: &graph.GRPCWebFilter{
+							Source: &ngfAPIv1alpha1.GRPCWebFilter{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      "gwf",
+									Namespace: "default",
+								},
+								Spec: ngfAPIv1alpha1.GRPCWebFilterSpec{
+									CORS: &ngfAPIv1alpha1.GRPCWebCORS{
+										AllowOrigins: []ngfAPIv1alpha1.GRPCWebOrigin{"https://app.example.com"},
+										AllowHeaders: []ngfAPIv1alpha1.GRPCWebHeaderName{"X-Tenant"},
+										MaxAge:       helpers.GetPointer[int32](600),
+									},
+								},
+							},
+							Valid:      true,
+							Referenced: true,
+						},
+					},
+				},
+			},
+			expected: HTTPFilters{
+				GRPCWeb: &GRPCWebFilter{
+					CORS: &GRPCWebCORS{
+						AllowOrigins: []string{"https://app.example.com"},
+						AllowHeaders: []string{"X-Tenant"},
+						MaxAge:       helpers.GetPointer[int32](600),
+					},
+				},
+			},
+			msg: "grpc web filter",
+		},
+		{
+			filters: []graph.Filter{
+				createDirectResponseFilter("drf1", 503),
+				createDirectResponseFilter("drf2", 200),
+				snippetsFilter1,
+			},
+			expected: HTTPFilters{
+				DirectResponse: &HTTPDirectResponseFilter{
+					StatusCode:  503,
+					ContentType: "text/plain",
+					Body:        "body of drf1",
+				},
+				SnippetsFilters: []SnippetsFilter{
+					{
+						LocationSnippet: &Snippet{
+							Name: createSnippetName(
+								ngfAPIv1alpha1.NginxContextHTTPServerLocation,
+								types.NamespacedName{Namespace: "default", Name: "sf1"},
+							),
+							Contents: "location snippet 1",
+						},
+						ServerSnippet: &Snippet{
+							Name: createSnippetName(
+								ngfAPIv1alpha1.NginxContextHTTPServer,
+								types.NamespacedName{Namespace: "default", Name: "sf1"},
+							),
+							Contents: "server snippet 1",
+						},
+					},
+				},
+			},
+			msg: "two direct response filters, first one wins",
+		},
 		{
 			filters: []graph.Filter{
 				redirect1,
@@ -3394,7 +3553,7 @@ func TestBuildUpstreams(t *testing.T) {
 
 	g := NewWithT(t)
 
-	upstreams := buildUpstreams(context.TODO(), gateway, fakeResolver, referencedServices, Dual)
+	upstreams := buildUpstreams(context.TODO(), gateway, fakeResolver, referencedServices, nil, Dual)
 	g.Expect(upstreams).To(ConsistOf(expUpstreams))
 }
 
@@ -3562,8 +3721,32 @@ func TestConvertBackendTLS(t *testing.T) {
 				},
 			},
 		},
-		Valid:     true,
-		CaCertRef: types.NamespacedName{Namespace: "test", Name: "ca-cert"},
+		Valid:      true,
+		CaCertRef:  types.NamespacedName{Namespace: "test", Name: "ca-cert"},
+		CaCertKind: kinds.ConfigMap,
+	}
+
+	btpCaCertSecretRefs := &graph.BackendTLSPolicy{
+		Source: &v1alpha3.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "btp",
+				Namespace: "test",
+			},
+			Spec: v1alpha3.BackendTLSPolicySpec{
+				Validation: v1alpha3.BackendTLSPolicyValidation{
+					CACertificateRefs: []v1.LocalObjectReference{
+						{
+							Kind: kinds.Secret,
+							Name: "ca-cert",
+						},
+					},
+					Hostname: "example.com",
+				},
+			},
+		},
+		Valid:      true,
+		CaCertRef:  types.NamespacedName{Namespace: "test", Name: "ca-cert"},
+		CaCertKind: kinds.Secret,
 	}
 
 	btpWellKnownCerts := &graph.BackendTLSPolicy{
@@ -3579,11 +3762,17 @@ func TestConvertBackendTLS(t *testing.T) {
 
 	expectedWithCertPath := &VerifyTLS{
 		CertBundleID: generateCertBundleID(
+			kinds.ConfigMap,
 			types.NamespacedName{Namespace: "test", Name: "ca-cert"},
 		),
 		Hostname: "example.com",
 	}
 
+	expectedWithSecretCertPath := &VerifyTLS{
+		CertBundleID: "cert_bundle_secret_test_ca-cert",
+		Hostname:     "example.com",
+	}
+
 	expectedWithWellKnownCerts := &VerifyTLS{
 		Hostname:   "example.com",
 		RootCAPath: alpineSSLRootCAPath,
@@ -3604,6 +3793,11 @@ func TestConvertBackendTLS(t *testing.T) {
 			expected: expectedWithCertPath,
 			msg:      "normal case with cert path",
 		},
+		{
+			btp:      btpCaCertSecretRefs,
+			expected: expectedWithSecretCertPath,
+			msg:      "normal case with cert path from secret",
+		},
 		{
 			btp:      btpWellKnownCerts,
 			expected: expectedWithWellKnownCerts,
@@ -4906,3 +5100,698 @@ func TestBuildNginxPlus(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildErrorPages(t *testing.T) {
+	t.Parallel()
+
+	gw := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gw"},
+		},
+	}
+
+	epp := &ngfAPIv1alpha1.ErrorPagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "epp"},
+		Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+			InterceptUpstreamErrors: helpers.GetPointer(true),
+			ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+				{
+					Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+					Static: &ngfAPIv1alpha1.ErrorPageStatic{
+						ConfigMapName: "error-pages",
+						Key:           "404.html",
+					},
+				},
+				{
+					Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{403},
+					Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{
+						URL:        "https://example.com/forbidden",
+						StatusCode: helpers.GetPointer[int32](301),
+					},
+				},
+				{
+					Codes:        []ngfAPIv1alpha1.ErrorPageStatusCode{502, 503},
+					ResponseCode: helpers.GetPointer[int32](503),
+					Service: &ngfAPIv1alpha1.ErrorPageService{
+						Name: "errors",
+						Port: 80,
+						Path: helpers.GetPointer("/maintenance"),
+					},
+				},
+			},
+		},
+	}
+
+	otherEpp := &ngfAPIv1alpha1.ErrorPagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "another-epp"},
+		Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+			ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+				{
+					Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{500},
+					Static: &ngfAPIv1alpha1.ErrorPageStatic{
+						ContentType:   helpers.GetPointer("application/json"),
+						ConfigMapName: "error-pages",
+						Key:           "500.json",
+					},
+				},
+			},
+		},
+	}
+
+	resources := map[types.NamespacedName]*graph.ErrorPageResources{
+		{Namespace: "test", Name: "epp"}: {
+			StaticBodies: map[int][]byte{0: []byte("not found")},
+			BackendRefs: map[int]graph.BackendRef{
+				2: {
+					SvcNsName:   types.NamespacedName{Namespace: "test", Name: "errors"},
+					ServicePort: apiv1.ServicePort{Port: 80},
+					Valid:       true,
+				},
+			},
+		},
+		{Namespace: "test", Name: "another-epp"}: {
+			StaticBodies: map[int][]byte{0: []byte(`{"error": "internal"}`)},
+		},
+	}
+
+	tests := []struct {
+		expErrorPages *ErrorPages
+		name          string
+		policies      []*graph.Policy
+	}{
+		{
+			name:          "no policies",
+			expErrorPages: nil,
+		},
+		{
+			name: "other policies and invalid policies are ignored",
+			policies: []*graph.Policy{
+				{Source: &ngfAPIv1alpha1.ClientSettingsPolicy{}, Valid: true},
+				{Source: epp, Valid: false},
+			},
+			expErrorPages: nil,
+		},
+		{
+			name: "error pages from multiple policies",
+			policies: []*graph.Policy{
+				{Source: epp, Valid: true},
+				{Source: otherEpp, Valid: true},
+			},
+			expErrorPages: &ErrorPages{
+				InterceptUpstreamErrors: helpers.GetPointer(true),
+				Pages: []ErrorPage{
+					{
+						Name:  "test_another-epp_0",
+						Codes: []int{500},
+						Static: &StaticErrorPage{
+							ContentType: "application/json",
+							Body:        []byte(`{"error": "internal"}`),
+						},
+					},
+					{
+						Name:  "test_epp_0",
+						Codes: []int{404},
+						Static: &StaticErrorPage{
+							ContentType: "text/html",
+							Body:        []byte("not found"),
+						},
+					},
+					{
+						Name:  "test_epp_1",
+						Codes: []int{403},
+						Redirect: &ErrorPageRedirect{
+							URL:        "https://example.com/forbidden",
+							StatusCode: 301,
+						},
+					},
+					{
+						Name:         "test_epp_2",
+						Codes:        []int{502, 503},
+						ResponseCode: helpers.GetPointer(503),
+						Backend: &ErrorPageBackend{
+							UpstreamName: "test_errors_80",
+							Path:         "/maintenance",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildErrorPages(gw, test.policies, resources)).To(Equal(test.expErrorPages))
+		})
+	}
+}
+
+func TestMergeErrorPages(t *testing.T) {
+	t.Parallel()
+
+	serverPages := &ErrorPages{
+		InterceptUpstreamErrors: helpers.GetPointer(true),
+		Pages: []ErrorPage{
+			{Name: "server_0", Codes: []int{404, 500}},
+			{Name: "server_1", Codes: []int{502}},
+		},
+	}
+
+	routePages := &ErrorPages{
+		Pages: []ErrorPage{
+			{Name: "route_0", Codes: []int{500, 502}},
+		},
+	}
+
+	tests := []struct {
+		route    *ErrorPages
+		server   *ErrorPages
+		expected *ErrorPages
+		name     string
+	}{
+		{
+			name:     "no route error pages",
+			server:   serverPages,
+			expected: nil,
+		},
+		{
+			name:     "no server error pages",
+			route:    routePages,
+			expected: routePages,
+		},
+		{
+			name:   "server error pages are added for codes that are not overridden",
+			route:  routePages,
+			server: serverPages,
+			expected: &ErrorPages{
+				Pages: []ErrorPage{
+					{Name: "route_0", Codes: []int{500, 502}},
+					{Name: "server_0", Codes: []int{404}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(mergeErrorPages(test.route, test.server)).To(Equal(test.expected))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(serverPages.Pages[0].Codes).To(Equal([]int{404, 500}))
+}
+
+func TestCombineErrorPages(t *testing.T) {
+	t.Parallel()
+
+	// route A overrides 500 of the server error pages, route B overrides 404 and 502
+	routeA := &ErrorPages{
+		InterceptUpstreamErrors: helpers.GetPointer(false),
+		Pages: []ErrorPage{
+			{Name: "a_0", Codes: []int{500, 502}},
+			{Name: "server_0", Codes: []int{404}},
+		},
+	}
+
+	routeB := &ErrorPages{
+		InterceptUpstreamErrors: helpers.GetPointer(true),
+		Pages: []ErrorPage{
+			{Name: "b_0", Codes: []int{404, 502}},
+			{Name: "server_0", Codes: []int{500}},
+		},
+	}
+
+	expected := &ErrorPages{
+		InterceptUpstreamErrors: helpers.GetPointer(true),
+		Pages: []ErrorPage{
+			{Name: "a_0", Codes: []int{500, 502}},
+			{Name: "b_0", Codes: []int{404}},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(combineErrorPages(nil, nil)).To(BeNil())
+	g.Expect(combineErrorPages(routeA, nil)).To(Equal(routeA))
+	g.Expect(combineErrorPages(nil, routeB)).To(Equal(routeB))
+
+	// the result doesn't depend on the order of the routes
+	g.Expect(combineErrorPages(routeA, routeB)).To(Equal(expected))
+	g.Expect(combineErrorPages(routeB, routeA)).To(Equal(expected))
+
+	// the codes of the same server error page are combined
+	routeC := &ErrorPages{
+		Pages: []ErrorPage{
+			{Name: "c_0", Codes: []int{502}},
+			{Name: "server_0", Codes: []int{404, 500}},
+		},
+	}
+
+	routeD := &ErrorPages{
+		Pages: []ErrorPage{
+			{Name: "server_0", Codes: []int{410}},
+		},
+	}
+
+	g.Expect(combineErrorPages(routeD, routeC)).To(Equal(&ErrorPages{
+		Pages: []ErrorPage{
+			{Name: "c_0", Codes: []int{502}},
+			{Name: "server_0", Codes: []int{404, 410, 500}},
+		},
+	}))
+
+	g.Expect(routeA.Pages[0].Codes).To(Equal([]int{500, 502}))
+	g.Expect(routeC.Pages[1].Codes).To(Equal([]int{404, 500}))
+}
+
+func TestBuildSplitKey(t *testing.T) {
+	t.Parallel()
+
+	gwWithSplitKey := &graph.Gateway{
+		EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+			SplitKey: &ngfAPIv1alpha1.SplitKey{Type: ngfAPIv1alpha1.SplitKeyTypeClientIP},
+		},
+	}
+
+	tsp := &ngfAPIv1alpha1.TrafficSplitPolicy{
+		Spec: ngfAPIv1alpha1.TrafficSplitPolicySpec{
+			SplitKey: ngfAPIv1alpha1.SplitKey{
+				Type: ngfAPIv1alpha1.SplitKeyTypeHeader,
+				Name: helpers.GetPointer("X-User-ID"),
+			},
+		},
+	}
+
+	tests := []struct {
+		gw          *graph.Gateway
+		expSplitKey *SplitKey
+		name        string
+		policies    []policies.Policy
+	}{
+		{
+			name: "nil gateway",
+		},
+		{
+			name: "NginxProxy is nil",
+			gw:   &graph.Gateway{},
+		},
+		{
+			name: "NginxProxy doesn't specify a split key",
+			gw: &graph.Gateway{
+				EffectiveNginxProxy: &graph.EffectiveNginxProxy{},
+			},
+		},
+		{
+			name:        "NginxProxy specifies a split key",
+			gw:          gwWithSplitKey,
+			policies:    []policies.Policy{&ngfAPIv1alpha1.ClientSettingsPolicy{}},
+			expSplitKey: &SplitKey{Type: SplitKeyTypeClientIP},
+		},
+		{
+			name:        "TrafficSplitPolicy overrides NginxProxy",
+			gw:          gwWithSplitKey,
+			policies:    []policies.Policy{&ngfAPIv1alpha1.ClientSettingsPolicy{}, tsp},
+			expSplitKey: &SplitKey{Type: SplitKeyTypeHeader, Name: "X-User-ID"},
+		},
+		{
+			name:        "TrafficSplitPolicy without NginxProxy",
+			policies:    []policies.Policy{tsp},
+			expSplitKey: &SplitKey{Type: SplitKeyTypeHeader, Name: "X-User-ID"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildSplitKey(test.gw, test.policies)).To(Equal(test.expSplitKey))
+		})
+	}
+}
+
+func TestBuildUpgrade(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expUpgrade *bool
+		name       string
+		policies   []policies.Policy
+	}{
+		{
+			name: "no policies",
+		},
+		{
+			name:     "WebSocketPolicy doesn't set upgrade",
+			policies: []policies.Policy{&ngfAPIv1alpha1.WebSocketPolicy{}},
+		},
+		{
+			name: "WebSocketPolicy enables upgrade",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.ClientSettingsPolicy{},
+				&ngfAPIv1alpha1.WebSocketPolicy{
+					Spec: ngfAPIv1alpha1.WebSocketPolicySpec{Upgrade: helpers.GetPointer(true)},
+				},
+			},
+			expUpgrade: helpers.GetPointer(true),
+		},
+		{
+			name: "WebSocketPolicy disables upgrade",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.WebSocketPolicy{
+					Spec: ngfAPIv1alpha1.WebSocketPolicySpec{ReadTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h")},
+				},
+				&ngfAPIv1alpha1.WebSocketPolicy{
+					Spec: ngfAPIv1alpha1.WebSocketPolicySpec{Upgrade: helpers.GetPointer(false)},
+				},
+			},
+			expUpgrade: helpers.GetPointer(false),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildUpgrade(test.policies)).To(Equal(test.expUpgrade))
+		})
+	}
+}
+
+func TestBuildSSL(t *testing.T) {
+	t.Parallel()
+
+	secretNsName := types.NamespacedName{Namespace: "test", Name: "secret"}
+	keyPairID := generateSSLKeyPairID(secretNsName)
+
+	createListener := func(options map[v1.AnnotationKey]v1.AnnotationValue) *graph.Listener {
+		return &graph.Listener{
+			Source: v1.Listener{
+				Protocol: v1.HTTPSProtocolType,
+				TLS: &v1.GatewayTLSConfig{
+					Options: options,
+				},
+			},
+			ResolvedSecret: &secretNsName,
+		}
+	}
+
+	np := &graph.EffectiveNginxProxy{
+		TLS: &ngfAPIv1alpha2.NginxTLS{
+			Protocols: []ngfAPIv1alpha2.TLSProtocolType{
+				ngfAPIv1alpha2.TLSProtocolV12,
+				ngfAPIv1alpha2.TLSProtocolV13,
+			},
+			Ciphers:             helpers.GetPointer("HIGH:!aNULL:!MD5"),
+			PreferServerCiphers: helpers.GetPointer(true),
+		},
+	}
+
+	tests := []struct {
+		listener *graph.Listener
+		np       *graph.EffectiveNginxProxy
+		expSSL   *SSL
+		name     string
+	}{
+		{
+			name:     "no TLS settings",
+			listener: createListener(nil),
+			expSSL:   &SSL{KeyPairID: keyPairID},
+		},
+		{
+			name:     "NginxProxy TLS settings",
+			listener: createListener(nil),
+			np:       np,
+			expSSL: &SSL{
+				KeyPairID:           keyPairID,
+				Protocols:           []string{"TLSv1.2", "TLSv1.3"},
+				Ciphers:             "HIGH:!aNULL:!MD5",
+				PreferServerCiphers: helpers.GetPointer(true),
+			},
+		},
+		{
+			name: "listener TLS options",
+			listener: createListener(map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-protocols":             "TLSv1.3",
+				"nginx.org/ssl-ciphers":               "ECDHE-RSA-AES128-GCM-SHA256",
+				"nginx.org/ssl-prefer-server-ciphers": "false",
+			}),
+			expSSL: &SSL{
+				KeyPairID:           keyPairID,
+				Protocols:           []string{"TLSv1.3"},
+				Ciphers:             "ECDHE-RSA-AES128-GCM-SHA256",
+				PreferServerCiphers: helpers.GetPointer(false),
+			},
+		},
+		{
+			name: "listener TLS options override NginxProxy TLS settings",
+			listener: createListener(map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-protocols": "TLSv1.3",
+			}),
+			np: np,
+			expSSL: &SSL{
+				KeyPairID:           keyPairID,
+				Protocols:           []string{"TLSv1.3"},
+				Ciphers:             "HIGH:!aNULL:!MD5",
+				PreferServerCiphers: helpers.GetPointer(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildSSL(test.listener, test.np)).To(Equal(test.expSSL))
+		})
+	}
+}
+
+func TestBuildTLSSettings(t *testing.T) {
+	t.Parallel()
+
+	keysNsName := types.NamespacedName{Namespace: "test", Name: "ticket-keys"}
+	secrets := map[types.NamespacedName]*graph.Secret{
+		keysNsName: {
+			Source: &apiv1.Secret{
+				Data: map[string][]byte{
+					"2025-01": []byte("old-key"),
+					"2025-02": []byte("new-key"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		tls               *ngfAPIv1alpha2.NginxTLS
+		sessionTicketKeys *types.NamespacedName
+		name              string
+		expSettings       TLSSettings
+	}{
+		{
+			name:        "no settings",
+			tls:         &ngfAPIv1alpha2.NginxTLS{},
+			expSettings: TLSSettings{},
+		},
+		{
+			name: "session cache with default size",
+			tls: &ngfAPIv1alpha2.NginxTLS{
+				SessionCache: &ngfAPIv1alpha2.TLSSessionCache{
+					Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+				},
+			},
+			expSettings: TLSSettings{
+				SessionCache:   "shared:SSL:10m",
+				SessionTimeout: "1h",
+			},
+		},
+		{
+			name: "session cache with size",
+			tls: &ngfAPIv1alpha2.NginxTLS{
+				SessionCache: &ngfAPIv1alpha2.TLSSessionCache{
+					Size: helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+				},
+			},
+			expSettings: TLSSettings{
+				SessionCache: "shared:SSL:20m",
+			},
+		},
+		{
+			name: "session cache and tickets disabled",
+			tls: &ngfAPIv1alpha2.NginxTLS{
+				SessionCache: &ngfAPIv1alpha2.TLSSessionCache{
+					Disable: helpers.GetPointer(true),
+					Size:    helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+				},
+				SessionTickets: &ngfAPIv1alpha2.TLSSessionTickets{
+					Disable: helpers.GetPointer(true),
+				},
+			},
+			sessionTicketKeys: &keysNsName,
+			expSettings: TLSSettings{
+				SessionCache:           "off",
+				SessionTicketsDisabled: true,
+			},
+		},
+		{
+			name: "session ticket keys",
+			tls: &ngfAPIv1alpha2.NginxTLS{
+				SessionTickets: &ngfAPIv1alpha2.TLSSessionTickets{
+					KeysSecretName: helpers.GetPointer(keysNsName.Name),
+				},
+			},
+			sessionTicketKeys: &keysNsName,
+			expSettings: TLSSettings{
+				SessionTicketKeys: []SessionTicketKey{
+					{ID: "session_ticket_key_test_ticket-keys_2025-02", Data: []byte("new-key")},
+					{ID: "session_ticket_key_test_ticket-keys_2025-01", Data: []byte("old-key")},
+				},
+			},
+		},
+		{
+			name: "OCSP stapling with resolver",
+			tls: &ngfAPIv1alpha2.NginxTLS{
+				OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+					Enable: helpers.GetPointer(true),
+					Resolver: &ngfAPIv1alpha2.DNSResolver{
+						Addresses: []string{"10.0.0.10", "fd00::10", "[fd00::11]:53"},
+						Valid:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("30s"),
+						Timeout:   helpers.GetPointer[ngfAPIv1alpha1.Duration]("5s"),
+					},
+				},
+			},
+			expSettings: TLSSettings{
+				OCSPStapling: true,
+				OCSPResolver: &DNSResolver{
+					Addresses: []string{"10.0.0.10", "[fd00::10]", "[fd00::11]:53"},
+					Valid:     "30s",
+					Timeout:   "5s",
+				},
+			},
+		},
+		{
+			name: "OCSP stapling disabled",
+			tls: &ngfAPIv1alpha2.NginxTLS{
+				OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+					Resolver: &ngfAPIv1alpha2.DNSResolver{
+						Addresses: []string{"10.0.0.10"},
+					},
+				},
+			},
+			expSettings: TLSSettings{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildTLSSettings(test.tls, test.sessionTicketKeys, secrets)).To(Equal(test.expSettings))
+		})
+	}
+}
+
+func TestMergeConfigurations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	ownerGroup := BackendGroup{Source: types.NamespacedName{Namespace: "test", Name: "owner-route"}}
+	sharedGroup := BackendGroup{Source: types.NamespacedName{Namespace: "test", Name: "shared-route"}}
+	memberGroup := BackendGroup{Source: types.NamespacedName{Namespace: "test", Name: "member-route"}}
+
+	owner := Configuration{
+		HTTPServers: []VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "foo.example.com", Port: 80},
+		},
+		SSLServers: []VirtualServer{
+			{IsDefault: true, Port: 443},
+			{Hostname: "foo.example.com", Port: 443},
+		},
+		TLSPassthroughServers: []Layer4VirtualServer{
+			{Hostname: "tls.foo.example.com", Port: 8443, UpstreamName: "foo-tls"},
+		},
+		Upstreams:       []Upstream{{Name: "foo"}, {Name: "shared"}},
+		StreamUpstreams: []Upstream{{Name: "foo-tls"}},
+		BackendGroups:   []BackendGroup{ownerGroup, sharedGroup},
+		SSLKeyPairs: map[SSLKeyPairID]SSLKeyPair{
+			"ssl_keypair_test_foo": {Cert: []byte("foo-cert"), Key: []byte("foo-key")},
+		},
+		BaseHTTPConfig: BaseHTTPConfig{HTTP2: true},
+		Logging:        Logging{ErrorLevel: "info"},
+	}
+
+	member := Configuration{
+		HTTPServers: []VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "bar.example.com", Port: 80},
+		},
+		SSLServers: []VirtualServer{
+			{IsDefault: true, Port: 8443},
+			{Hostname: "bar.example.com", Port: 8443},
+		},
+		TLSPassthroughServers: []Layer4VirtualServer{
+			{Hostname: "tls.bar.example.com", Port: 8443, UpstreamName: "bar-tls"},
+		},
+		Upstreams:       []Upstream{{Name: "bar"}, {Name: "shared"}},
+		StreamUpstreams: []Upstream{{Name: "bar-tls"}},
+		BackendGroups:   []BackendGroup{sharedGroup, memberGroup},
+		SSLKeyPairs: map[SSLKeyPairID]SSLKeyPair{
+			"ssl_keypair_test_foo": {Cert: []byte("other-cert"), Key: []byte("other-key")},
+			"ssl_keypair_test_bar": {Cert: []byte("bar-cert"), Key: []byte("bar-key")},
+		},
+		CertBundles: map[CertBundleID]CertBundle{
+			"cert_bundle_test_bar": []byte("bar-ca"),
+		},
+		BaseHTTPConfig: BaseHTTPConfig{HTTP2: false},
+		Logging:        Logging{ErrorLevel: "debug"},
+	}
+
+	expected := Configuration{
+		HTTPServers: []VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "foo.example.com", Port: 80},
+			{Hostname: "bar.example.com", Port: 80},
+		},
+		SSLServers: []VirtualServer{
+			{IsDefault: true, Port: 443},
+			{Hostname: "foo.example.com", Port: 443},
+			{IsDefault: true, Port: 8443},
+			{Hostname: "bar.example.com", Port: 8443},
+		},
+		TLSPassthroughServers: []Layer4VirtualServer{
+			{Hostname: "tls.foo.example.com", Port: 8443, UpstreamName: "foo-tls"},
+			{Hostname: "tls.bar.example.com", Port: 8443, UpstreamName: "bar-tls"},
+		},
+		Upstreams:       []Upstream{{Name: "foo"}, {Name: "shared"}, {Name: "bar"}},
+		StreamUpstreams: []Upstream{{Name: "foo-tls"}, {Name: "bar-tls"}},
+		BackendGroups:   []BackendGroup{ownerGroup, sharedGroup, memberGroup},
+		SSLKeyPairs: map[SSLKeyPairID]SSLKeyPair{
+			"ssl_keypair_test_foo": {Cert: []byte("foo-cert"), Key: []byte("foo-key")},
+			"ssl_keypair_test_bar": {Cert: []byte("bar-cert"), Key: []byte("bar-key")},
+		},
+		CertBundles: map[CertBundleID]CertBundle{
+			"cert_bundle_test_bar": []byte("bar-ca"),
+		},
+		BaseHTTPConfig: BaseHTTPConfig{HTTP2: true},
+		Logging:        Logging{ErrorLevel: "info"},
+	}
+
+	g.Expect(MergeConfigurations(owner)).To(Equal(owner))
+
+	result := MergeConfigurations(owner, member)
+	g.Expect(result).To(Equal(expected))
+
+	// the owner's Configuration is not modified
+	g.Expect(owner.SSLKeyPairs).To(HaveLen(1))
+	g.Expect(owner.CertBundles).To(BeNil())
+}
@@ -78,6 +78,10 @@ func convertHTTPRequestMirrorFilter(filter *v1.HTTPRequestMirrorFilter, ruleIdx
 
 	result.Target = mirror.BackendPath(ruleIdx, namespace, *result.Name)
 
+	if percent, _ := mirror.Percent(filter); percent < 100 {
+		result.Percent = helpers.GetPointer(percent)
+	}
+
 	return result
 }
 
@@ -109,6 +113,8 @@ func convertPathType(pathType v1.PathMatchType) PathType {
 		return PathTypePrefix
 	case v1.PathMatchExact:
 		return PathTypeExact
+	case v1.PathMatchRegularExpression:
+		return PathTypeRegularExpression
 	default:
 		panic(fmt.Sprintf("unsupported path type: %s", pathType))
 	}
@@ -166,3 +172,51 @@ func convertSnippetsFilter(filter *graph.SnippetsFilter) SnippetsFilter {
 
 	return result
 }
+
+func convertDirectResponseFilter(filter *graph.DirectResponseFilter) *HTTPDirectResponseFilter {
+	return &HTTPDirectResponseFilter{
+		StatusCode:  filter.StatusCode,
+		ContentType: filter.ContentType,
+		Body:        filter.Body,
+	}
+}
+
+func convertGRPCRewriteFilter(filter *graph.GRPCRewriteFilter) *GRPCRewriteFilter {
+	spec := filter.Source.Spec
+
+	result := &GRPCRewriteFilter{
+		Service: spec.Service,
+		Method:  spec.Method,
+	}
+
+	if spec.Hostname != nil {
+		result.Hostname = helpers.GetPointer(string(*spec.Hostname))
+	}
+
+	return result
+}
+
+func convertGRPCWebFilter(filter *graph.GRPCWebFilter) *GRPCWebFilter {
+	cors := filter.Source.Spec.CORS
+	if cors == nil {
+		return &GRPCWebFilter{}
+	}
+
+	result := &GRPCWebCORS{
+		AllowOrigins: make([]string, 0, len(cors.AllowOrigins)),
+		MaxAge:       cors.MaxAge,
+	}
+
+	for _, origin := range cors.AllowOrigins {
+		result.AllowOrigins = append(result.AllowOrigins, string(origin))
+	}
+
+	if len(cors.AllowHeaders) > 0 {
+		result.AllowHeaders = make([]string, 0, len(cors.AllowHeaders))
+		for _, header := range cors.AllowHeaders {
+			result.AllowHeaders = append(result.AllowHeaders, string(header))
+		}
+	}
+
+	return &GRPCWebFilter{CORS: result}
+}
@@ -355,6 +355,47 @@ func TestConvertHTTPMirrorFilter(t *testing.T) {
 			},
 			name: "full",
 		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				BackendRef: v1.BackendObjectReference{
+					Name: "backend",
+				},
+				Percent: helpers.GetPointer[int32](100),
+			},
+			expected: &HTTPRequestMirrorFilter{
+				Name:   helpers.GetPointer("backend"),
+				Target: helpers.GetPointer("/_ngf-internal-mirror-backend-0"),
+			},
+			name: "all requests",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				BackendRef: v1.BackendObjectReference{
+					Name: "backend",
+				},
+				Percent: helpers.GetPointer[int32](25),
+			},
+			expected: &HTTPRequestMirrorFilter{
+				Name:    helpers.GetPointer("backend"),
+				Target:  helpers.GetPointer("/_ngf-internal-mirror-backend-0"),
+				Percent: helpers.GetPointer(25.0),
+			},
+			name: "percent",
+		},
+		{
+			filter: &v1.HTTPRequestMirrorFilter{
+				BackendRef: v1.BackendObjectReference{
+					Name: "backend",
+				},
+				Fraction: &v1.Fraction{Numerator: 1, Denominator: helpers.GetPointer[int32](3)},
+			},
+			expected: &HTTPRequestMirrorFilter{
+				Name:    helpers.GetPointer("backend"),
+				Target:  helpers.GetPointer("/_ngf-internal-mirror-backend-0"),
+				Percent: helpers.GetPointer(33.33),
+			},
+			name: "fraction",
+		},
 	}
 
 	for _, test := range tests {
@@ -434,7 +475,11 @@ func TestConvertPathType(t *testing.T) {
 			pathType: v1.PathMatchExact,
 		},
 		{
+			expected: PathTypeRegularExpression,
 			pathType: v1.PathMatchRegularExpression,
+		},
+		{
+			pathType: v1.PathMatchType("invalid"),
 			panic:    true,
 		},
 	}
@@ -79,3 +79,80 @@ func higherPriority(rule1, rule2 MatchRule) bool {
 	// If still tied, compare the object meta of the two routes.
 	return ngfsort.LessObjectMeta(rule1.Source, rule2.Source)
 }
+
+// sortPathRules sorts the PathRules so the order is preserved after reconfiguration.
+//
+// NGINX checks the locations with regular expressions in the order they appear in the configuration and uses the
+// first one that matches. Because of that, the PathRules with RegularExpression paths are sorted after all other
+// PathRules, in order of precedence:
+// - The rule of the oldest Route based on creation timestamp.
+// - The rule of the Route appearing first in alphabetical order by "{namespace}/{name}".
+// - The first rule within the Route.
+// - The path in alphabetical order.
+func sortPathRules(pathRules []PathRule) {
+	sort.Slice(pathRules, func(i, j int) bool {
+		regex1 := pathRules[i].PathType == PathTypeRegularExpression
+		regex2 := pathRules[j].PathType == PathTypeRegularExpression
+
+		if regex1 != regex2 {
+			return regex2
+		}
+
+		if regex1 {
+			return regexPathRuleHigherPriority(pathRules[i], pathRules[j])
+		}
+
+		if pathRules[i].Path != pathRules[j].Path {
+			return pathRules[i].Path < pathRules[j].Path
+		}
+
+		return pathRules[i].PathType < pathRules[j].PathType
+	})
+}
+
+// regexPathRuleHigherPriority returns true if the RegularExpression PathRule rule1 has a higher priority than rule2.
+func regexPathRuleHigherPriority(rule1, rule2 PathRule) bool {
+	first1 := firstMatchRule(rule1.MatchRules)
+	first2 := firstMatchRule(rule2.MatchRules)
+
+	if (first1 == nil) != (first2 == nil) {
+		return first1 != nil
+	}
+
+	if first1 != nil {
+		if ngfsort.LessObjectMeta(first1.Source, first2.Source) {
+			return true
+		}
+
+		if ngfsort.LessObjectMeta(first2.Source, first1.Source) {
+			return false
+		}
+
+		if first1.BackendGroup.RuleIdx != first2.BackendGroup.RuleIdx {
+			return first1.BackendGroup.RuleIdx < first2.BackendGroup.RuleIdx
+		}
+	}
+
+	return rule1.Path < rule2.Path
+}
+
+// firstMatchRule returns the MatchRule that belongs to the oldest Route and the first rule within that Route.
+// It returns nil if none of the MatchRules has a Source.
+func firstMatchRule(matchRules []MatchRule) *MatchRule {
+	var first *MatchRule
+
+	for i := range matchRules {
+		r := &matchRules[i]
+		if r.Source == nil {
+			continue
+		}
+
+		if first == nil ||
+			ngfsort.LessObjectMeta(r.Source, first.Source) ||
+			(!ngfsort.LessObjectMeta(first.Source, r.Source) && r.BackendGroup.RuleIdx < first.BackendGroup.RuleIdx) {
+			first = r
+		}
+	}
+
+	return first
+}
@@ -162,3 +162,76 @@ func TestSort(t *testing.T) {
 	g := NewWithT(t)
 	g.Expect(cmp.Diff(sortedRules, rules)).To(BeEmpty())
 }
+
+func TestSortPathRules(t *testing.T) {
+	t.Parallel()
+	earlier := metav1.Now()
+	later := metav1.NewTime(earlier.Add(1 * time.Second))
+
+	olderRoute := &metav1.ObjectMeta{Name: "hr-b", Namespace: "test", CreationTimestamp: earlier}
+	newerRoute := &metav1.ObjectMeta{Name: "hr-c", Namespace: "test", CreationTimestamp: later}
+	newerRouteAlphabeticallyFirst := &metav1.ObjectMeta{Name: "hr-a", Namespace: "test", CreationTimestamp: later}
+
+	createRegexPathRule := func(path string, matchRules ...MatchRule) PathRule {
+		return PathRule{
+			Path:       path,
+			PathType:   PathTypeRegularExpression,
+			MatchRules: matchRules,
+		}
+	}
+
+	createMatchRule := func(source *metav1.ObjectMeta, ruleIdx int) MatchRule {
+		return MatchRule{
+			Source:       source,
+			BackendGroup: BackendGroup{RuleIdx: ruleIdx},
+		}
+	}
+
+	prefixFoo := PathRule{Path: "/foo", PathType: PathTypePrefix}
+	exactFoo := PathRule{Path: "/foo", PathType: PathTypeExact}
+	prefixBar := PathRule{Path: "/bar", PathType: PathTypePrefix}
+
+	newerRouteRule0 := createRegexPathRule("/newer-0", createMatchRule(newerRoute, 0))
+	newerRouteAlphabeticallyFirstRule1 := createRegexPathRule(
+		"/newer-alphabetically-first-1",
+		createMatchRule(newerRouteAlphabeticallyFirst, 1),
+	)
+	olderRouteRule1 := createRegexPathRule("/older-1", createMatchRule(olderRoute, 1))
+	olderRouteRule0 := createRegexPathRule("/older-0", createMatchRule(olderRoute, 0))
+	// the oldest Route among the MatchRules determines the precedence of the PathRule
+	sharedWithOlderRouteRule0 := createRegexPathRule(
+		"/shared",
+		createMatchRule(newerRoute, 0),
+		createMatchRule(olderRoute, 0),
+	)
+	noSource := createRegexPathRule("/no-source", MatchRule{})
+
+	rules := []PathRule{
+		newerRouteRule0,
+		prefixFoo,
+		noSource,
+		olderRouteRule1,
+		exactFoo,
+		sharedWithOlderRouteRule0,
+		newerRouteAlphabeticallyFirstRule1,
+		prefixBar,
+		olderRouteRule0,
+	}
+
+	sortedRules := []PathRule{
+		prefixBar,
+		exactFoo,
+		prefixFoo,
+		olderRouteRule0,
+		sharedWithOlderRouteRule0,
+		olderRouteRule1,
+		newerRouteAlphabeticallyFirstRule1,
+		newerRouteRule0,
+		noSource,
+	}
+
+	sortPathRules(rules)
+
+	g := NewWithT(t)
+	g.Expect(cmp.Diff(sortedRules, rules)).To(BeEmpty())
+}
@@ -19,6 +19,8 @@ const (
 	PathTypePrefix PathType = "prefix"
 	// PathTypeExact indicates that the path is exact.
 	PathTypeExact PathType = "exact"
+	// PathTypeRegularExpression indicates that the path is a regular expression.
+	PathTypeRegularExpression PathType = "regularExpression"
 )
 
 // Configuration is an intermediate representation of dataplane configuration.
@@ -85,6 +87,8 @@ type VirtualServer struct {
 	PathRules []PathRule
 	// Policies is a list of Policies that apply to the server.
 	Policies []policies.Policy
+	// ErrorPages holds the custom error pages of the server.
+	ErrorPages *ErrorPages
 	// Port is the port of the server.
 	Port int32
 	// IsDefault indicates whether the server is the default server.
@@ -117,8 +121,15 @@ type Upstream struct {
 
 // SSL is the SSL configuration for a server.
 type SSL struct {
+	// PreferServerCiphers specifies whether the ciphers of the server are preferred over the ciphers of the client.
+	// If nil, the NGINX default is used.
+	PreferServerCiphers *bool
 	// KeyPairID is the ID of the corresponding SSLKeyPair for the server.
 	KeyPairID SSLKeyPairID
+	// Ciphers is the list of enabled ciphers in the format of the OpenSSL library.
+	Ciphers string
+	// Protocols is the list of enabled TLS protocols.
+	Protocols []string
 }
 
 // PathRule represents routing rules that share a common path.
@@ -131,10 +142,63 @@ type PathRule struct {
 	MatchRules []MatchRule
 	// Policies contains the list of policies that are applied to this PathRule.
 	Policies []policies.Policy
+	// ErrorPages holds the custom error pages of this PathRule. If nil, the error pages of the server apply.
+	// Otherwise, it includes the error pages of the server for the status codes it doesn't override.
+	ErrorPages *ErrorPages
 	// GRPC indicates if this is a gRPC rule
 	GRPC bool
 }
 
+// ErrorPages holds the custom error pages configuration.
+type ErrorPages struct {
+	// InterceptUpstreamErrors indicates whether the error responses of the upstreams are replaced with error pages.
+	InterceptUpstreamErrors *bool
+	// Pages are the error pages.
+	Pages []ErrorPage
+}
+
+// ErrorPage is a custom error page that replaces the error responses with one of the configured status codes.
+// Exactly one of Static, Redirect or Backend is set.
+type ErrorPage struct {
+	// ResponseCode is the status code returned to the client instead of the original status code.
+	// If nil, the original status code is returned.
+	ResponseCode *int
+	// Static holds the static response of the error page.
+	Static *StaticErrorPage
+	// Redirect holds the redirect of the error page.
+	Redirect *ErrorPageRedirect
+	// Backend holds the backend that serves the error page.
+	Backend *ErrorPageBackend
+	// Name is the name of the error page. It is unique and safe to use as a file name.
+	Name string
+	// Codes are the status codes of the error responses that are replaced by the error page.
+	Codes []int
+}
+
+// StaticErrorPage is a static error page response.
+type StaticErrorPage struct {
+	// ContentType is the content type of the response.
+	ContentType string
+	// Body is the body of the response.
+	Body []byte
+}
+
+// ErrorPageRedirect is a redirect to another URL.
+type ErrorPageRedirect struct {
+	// URL is the URL to redirect to.
+	URL string
+	// StatusCode is the status code of the redirect.
+	StatusCode int
+}
+
+// ErrorPageBackend is a backend that serves an error page.
+type ErrorPageBackend struct {
+	// UpstreamName is the name of the upstream.
+	UpstreamName string
+	// Path is the path of the request sent to the upstream.
+	Path string
+}
+
 // InvalidHTTPFilter is a special filter for handling the case when configured filters are invalid.
 type InvalidHTTPFilter struct{}
 
@@ -156,6 +220,48 @@ type HTTPFilters struct {
 	// SnippetsFilters holds all the SnippetsFilters for the MatchRule.
 	// Unlike the core and extended filters, there can be more than one SnippetsFilters defined on a routing rule.
 	SnippetsFilters []SnippetsFilter
+	// DirectResponse holds the DirectResponseFilter.
+	DirectResponse *HTTPDirectResponseFilter
+	// GRPCRewrite holds the GRPCRewriteFilter.
+	GRPCRewrite *GRPCRewriteFilter
+	// GRPCWeb holds the GRPCWebFilter.
+	GRPCWeb *GRPCWebFilter
+}
+
+// GRPCWebFilter enables gRPC-Web translation for gRPC requests.
+type GRPCWebFilter struct {
+	// CORS holds the Cross-Origin Resource Sharing configuration. If nil, CORS is not configured.
+	CORS *GRPCWebCORS
+}
+
+// GRPCWebCORS holds the Cross-Origin Resource Sharing configuration for gRPC-Web requests.
+type GRPCWebCORS struct {
+	// MaxAge is the number of seconds that the result of a preflight request can be cached.
+	MaxAge *int32
+	// AllowOrigins is the list of allowed origins.
+	AllowOrigins []string
+	// AllowHeaders is the list of additional allowed request headers.
+	AllowHeaders []string
+}
+
+// GRPCRewriteFilter rewrites the authority, and the service and method of a gRPC request.
+type GRPCRewriteFilter struct {
+	// Hostname is the value that replaces the authority of the request.
+	Hostname *string
+	// Service is the fully qualified service name that replaces the service of the request.
+	Service *string
+	// Method is the method name that replaces the method of the request.
+	Method *string
+}
+
+// HTTPDirectResponseFilter responds to requests with a fixed response instead of proxying them to a backend.
+type HTTPDirectResponseFilter struct {
+	// ContentType is the content type of the response.
+	ContentType string
+	// Body is the body of the response.
+	Body string
+	// StatusCode is the status code of the response.
+	StatusCode int
 }
 
 // SnippetsFilter holds the location and server snippets in a SnippetsFilter.
@@ -215,6 +321,9 @@ type HTTPRequestMirrorFilter struct {
 	Namespace *string
 	// Target is the target of the mirror (path with hostname and service name).
 	Target *string
+	// Percent is the percentage of requests to mirror.
+	// If nil, all requests are mirrored.
+	Percent *float64
 }
 
 // PathModifierType is the type of the PathModifier in a redirect or rewrite rule.
@@ -280,6 +389,9 @@ type MatchRule struct {
 	Match Match
 	// BackendGroup is the group of Backends that the rule routes to.
 	BackendGroup BackendGroup
+	// Upgrade indicates whether connection upgrades, such as WebSocket, are enabled for the rule.
+	// It is set by a WebSocketPolicy. If nil, the default behavior applies.
+	Upgrade *bool
 }
 
 // Match represents a match for a routing rule which consist of matches against various HTTP request attributes.
@@ -294,6 +406,9 @@ type Match struct {
 
 // BackendGroup represents a group of Backends for a routing rule in an HTTPRoute.
 type BackendGroup struct {
+	// SplitKey is the key used to split traffic between the Backends.
+	// If nil, traffic is split by the request ID.
+	SplitKey *SplitKey
 	// Source is the NamespacedName of the HTTPRoute the group belongs to.
 	Source types.NamespacedName
 	// Backends is a list of Backends in the Group.
@@ -313,6 +428,28 @@ func (bg *BackendGroup) Name() string {
 	return fmt.Sprintf("group_%s__%s_rule%d", bg.Source.Namespace, bg.Source.Name, bg.RuleIdx)
 }
 
+// SplitKeyType is the type of the key used to split traffic between the Backends of a BackendGroup.
+type SplitKeyType string
+
+const (
+	// SplitKeyTypeRequestID splits traffic by the request ID.
+	SplitKeyTypeRequestID SplitKeyType = "RequestID"
+	// SplitKeyTypeClientIP splits traffic by the client IP address.
+	SplitKeyTypeClientIP SplitKeyType = "ClientIP"
+	// SplitKeyTypeCookie splits traffic by the value of a cookie.
+	SplitKeyTypeCookie SplitKeyType = "Cookie"
+	// SplitKeyTypeHeader splits traffic by the value of a request header.
+	SplitKeyTypeHeader SplitKeyType = "Header"
+)
+
+// SplitKey is the key used to split traffic between the Backends of a BackendGroup.
+type SplitKey struct {
+	// Type is the type of the key.
+	Type SplitKeyType
+	// Name is the name of the cookie or header. It is empty for the other types.
+	Name string
+}
+
 // Backend represents a Backend for a routing rule.
 type Backend struct {
 	// VerifyTLS holds the backend TLS verification configuration.
@@ -368,8 +505,16 @@ type BaseHTTPConfig struct {
 	Snippets []Snippet
 	// RewriteIPSettings defines configuration for rewriting the client IP to the original client's IP.
 	RewriteClientIPSettings RewriteClientIPSettings
+	// HTTP3Settings defines configuration of HTTP/3 for the servers of HTTPS listeners.
+	HTTP3Settings HTTP3Settings
+	// TLSSettings defines configuration of TLS session resumption and OCSP stapling for the servers of
+	// HTTPS listeners.
+	TLSSettings TLSSettings
 	// HTTP2 specifies whether http2 should be enabled for all servers.
 	HTTP2 bool
+	// NativeHTTPMatching specifies whether the method, header and query parameter matches are evaluated
+	// with NGINX map blocks instead of the NGINX JavaScript module.
+	NativeHTTPMatching bool
 }
 
 // Snippet is a snippet of configuration.
@@ -390,6 +535,53 @@ type RewriteClientIPSettings struct {
 	IPRecursive bool
 }
 
+// TLSSettings defines configuration of TLS session resumption and OCSP stapling for the servers of HTTPS listeners.
+type TLSSettings struct {
+	// SessionCache is the value of the ssl_session_cache directive. If empty, the NGINX default is used.
+	SessionCache string
+	// SessionTimeout is the time during which a client can reuse the parameters of a TLS session.
+	SessionTimeout string
+	// OCSPResolver defines the DNS servers used to resolve the hostnames of the OCSP responders.
+	OCSPResolver *DNSResolver
+	// SessionTicketKeys holds the keys used to encrypt and decrypt session tickets.
+	// The first key is used to encrypt session tickets.
+	SessionTicketKeys []SessionTicketKey
+	// SessionTicketsDisabled specifies whether the resumption of TLS sessions with session tickets is disabled.
+	SessionTicketsDisabled bool
+	// OCSPStapling specifies whether the stapling of OCSP responses is enabled.
+	OCSPStapling bool
+}
+
+// SessionTicketKeyID is a unique identifier for a SessionTicketKey.
+// The ID is safe to use as a file name.
+type SessionTicketKeyID string
+
+// SessionTicketKey is a key used to encrypt and decrypt TLS session tickets.
+type SessionTicketKey struct {
+	// ID is the unique identifier of the key.
+	ID SessionTicketKeyID
+	// Data is the key.
+	Data []byte
+}
+
+// DNSResolver defines the DNS servers that NGINX uses to resolve hostnames.
+type DNSResolver struct {
+	// Valid overrides the time that NGINX caches the DNS responses.
+	Valid string
+	// Timeout is the timeout for resolving a hostname.
+	Timeout string
+	// Addresses are the addresses of the DNS servers.
+	Addresses []string
+}
+
+// HTTP3Settings defines configuration of HTTP/3 for the servers of HTTPS listeners.
+type HTTP3Settings struct {
+	// AltSvcMaxAge is the number of seconds that clients can remember that HTTP/3 is available.
+	AltSvcMaxAge int32
+	// Enabled specifies whether HTTP/3 is enabled.
+	Enabled bool
+}
+
 // RewriteIPModeType specifies the mode for rewriting the client IP.
 type RewriteIPModeType string
 
@@ -16,8 +16,10 @@ import (
 type BackendTLSPolicy struct {
 	// Source is the source resource.
 	Source *v1alpha3.BackendTLSPolicy
-	// CaCertRef is the name of the ConfigMap that contains the CA certificate.
+	// CaCertRef is the name of the ConfigMap or Secret that contains the CA certificate.
 	CaCertRef types.NamespacedName
+	// CaCertKind is the kind of the resource referenced by CaCertRef, either ConfigMap or Secret.
+	CaCertKind v1.Kind
 	// Gateways are the names of the Gateways that are being checked for this BackendTLSPolicy.
 	Gateways []types.NamespacedName
 	// Conditions include Conditions for the BackendTLSPolicy.
@@ -44,6 +46,7 @@ func processBackendTLSPolicies(
 	processedBackendTLSPolicies := make(map[types.NamespacedName]*BackendTLSPolicy, len(backendTLSPolicies))
 	for nsname, backendTLSPolicy := range backendTLSPolicies {
 		var caCertRef types.NamespacedName
+		var caCertKind v1.Kind
 
 		valid, ignored, conds := validateBackendTLSPolicy(backendTLSPolicy, configMapResolver, secretResolver, ctlrName)
 
@@ -51,6 +54,7 @@ func processBackendTLSPolicies(
 			caCertRef = types.NamespacedName{
 				Namespace: backendTLSPolicy.Namespace, Name: string(backendTLSPolicy.Spec.Validation.CACertificateRefs[0].Name),
 			}
+			caCertKind = backendTLSPolicy.Spec.Validation.CACertificateRefs[0].Kind
 		}
 
 		processedBackendTLSPolicies[nsname] = &BackendTLSPolicy{
@@ -58,6 +62,7 @@ func processBackendTLSPolicies(
 			Valid:      valid,
 			Conditions: conds,
 			CaCertRef:  caCertRef,
+			CaCertKind: caCertKind,
 			Ignored:    ignored,
 		}
 	}
@@ -136,7 +141,7 @@ func validateBackendTLSCACertRef(
 	}
 
 	selectedCertRef := btp.Spec.Validation.CACertificateRefs[0]
-	allowedCaCertKinds := []v1.Kind{"ConfigMap", "Secret"}
+	allowedCaCertKinds := []v1.Kind{kinds.ConfigMap, kinds.Secret}
 
 	if !slices.Contains(allowedCaCertKinds, selectedCertRef.Kind) {
 		path := field.NewPath("validation.caCertificateRefs[0].kind")
@@ -155,13 +160,13 @@ func validateBackendTLSCACertRef(
 	}
 
 	switch selectedCertRef.Kind {
-	case "ConfigMap":
+	case kinds.ConfigMap:
 		if err := configMapResolver.resolve(nsName); err != nil {
 			path := field.NewPath("validation.caCertificateRefs[0]")
 			return field.Invalid(path, selectedCertRef, err.Error())
 		}
-	case "Secret":
-		if err := secretResolver.resolve(nsName); err != nil {
+	case kinds.Secret:
+		if err := secretResolver.resolveCACert(nsName); err != nil {
 			path := field.NewPath("validation.caCertificateRefs[0]")
 			return field.Invalid(path, selectedCertRef, err.Error())
 		}
@@ -120,6 +120,22 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 		},
 	}
 
+	localObjectRefOpaqueSecretCase := []gatewayv1.LocalObjectReference{
+		{
+			Kind:  "Secret",
+			Name:  "ca-secret",
+			Group: "",
+		},
+	}
+
+	localObjectRefSecretWithoutCA := []gatewayv1.LocalObjectReference{
+		{
+			Kind:  "Secret",
+			Name:  "tls-secret-no-ca",
+			Group: "",
+		},
+	}
+
 	localObjectRefInvalidName := []gatewayv1.LocalObjectReference{
 		{
 			Kind:  "ConfigMap",
@@ -233,6 +249,39 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 			},
 			isValid: true,
 		},
+		{
+			name: "normal case with ca cert ref opaque secret",
+			tlsPolicy: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls-policy",
+					Namespace: "test",
+				},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: targetRefNormalCase,
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						CACertificateRefs: localObjectRefOpaqueSecretCase,
+						Hostname:          "foo.test.com",
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			name: "invalid case with ca cert ref secret without ca.crt",
+			tlsPolicy: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls-policy",
+					Namespace: "test",
+				},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: targetRefNormalCase,
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						CACertificateRefs: localObjectRefSecretWithoutCA,
+						Hostname:          "foo.test.com",
+					},
+				},
+			},
+		},
 		{
 			name: "normal case with ca cert refs and 16 ancestors including us",
 			tlsPolicy: &v1alpha3.BackendTLSPolicy{
@@ -454,6 +503,27 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 				CAKey:               []byte(caBlock),
 			},
 		},
+		{Namespace: "test", Name: "ca-secret"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-secret",
+				Namespace: "test",
+			},
+			Type: v1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				CAKey: []byte(caBlock),
+			},
+		},
+		{Namespace: "test", Name: "tls-secret-no-ca"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tls-secret-no-ca",
+				Namespace: "test",
+			},
+			Type: v1.SecretTypeTLS,
+			Data: map[string][]byte{
+				v1.TLSCertKey:       cert,
+				v1.TLSPrivateKeyKey: key,
+			},
+		},
 		{Namespace: "test", Name: "invalid-secret"}: {
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "invalid-secret",
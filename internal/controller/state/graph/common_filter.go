@@ -217,7 +217,7 @@ func validateFilter(
 			filterPath.Child(string(filter.FilterType)),
 		)
 	case FilterExtensionRef:
-		return validateExtensionRefFilter(filter.ExtensionRef, filterPath)
+		return validateExtensionRefFilter(filter.ExtensionRef, filter.RouteType, filterPath)
 	default:
 		panic(fmt.Sprintf("unexpected filter type %v", filter.FilterType))
 	}
@@ -230,7 +230,38 @@ func validateFilterMirror(mirror *v1.HTTPRequestMirrorFilter, filterPath *field.
 		return field.ErrorList{field.Required(mirrorPath, "cannot be nil")}
 	}
 
-	return nil
+	var allErrs field.ErrorList
+
+	if mirror.Percent != nil && mirror.Fraction != nil {
+		allErrs = append(allErrs, field.Invalid(mirrorPath, "percent and fraction", "only one may be specified"))
+	}
+
+	if mirror.Percent != nil && (*mirror.Percent < 0 || *mirror.Percent > 100) {
+		allErrs = append(allErrs, field.Invalid(mirrorPath.Child("percent"), *mirror.Percent, "must be between 0 and 100"))
+	}
+
+	if fraction := mirror.Fraction; fraction != nil {
+		fractionPath := mirrorPath.Child("fraction")
+
+		denominator := int32(100)
+		if fraction.Denominator != nil {
+			denominator = *fraction.Denominator
+		}
+
+		if denominator < 1 {
+			allErrs = append(allErrs, field.Invalid(fractionPath.Child("denominator"), denominator, "must be at least 1"))
+		}
+
+		if fraction.Numerator < 0 || fraction.Numerator > denominator {
+			allErrs = append(allErrs, field.Invalid(
+				fractionPath.Child("numerator"),
+				fraction.Numerator,
+				"must be between 0 and the denominator",
+			))
+		}
+	}
+
+	return allErrs
 }
 
 func validateFilterHeaderModifier(
@@ -10,6 +10,7 @@ import (
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation/validationfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 )
 
@@ -57,6 +58,40 @@ func TestValidateFilter(t *testing.T) {
 			expectErrCount: 1,
 			name:           "invalid HTTP mirror filter",
 		},
+		{
+			filter: Filter{
+				RouteType:  RouteTypeHTTP,
+				FilterType: FilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+					Fraction: &gatewayv1.Fraction{Numerator: 1, Denominator: helpers.GetPointer[int32](3)},
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid HTTP mirror filter with fraction",
+		},
+		{
+			filter: Filter{
+				RouteType:  RouteTypeHTTP,
+				FilterType: FilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+					Percent:  helpers.GetPointer[int32](101),
+					Fraction: &gatewayv1.Fraction{Numerator: 4, Denominator: helpers.GetPointer[int32](3)},
+				},
+			},
+			expectErrCount: 3,
+			name:           "invalid HTTP mirror filter with percent and fraction",
+		},
+		{
+			filter: Filter{
+				RouteType:  RouteTypeHTTP,
+				FilterType: FilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+					Fraction: &gatewayv1.Fraction{Numerator: 0, Denominator: helpers.GetPointer[int32](0)},
+				},
+			},
+			expectErrCount: 1,
+			name:           "invalid HTTP mirror filter with zero denominator",
+		},
 		{
 			filter: Filter{
 				RouteType:             RouteTypeHTTP,
@@ -91,3 +91,20 @@ func (r *configMapResolver) getResolvedConfigMaps() map[types.NamespacedName]*Ca
 
 	return resolved
 }
+
+// getConfigMapData returns the value of the key in the data or binaryData of the ConfigMap.
+func getConfigMapData(cm *apiv1.ConfigMap, key string) ([]byte, error) {
+	if cm == nil {
+		return nil, errors.New("ConfigMap does not exist")
+	}
+
+	if data, exists := cm.Data[key]; exists {
+		return []byte(data), nil
+	}
+
+	if data, exists := cm.BinaryData[key]; exists {
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("ConfigMap does not have the key %q", key)
+}
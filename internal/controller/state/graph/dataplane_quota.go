@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+)
+
+// dataPlaneUsage holds the resources of an nginx data plane that count towards the quota of its namespace.
+type dataPlaneUsage struct {
+	cpu      resource.Quantity
+	memory   resource.Quantity
+	replicas int32
+}
+
+func (u *dataPlaneUsage) add(other dataPlaneUsage) {
+	u.replicas += other.replicas
+	u.cpu.Add(other.cpu)
+	u.memory.Add(other.memory)
+}
+
+// quotaForGatewayClass returns the data plane quota configured in the NginxProxy of the GatewayClass.
+// The quota configured in the NginxProxy of a Gateway is ignored, so that Gateway owners can't raise it.
+func quotaForGatewayClass(gc *GatewayClass) *ngfAPIv1alpha2.QuotaSpec {
+	if gc == nil || !nginxProxyValid(gc.NginxProxy) || gc.NginxProxy.Source.Spec.Kubernetes == nil {
+		return nil
+	}
+
+	return gc.NginxProxy.Source.Spec.Kubernetes.Quota
+}
+
+// enforceDataPlaneQuotas marks the Gateways whose data planes would exceed the quota of their namespace.
+// The data planes of a namespace are admitted from the oldest Gateway to the newest one, so that creating
+// a Gateway doesn't take the resources of the data plane of an existing Gateway.
+// Merged Gateways and static data planes don't have their own provisioned data plane, so they are not counted.
+func enforceDataPlaneQuotas(gws map[types.NamespacedName]*Gateway, quota *ngfAPIv1alpha2.QuotaSpec) {
+	if quota == nil {
+		return
+	}
+
+	gwsByNamespace := make(map[string][]*Gateway)
+	for _, gw := range gws {
+		if !gw.Valid || gw.MergedInto != nil || StaticDataPlaneEnabledForNginxProxy(gw.EffectiveNginxProxy) {
+			continue
+		}
+
+		gwsByNamespace[gw.Source.Namespace] = append(gwsByNamespace[gw.Source.Namespace], gw)
+	}
+
+	for namespace, group := range gwsByNamespace {
+		slices.SortFunc(group, compareGatewaysByAge)
+
+		var used dataPlaneUsage
+		for _, gw := range group {
+			usage, err := buildDataPlaneUsage(gw.EffectiveNginxProxy, quota)
+			if err == nil {
+				err = checkDataPlaneQuota(used, usage, quota)
+			}
+
+			if err != nil {
+				msg := fmt.Sprintf("data plane exceeds the quota of namespace %q: %s", namespace, err)
+				gw.Conditions = append(gw.Conditions, conditions.NewGatewayDataPlaneQuotaExceeded(msg))
+				gw.DataPlaneQuotaExceeded = true
+
+				continue
+			}
+
+			used.add(usage)
+		}
+	}
+}
+
+// buildDataPlaneUsage returns the resources of the data plane configured in the NginxProxy.
+// The CPU and memory are only counted if the quota limits them.
+func buildDataPlaneUsage(np *EffectiveNginxProxy, quota *ngfAPIv1alpha2.QuotaSpec) (dataPlaneUsage, error) {
+	usage := dataPlaneUsage{replicas: 1}
+
+	var container ngfAPIv1alpha2.ContainerSpec
+	var pod ngfAPIv1alpha2.PodSpec
+
+	if np != nil && np.Kubernetes != nil {
+		switch k8s := np.Kubernetes; {
+		case k8s.DaemonSet != nil:
+			return dataPlaneUsage{}, errors.New("the number of Pods of a DaemonSet can't be limited")
+		case k8s.Deployment != nil:
+			deployment := k8s.Deployment
+			if deployment.Autoscaling != nil && deployment.Autoscaling.Enable {
+				usage.replicas = deployment.Autoscaling.MaxReplicas
+			} else if deployment.Replicas != nil {
+				usage.replicas = *deployment.Replicas
+			}
+
+			container = deployment.Container
+			pod = deployment.Pod
+		}
+	}
+
+	resources := make([]corev1.ResourceRequirements, 0, len(pod.Containers)+1)
+	if container.Resources != nil {
+		resources = append(resources, *container.Resources)
+	} else {
+		resources = append(resources, corev1.ResourceRequirements{})
+	}
+
+	for _, c := range pod.Containers {
+		resources = append(resources, c.Resources)
+	}
+
+	var err error
+	if quota.MaxCPU != nil {
+		if usage.cpu, err = podRequests(resources, corev1.ResourceCPU, usage.replicas); err != nil {
+			return dataPlaneUsage{}, err
+		}
+	}
+
+	if quota.MaxMemory != nil {
+		if usage.memory, err = podRequests(resources, corev1.ResourceMemory, usage.replicas); err != nil {
+			return dataPlaneUsage{}, err
+		}
+	}
+
+	return usage, nil
+}
+
+// podRequests returns the total amount of the resource requested by the containers of the replicas of a Pod.
+// Like Kubernetes, the limit of a container is used as its request if the container doesn't specify a request.
+func podRequests(
+	containers []corev1.ResourceRequirements,
+	name corev1.ResourceName,
+	replicas int32,
+) (resource.Quantity, error) {
+	var total resource.Quantity
+
+	for _, c := range containers {
+		request, ok := c.Requests[name]
+		if !ok {
+			request, ok = c.Limits[name]
+		}
+
+		if !ok {
+			return resource.Quantity{}, fmt.Errorf("all containers must specify %s requests or limits", name)
+		}
+
+		total.Add(request)
+	}
+
+	total.Mul(int64(replicas))
+
+	return total, nil
+}
+
+// checkDataPlaneQuota returns an error if the usage of a data plane doesn't fit into the remainder of the quota.
+func checkDataPlaneQuota(used, usage dataPlaneUsage, quota *ngfAPIv1alpha2.QuotaSpec) error {
+	if quota.MaxReplicas != nil && used.replicas+usage.replicas > *quota.MaxReplicas {
+		return fmt.Errorf(
+			"requires %d replicas, but only %d of the maximum %d replicas are available",
+			usage.replicas,
+			max(*quota.MaxReplicas-used.replicas, 0),
+			*quota.MaxReplicas,
+		)
+	}
+
+	checkQuantity := func(name corev1.ResourceName, used, usage resource.Quantity, limit *resource.Quantity) error {
+		if limit == nil {
+			return nil
+		}
+
+		total := used.DeepCopy()
+		total.Add(usage)
+		if total.Cmp(*limit) <= 0 {
+			return nil
+		}
+
+		available := limit.DeepCopy()
+		available.Sub(used)
+		if available.Sign() < 0 {
+			available = resource.Quantity{}
+		}
+
+		return fmt.Errorf(
+			"requires %s of %s, but only %s of the maximum %s are available",
+			usage.String(),
+			name,
+			available.String(),
+			limit.String(),
+		)
+	}
+
+	if err := checkQuantity(corev1.ResourceCPU, used.cpu, usage.cpu, quota.MaxCPU); err != nil {
+		return err
+	}
+
+	return checkQuantity(corev1.ResourceMemory, used.memory, usage.memory, quota.MaxMemory)
+}
@@ -0,0 +1,375 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestQuotaForGatewayClass(t *testing.T) {
+	t.Parallel()
+
+	quota := &ngfAPIv1alpha2.QuotaSpec{MaxReplicas: helpers.GetPointer[int32](2)}
+
+	tests := []struct {
+		gc       *GatewayClass
+		expected *ngfAPIv1alpha2.QuotaSpec
+		name     string
+	}{
+		{
+			name: "nil gatewayclass",
+		},
+		{
+			name: "no nginx proxy",
+			gc:   &GatewayClass{},
+		},
+		{
+			name: "invalid nginx proxy",
+			gc: &GatewayClass{
+				NginxProxy: &NginxProxy{
+					Source: &ngfAPIv1alpha2.NginxProxy{
+						Spec: ngfAPIv1alpha2.NginxProxySpec{
+							Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{Quota: quota},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no kubernetes spec",
+			gc: &GatewayClass{
+				NginxProxy: &NginxProxy{Source: &ngfAPIv1alpha2.NginxProxy{}, Valid: true},
+			},
+		},
+		{
+			name: "quota",
+			gc: &GatewayClass{
+				NginxProxy: &NginxProxy{
+					Source: &ngfAPIv1alpha2.NginxProxy{
+						Spec: ngfAPIv1alpha2.NginxProxySpec{
+							Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{Quota: quota},
+						},
+					},
+					Valid: true,
+				},
+			},
+			expected: quota,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(quotaForGatewayClass(test.gc)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildDataPlaneUsage(t *testing.T) {
+	t.Parallel()
+
+	resourceQuota := &ngfAPIv1alpha2.QuotaSpec{
+		MaxCPU:    helpers.GetPointer(resource.MustParse("4")),
+		MaxMemory: helpers.GetPointer(resource.MustParse("4Gi")),
+	}
+
+	resources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("500m"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+		},
+	}
+
+	tests := []struct {
+		np       *EffectiveNginxProxy
+		quota    *ngfAPIv1alpha2.QuotaSpec
+		name     string
+		expErr   string
+		expected dataPlaneUsage
+	}{
+		{
+			name:     "default deployment",
+			quota:    &ngfAPIv1alpha2.QuotaSpec{MaxReplicas: helpers.GetPointer[int32](2)},
+			expected: dataPlaneUsage{replicas: 1},
+		},
+		{
+			name: "replicas",
+			np: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{Replicas: helpers.GetPointer[int32](3)},
+				},
+			},
+			quota:    &ngfAPIv1alpha2.QuotaSpec{MaxReplicas: helpers.GetPointer[int32](2)},
+			expected: dataPlaneUsage{replicas: 3},
+		},
+		{
+			name: "autoscaling counts max replicas",
+			np: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+						Replicas: helpers.GetPointer[int32](3),
+						Autoscaling: &ngfAPIv1alpha2.AutoscalingSpec{
+							Enable:      true,
+							MaxReplicas: 5,
+						},
+					},
+				},
+			},
+			quota:    &ngfAPIv1alpha2.QuotaSpec{MaxReplicas: helpers.GetPointer[int32](2)},
+			expected: dataPlaneUsage{replicas: 5},
+		},
+		{
+			name: "requests and limits of all containers",
+			np: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+						Replicas:  helpers.GetPointer[int32](2),
+						Container: ngfAPIv1alpha2.ContainerSpec{Resources: resources},
+						Pod: ngfAPIv1alpha2.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "sidecar",
+									Resources: corev1.ResourceRequirements{
+										Requests: corev1.ResourceList{
+											corev1.ResourceCPU:    resource.MustParse("100m"),
+											corev1.ResourceMemory: resource.MustParse("64Mi"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			quota: resourceQuota,
+			expected: dataPlaneUsage{
+				replicas: 2,
+				cpu:      resource.MustParse("1200m"),
+				memory:   resource.MustParse("640Mi"),
+			},
+		},
+		{
+			name: "resources are not required without cpu and memory quota",
+			np: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{},
+				},
+			},
+			quota:    &ngfAPIv1alpha2.QuotaSpec{MaxReplicas: helpers.GetPointer[int32](2)},
+			expected: dataPlaneUsage{replicas: 1},
+		},
+		{
+			name: "missing resources",
+			np: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{},
+				},
+			},
+			quota:  resourceQuota,
+			expErr: "all containers must specify cpu requests or limits",
+		},
+		{
+			name: "missing memory",
+			np: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+						Container: ngfAPIv1alpha2.ContainerSpec{
+							Resources: &corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+							},
+						},
+					},
+				},
+			},
+			quota:  resourceQuota,
+			expErr: "all containers must specify memory requests or limits",
+		},
+		{
+			name: "daemonset",
+			np: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					DaemonSet: &ngfAPIv1alpha2.DaemonSetSpec{},
+				},
+			},
+			quota:  &ngfAPIv1alpha2.QuotaSpec{MaxReplicas: helpers.GetPointer[int32](2)},
+			expErr: "the number of Pods of a DaemonSet can't be limited",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			usage, err := buildDataPlaneUsage(test.np, test.quota)
+			if test.expErr != "" {
+				g.Expect(err).To(MatchError(test.expErr))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(usage.replicas).To(Equal(test.expected.replicas))
+			g.Expect(usage.cpu.Cmp(test.expected.cpu)).To(BeZero())
+			g.Expect(usage.memory.Cmp(test.expected.memory)).To(BeZero())
+		})
+	}
+}
+
+func TestCheckDataPlaneQuota(t *testing.T) {
+	t.Parallel()
+
+	quota := &ngfAPIv1alpha2.QuotaSpec{
+		MaxReplicas: helpers.GetPointer[int32](4),
+		MaxCPU:      helpers.GetPointer(resource.MustParse("2")),
+		MaxMemory:   helpers.GetPointer(resource.MustParse("1Gi")),
+	}
+
+	used := dataPlaneUsage{
+		replicas: 3,
+		cpu:      resource.MustParse("1500m"),
+		memory:   resource.MustParse("512Mi"),
+	}
+
+	tests := []struct {
+		name   string
+		expErr string
+		usage  dataPlaneUsage
+	}{
+		{
+			name: "fits",
+			usage: dataPlaneUsage{
+				replicas: 1,
+				cpu:      resource.MustParse("500m"),
+				memory:   resource.MustParse("512Mi"),
+			},
+		},
+		{
+			name:   "too many replicas",
+			usage:  dataPlaneUsage{replicas: 2},
+			expErr: "requires 2 replicas, but only 1 of the maximum 4 replicas are available",
+		},
+		{
+			name: "too much cpu",
+			usage: dataPlaneUsage{
+				replicas: 1,
+				cpu:      resource.MustParse("1"),
+			},
+			expErr: "requires 1 of cpu, but only 500m of the maximum 2 are available",
+		},
+		{
+			name: "too much memory",
+			usage: dataPlaneUsage{
+				replicas: 1,
+				memory:   resource.MustParse("1Gi"),
+			},
+			expErr: "requires 1Gi of memory, but only 512Mi of the maximum 1Gi are available",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := checkDataPlaneQuota(used, test.usage, quota)
+			if test.expErr != "" {
+				g.Expect(err).To(MatchError(test.expErr))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestEnforceDataPlaneQuotas(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	createGateway := func(namespace, name string, created time.Time, replicas int32) *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         namespace,
+					Name:              name,
+					CreationTimestamp: metav1.NewTime(created),
+				},
+			},
+			EffectiveNginxProxy: &EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{Replicas: helpers.GetPointer(replicas)},
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	oldest := createGateway("test", "oldest", now.Add(-time.Hour), 2)
+	newer := createGateway("test", "newer", now.Add(-time.Minute), 1)
+	newest := createGateway("test", "newest", now, 1)
+	otherNs := createGateway("other", "other-ns", now, 3)
+
+	merged := createGateway("test", "merged", now.Add(-2*time.Hour), 5)
+	merged.MergedInto = &types.NamespacedName{Namespace: "test", Name: "oldest"}
+
+	static := createGateway("test", "static", now.Add(-2*time.Hour), 5)
+	static.EffectiveNginxProxy.StaticDataPlane = &ngfAPIv1alpha2.StaticDataPlane{TokenSecretName: "token"}
+
+	invalid := createGateway("test", "invalid", now.Add(-2*time.Hour), 5)
+	invalid.Valid = false
+
+	gws := map[types.NamespacedName]*Gateway{}
+	for _, gw := range []*Gateway{oldest, newer, newest, otherNs, merged, static, invalid} {
+		gws[types.NamespacedName{Namespace: gw.Source.Namespace, Name: gw.Source.Name}] = gw
+	}
+
+	enforceDataPlaneQuotas(gws, &ngfAPIv1alpha2.QuotaSpec{MaxReplicas: helpers.GetPointer[int32](3)})
+
+	g := NewWithT(t)
+
+	for _, gw := range []*Gateway{oldest, newer, otherNs, merged, static, invalid} {
+		g.Expect(gw.DataPlaneQuotaExceeded).To(BeFalse())
+		g.Expect(gw.Conditions).To(BeEmpty())
+	}
+
+	g.Expect(newest.DataPlaneQuotaExceeded).To(BeTrue())
+	g.Expect(newest.Conditions).To(Equal([]conditions.Condition{
+		conditions.NewGatewayDataPlaneQuotaExceeded(
+			"data plane exceeds the quota of namespace \"test\": " +
+				"requires 1 replicas, but only 0 of the maximum 3 replicas are available",
+		),
+	}))
+}
+
+func TestEnforceDataPlaneQuotas_NoQuota(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gw := &Gateway{
+		Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gw"}},
+		EffectiveNginxProxy: &EffectiveNginxProxy{
+			Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{DaemonSet: &ngfAPIv1alpha2.DaemonSetSpec{}},
+		},
+		Valid: true,
+	}
+
+	enforceDataPlaneQuotas(map[types.NamespacedName]*Gateway{{Namespace: "test", Name: "gw"}: gw}, nil)
+
+	g.Expect(gw.DataPlaneQuotaExceeded).To(BeFalse())
+	g.Expect(gw.Conditions).To(BeEmpty())
+}
@@ -0,0 +1,159 @@
+package graph
+
+import (
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+const defaultDirectResponseContentType = "text/plain"
+
+// DirectResponseFilter represents a ngfAPI.DirectResponseFilter.
+type DirectResponseFilter struct {
+	// Source is the DirectResponseFilter.
+	Source *ngfAPI.DirectResponseFilter
+	// ConfigMap is the ConfigMap that holds the body of the response.
+	// It is nil if the body is not stored in a ConfigMap.
+	ConfigMap *types.NamespacedName
+	// ContentType is the content type of the response.
+	ContentType string
+	// Body is the resolved body of the response.
+	Body string
+	// Conditions define the conditions to be reported in the status of the DirectResponseFilter.
+	Conditions []conditions.Condition
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Valid indicates whether the DirectResponseFilter is semantically and syntactically valid,
+	// and whether its body was resolved.
+	Valid bool
+	// Referenced indicates whether the DirectResponseFilter is referenced by a Route.
+	Referenced bool
+}
+
+// getDirectResponseFilterResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to a DirectResponseFilter in the given namespace.
+// If the DirectResponseFilter exists, it is marked as referenced and returned as an ExtensionRefFilter.
+func getDirectResponseFilterResolverForNamespace(
+	directResponseFilters map[types.NamespacedName]*DirectResponseFilter,
+	ns string,
+) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		if len(directResponseFilters) == 0 {
+			return nil
+		}
+
+		if ref.Group != ngfAPI.GroupName || ref.Kind != kinds.DirectResponseFilter {
+			return nil
+		}
+
+		drf := directResponseFilters[types.NamespacedName{Namespace: ns, Name: string(ref.Name)}]
+		if drf == nil {
+			return nil
+		}
+
+		drf.Referenced = true
+
+		return &ExtensionRefFilter{DirectResponseFilter: drf, Valid: drf.Valid}
+	}
+}
+
+func processDirectResponseFilters(
+	directResponseFilters map[types.NamespacedName]*ngfAPI.DirectResponseFilter,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	validator validation.GenericValidator,
+) map[types.NamespacedName]*DirectResponseFilter {
+	if len(directResponseFilters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*DirectResponseFilter)
+
+	for nsname, drf := range directResponseFilters {
+		filter := &DirectResponseFilter{
+			Source:      drf,
+			StatusCode:  int(drf.Spec.StatusCode),
+			ContentType: defaultDirectResponseContentType,
+			Valid:       true,
+		}
+
+		if body := drf.Spec.Body; body != nil && body.ConfigMap != nil {
+			filter.ConfigMap = &types.NamespacedName{Namespace: nsname.Namespace, Name: body.ConfigMap.Name}
+		}
+
+		if ct := drf.Spec.ContentType; ct != nil {
+			if err := validator.ValidateContentType(*ct); err != nil {
+				err := field.Invalid(field.NewPath("spec").Child("contentType"), *ct, err.Error())
+				filter.Valid = false
+				filter.Conditions = []conditions.Condition{conditions.NewDirectResponseFilterInvalid(err.Error())}
+				processed[nsname] = filter
+
+				continue
+			}
+
+			filter.ContentType = *ct
+		}
+
+		body, err := resolveDirectResponseBody(drf, configMaps)
+		if err != nil {
+			filter.Valid = false
+			filter.Conditions = []conditions.Condition{conditions.NewDirectResponseFilterInvalid(err.Error())}
+		} else {
+			filter.Body = body
+		}
+
+		processed[nsname] = filter
+	}
+
+	return processed
+}
+
+// resolveDirectResponseBody returns the body of the DirectResponseFilter. The body is either inline or stored
+// in a ConfigMap. Since the body is used in the return directive of NGINX, it must not contain '$'
+// to prevent variable expansion.
+func resolveDirectResponseBody(
+	drf *ngfAPI.DirectResponseFilter,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) (string, error) {
+	bodyPath := field.NewPath("spec").Child("body")
+
+	if drf.Spec.Body == nil {
+		return "", nil
+	}
+
+	var body string
+
+	switch {
+	case drf.Spec.Body.Inline != nil:
+		bodyPath = bodyPath.Child("inline")
+		body = *drf.Spec.Body.Inline
+	case drf.Spec.Body.ConfigMap != nil:
+		ref := drf.Spec.Body.ConfigMap
+		bodyPath = bodyPath.Child("configMap")
+
+		data, err := getConfigMapData(
+			configMaps[types.NamespacedName{Namespace: drf.Namespace, Name: ref.Name}],
+			ref.Key,
+		)
+		if err != nil {
+			return "", field.Invalid(bodyPath, ref.Name, err.Error())
+		}
+
+		body = string(data)
+	default:
+		return "", field.Required(bodyPath, "one of inline or configMap must be set")
+	}
+
+	if strings.Contains(body, "$") {
+		return "", field.Forbidden(bodyPath, "must not contain '$'")
+	}
+
+	return body, nil
+}
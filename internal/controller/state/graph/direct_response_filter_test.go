@@ -0,0 +1,307 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation/validationfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func createDirectResponseFilter(name string, spec ngfAPI.DirectResponseFilterSpec) *ngfAPI.DirectResponseFilter {
+	return &ngfAPI.DirectResponseFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: spec,
+	}
+}
+
+func TestProcessDirectResponseFilters(t *testing.T) {
+	t.Parallel()
+
+	cmNsName := types.NamespacedName{Namespace: "test", Name: "bodies"}
+	configMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		cmNsName: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: cmNsName.Namespace, Name: cmNsName.Name},
+			Data: map[string]string{
+				"robots.txt":  "User-agent: *\nDisallow: /",
+				"invalid.txt": "$remote_addr",
+			},
+			BinaryData: map[string][]byte{
+				"maintenance.json": []byte(`{"message": "maintenance"}`),
+			},
+		},
+	}
+
+	tests := []struct {
+		filter *ngfAPI.DirectResponseFilter
+		exp    *DirectResponseFilter
+		name   string
+	}{
+		{
+			name:   "no body",
+			filter: createDirectResponseFilter("no-body", ngfAPI.DirectResponseFilterSpec{StatusCode: 204}),
+			exp: &DirectResponseFilter{
+				StatusCode:  204,
+				ContentType: "text/plain",
+				Valid:       true,
+			},
+		},
+		{
+			name: "inline body",
+			filter: createDirectResponseFilter("inline", ngfAPI.DirectResponseFilterSpec{
+				StatusCode:  503,
+				ContentType: helpers.GetPointer("text/html; charset=utf-8"),
+				Body: &ngfAPI.DirectResponseBody{
+					Inline: helpers.GetPointer(`<h1 class="down">Down</h1>`),
+				},
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  503,
+				ContentType: "text/html; charset=utf-8",
+				Body:        `<h1 class="down">Down</h1>`,
+				Valid:       true,
+			},
+		},
+		{
+			name: "body from ConfigMap data",
+			filter: createDirectResponseFilter("cm-data", ngfAPI.DirectResponseFilterSpec{
+				StatusCode: 200,
+				Body: &ngfAPI.DirectResponseBody{
+					ConfigMap: &ngfAPI.DirectResponseConfigMapRef{Name: "bodies", Key: "robots.txt"},
+				},
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  200,
+				ContentType: "text/plain",
+				Body:        "User-agent: *\nDisallow: /",
+				ConfigMap:   &cmNsName,
+				Valid:       true,
+			},
+		},
+		{
+			name: "body from ConfigMap binaryData",
+			filter: createDirectResponseFilter("cm-binary-data", ngfAPI.DirectResponseFilterSpec{
+				StatusCode:  503,
+				ContentType: helpers.GetPointer("application/json"),
+				Body: &ngfAPI.DirectResponseBody{
+					ConfigMap: &ngfAPI.DirectResponseConfigMapRef{Name: "bodies", Key: "maintenance.json"},
+				},
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  503,
+				ContentType: "application/json",
+				Body:        `{"message": "maintenance"}`,
+				ConfigMap:   &cmNsName,
+				Valid:       true,
+			},
+		},
+		{
+			name: "ConfigMap does not exist",
+			filter: createDirectResponseFilter("cm-dne", ngfAPI.DirectResponseFilterSpec{
+				StatusCode: 200,
+				Body: &ngfAPI.DirectResponseBody{
+					ConfigMap: &ngfAPI.DirectResponseConfigMapRef{Name: "dne", Key: "robots.txt"},
+				},
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  200,
+				ContentType: "text/plain",
+				ConfigMap:   &types.NamespacedName{Namespace: "test", Name: "dne"},
+				Conditions: []conditions.Condition{
+					conditions.NewDirectResponseFilterInvalid(
+						"spec.body.configMap: Invalid value: \"dne\": ConfigMap does not exist",
+					),
+				},
+			},
+		},
+		{
+			name: "ConfigMap key does not exist",
+			filter: createDirectResponseFilter("cm-key-dne", ngfAPI.DirectResponseFilterSpec{
+				StatusCode: 200,
+				Body: &ngfAPI.DirectResponseBody{
+					ConfigMap: &ngfAPI.DirectResponseConfigMapRef{Name: "bodies", Key: "dne"},
+				},
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  200,
+				ContentType: "text/plain",
+				ConfigMap:   &cmNsName,
+				Conditions: []conditions.Condition{
+					conditions.NewDirectResponseFilterInvalid(
+						"spec.body.configMap: Invalid value: \"bodies\": ConfigMap does not have the key \"dne\"",
+					),
+				},
+			},
+		},
+		{
+			name: "ConfigMap body contains variable",
+			filter: createDirectResponseFilter("cm-var", ngfAPI.DirectResponseFilterSpec{
+				StatusCode: 200,
+				Body: &ngfAPI.DirectResponseBody{
+					ConfigMap: &ngfAPI.DirectResponseConfigMapRef{Name: "bodies", Key: "invalid.txt"},
+				},
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  200,
+				ContentType: "text/plain",
+				ConfigMap:   &cmNsName,
+				Conditions: []conditions.Condition{
+					conditions.NewDirectResponseFilterInvalid("spec.body.configMap: Forbidden: must not contain '$'"),
+				},
+			},
+		},
+		{
+			name: "inline body contains variable",
+			filter: createDirectResponseFilter("inline-var", ngfAPI.DirectResponseFilterSpec{
+				StatusCode: 200,
+				Body: &ngfAPI.DirectResponseBody{
+					Inline: helpers.GetPointer("$host"),
+				},
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  200,
+				ContentType: "text/plain",
+				Conditions: []conditions.Condition{
+					conditions.NewDirectResponseFilterInvalid("spec.body.inline: Forbidden: must not contain '$'"),
+				},
+			},
+		},
+		{
+			name: "invalid content type",
+			filter: createDirectResponseFilter("invalid-content-type", ngfAPI.DirectResponseFilterSpec{
+				StatusCode:  200,
+				ContentType: helpers.GetPointer(`text/plain"`),
+			}),
+			exp: &DirectResponseFilter{
+				StatusCode:  200,
+				ContentType: "text/plain",
+				Conditions: []conditions.Condition{
+					conditions.NewDirectResponseFilterInvalid(
+						"spec.contentType: Invalid value: \"text/plain\\\"\": invalid content type",
+					),
+				},
+			},
+		},
+	}
+
+	validator := &validationfakes.FakeGenericValidator{
+		ValidateContentTypeStub: func(contentType string) error {
+			if contentType == `text/plain"` {
+				return errors.New("invalid content type")
+			}
+			return nil
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			nsname := types.NamespacedName{Namespace: test.filter.Namespace, Name: test.filter.Name}
+			processed := processDirectResponseFilters(
+				map[types.NamespacedName]*ngfAPI.DirectResponseFilter{nsname: test.filter},
+				configMaps,
+				validator,
+			)
+
+			test.exp.Source = test.filter
+			g.Expect(processed).To(HaveKeyWithValue(nsname, test.exp))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(processDirectResponseFilters(nil, configMaps, validator)).To(BeNil())
+}
+
+func TestGetDirectResponseFilterResolverForNamespace(t *testing.T) {
+	t.Parallel()
+
+	createFilters := func() map[types.NamespacedName]*DirectResponseFilter {
+		return map[types.NamespacedName]*DirectResponseFilter{
+			{Namespace: "test", Name: "valid"}: {
+				Source: createDirectResponseFilter("valid", ngfAPI.DirectResponseFilterSpec{}),
+				Valid:  true,
+			},
+			{Namespace: "test", Name: "invalid"}: {
+				Source: createDirectResponseFilter("invalid", ngfAPI.DirectResponseFilterSpec{}),
+				Valid:  false,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ref        v1.LocalObjectReference
+		filters    map[types.NamespacedName]*DirectResponseFilter
+		namespace  string
+		expResolve bool
+		expValid   bool
+	}{
+		{
+			name:      "no filters",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.DirectResponseFilter, Name: "valid"},
+			namespace: "test",
+		},
+		{
+			name:      "wrong kind",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.SnippetsFilter, Name: "valid"},
+			filters:   createFilters(),
+			namespace: "test",
+		},
+		{
+			name:      "wrong namespace",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.DirectResponseFilter, Name: "valid"},
+			filters:   createFilters(),
+			namespace: "other",
+		},
+		{
+			name:       "valid filter",
+			ref:        v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.DirectResponseFilter, Name: "valid"},
+			filters:    createFilters(),
+			namespace:  "test",
+			expResolve: true,
+			expValid:   true,
+		},
+		{
+			name:       "invalid filter",
+			ref:        v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.DirectResponseFilter, Name: "invalid"},
+			filters:    createFilters(),
+			namespace:  "test",
+			expResolve: true,
+			expValid:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolve := getDirectResponseFilterResolverForNamespace(test.filters, test.namespace)
+			resolved := resolve(test.ref)
+			if !test.expResolve {
+				g.Expect(resolved).To(BeNil())
+				return
+			}
+
+			g.Expect(resolved).ToNot(BeNil())
+			g.Expect(resolved.DirectResponseFilter).ToNot(BeNil())
+			g.Expect(resolved.DirectResponseFilter.Referenced).To(BeTrue())
+			g.Expect(resolved.DirectResponseFilter.Source.Name).To(BeEquivalentTo(test.ref.Name))
+			g.Expect(resolved.Valid).To(Equal(test.expValid))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package graph
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+// ErrorPageResources holds the resolved resources referenced by the error pages of an ErrorPagePolicy.
+type ErrorPageResources struct {
+	// StaticBodies holds the response bodies of the static error pages, keyed by the index of the error page.
+	StaticBodies map[int][]byte
+	// BackendRefs holds the backends of the Service error pages, keyed by the index of the error page.
+	BackendRefs map[int]BackendRef
+}
+
+// processErrorPagePolicies resolves the ConfigMaps and Services referenced by the ErrorPagePolicies.
+// If a referenced resource cannot be resolved, the ErrorPagePolicy is marked as invalid.
+// It returns the resolved resources of every ErrorPagePolicy and all ConfigMaps referenced by ErrorPagePolicies,
+// including ones that don't exist.
+func processErrorPagePolicies(
+	pols map[PolicyKey]*Policy,
+	configMaps map[types.NamespacedName]*v1.ConfigMap,
+	services map[types.NamespacedName]*v1.Service,
+) (map[types.NamespacedName]*ErrorPageResources, map[types.NamespacedName]struct{}) {
+	var resources map[types.NamespacedName]*ErrorPageResources
+	var referencedConfigMaps map[types.NamespacedName]struct{}
+
+	for key, policy := range pols {
+		if key.GVK.Kind != kinds.ErrorPagePolicy {
+			continue
+		}
+
+		epp := helpers.MustCastObject[*ngfAPIv1alpha1.ErrorPagePolicy](policy.Source)
+
+		res := &ErrorPageResources{
+			StaticBodies: make(map[int][]byte),
+			BackendRefs:  make(map[int]BackendRef),
+		}
+
+		var allErrs field.ErrorList
+		pagesPath := field.NewPath("spec").Child("errorPages")
+
+		for idx, page := range epp.Spec.ErrorPages {
+			switch {
+			case page.Static != nil:
+				cmNsName := types.NamespacedName{Namespace: epp.Namespace, Name: page.Static.ConfigMapName}
+				if referencedConfigMaps == nil {
+					referencedConfigMaps = make(map[types.NamespacedName]struct{})
+				}
+				referencedConfigMaps[cmNsName] = struct{}{}
+
+				body, err := getConfigMapData(configMaps[cmNsName], page.Static.Key)
+				if err != nil {
+					allErrs = append(allErrs, field.Invalid(pagesPath.Index(idx).Child("static"), cmNsName.Name, err.Error()))
+					continue
+				}
+
+				res.StaticBodies[idx] = body
+			case page.Service != nil:
+				svcNsName := types.NamespacedName{Namespace: epp.Namespace, Name: page.Service.Name}
+				servicePath := pagesPath.Index(idx).Child("service")
+
+				svc, exists := services[svcNsName]
+				if !exists {
+					allErrs = append(allErrs, field.NotFound(servicePath.Child("name"), page.Service.Name))
+					continue
+				}
+
+				svcPort, err := getServicePort(svc, page.Service.Port)
+				if err != nil {
+					allErrs = append(allErrs, field.Invalid(servicePath.Child("port"), page.Service.Port, err.Error()))
+					continue
+				}
+
+				res.BackendRefs[idx] = BackendRef{
+					SvcNsName:   svcNsName,
+					ServicePort: svcPort,
+					Weight:      1,
+					Valid:       true,
+				}
+			}
+		}
+
+		if len(allErrs) > 0 {
+			policy.Valid = false
+			policy.Conditions = append(policy.Conditions, conditions.NewPolicyInvalid(allErrs.ToAggregate().Error()))
+			continue
+		}
+
+		if resources == nil {
+			resources = make(map[types.NamespacedName]*ErrorPageResources)
+		}
+		resources[key.NsName] = res
+	}
+
+	return resources, referencedConfigMaps
+}
+
+// addServicesForErrorPagePolicies adds the Services referenced by the ErrorPagePolicies to the referenced Services,
+// so that changes to the Services and their endpoints are tracked for the Gateways the policies apply to.
+func addServicesForErrorPagePolicies(
+	pols map[PolicyKey]*Policy,
+	routes map[RouteKey]*L7Route,
+	referencedServices map[types.NamespacedName]*ReferencedService,
+) map[types.NamespacedName]*ReferencedService {
+	for key, policy := range pols {
+		if key.GVK.Kind != kinds.ErrorPagePolicy {
+			continue
+		}
+
+		epp := helpers.MustCastObject[*ngfAPIv1alpha1.ErrorPagePolicy](policy.Source)
+
+		gateways := make(map[types.NamespacedName]struct{})
+		for _, ref := range policy.TargetRefs {
+			switch ref.Kind {
+			case kinds.Gateway:
+				gateways[ref.Nsname] = struct{}{}
+			case kinds.HTTPRoute:
+				route, exists := routes[routeKeyForKind(ref.Kind, ref.Nsname)]
+				if !exists {
+					continue
+				}
+
+				for _, parentRef := range route.ParentRefs {
+					if parentRef.Gateway != nil {
+						gateways[parentRef.Gateway.NamespacedName] = struct{}{}
+					}
+				}
+			}
+		}
+
+		for _, page := range epp.Spec.ErrorPages {
+			if page.Service == nil {
+				continue
+			}
+
+			svcNsName := types.NamespacedName{Namespace: epp.Namespace, Name: page.Service.Name}
+
+			if referencedServices == nil {
+				referencedServices = make(map[types.NamespacedName]*ReferencedService)
+			}
+
+			if _, exists := referencedServices[svcNsName]; !exists {
+				referencedServices[svcNsName] = &ReferencedService{
+					GatewayNsNames: make(map[types.NamespacedName]struct{}),
+				}
+			}
+
+			for gw := range gateways {
+				referencedServices[svcNsName].GatewayNsNames[gw] = struct{}{}
+			}
+		}
+	}
+
+	return referencedServices
+}
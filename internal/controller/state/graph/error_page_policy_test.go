@@ -0,0 +1,238 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func createErrorPagePolicy(pages ...ngfAPIv1alpha1.ErrorPage) *ngfAPIv1alpha1.ErrorPagePolicy {
+	return &ngfAPIv1alpha1.ErrorPagePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "epp",
+		},
+		Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+			ErrorPages: pages,
+		},
+	}
+}
+
+func TestProcessErrorPagePolicies(t *testing.T) {
+	t.Parallel()
+
+	eppGVK := schema.GroupVersionKind{Group: ngfAPIv1alpha1.GroupName, Kind: kinds.ErrorPagePolicy}
+	eppKey := PolicyKey{
+		NsName: types.NamespacedName{Namespace: testNs, Name: "epp"},
+		GVK:    eppGVK,
+	}
+
+	staticPage := ngfAPIv1alpha1.ErrorPage{
+		Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+		Static: &ngfAPIv1alpha1.ErrorPageStatic{
+			ConfigMapName: "error-pages",
+			Key:           "404.html",
+		},
+	}
+	binaryPage := ngfAPIv1alpha1.ErrorPage{
+		Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{403},
+		Static: &ngfAPIv1alpha1.ErrorPageStatic{
+			ConfigMapName: "error-pages",
+			Key:           "403.html",
+		},
+	}
+	servicePage := ngfAPIv1alpha1.ErrorPage{
+		Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{502},
+		Service: &ngfAPIv1alpha1.ErrorPageService{
+			Name: "errors",
+			Port: 80,
+		},
+	}
+	redirectPage := ngfAPIv1alpha1.ErrorPage{
+		Codes:    []ngfAPIv1alpha1.ErrorPageStatusCode{500},
+		Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{URL: "https://example.com/error"},
+	}
+
+	svcPort := v1.ServicePort{Name: "http", Port: 80}
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: testNs, Name: "error-pages"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "error-pages"},
+			Data: map[string]string{
+				"404.html": "not found",
+			},
+			BinaryData: map[string][]byte{
+				"403.html": []byte("forbidden"),
+			},
+		},
+	}
+	services := map[types.NamespacedName]*v1.Service{
+		{Namespace: testNs, Name: "errors"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "errors"},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{svcPort},
+			},
+		},
+	}
+
+	tests := []struct {
+		epp              *ngfAPIv1alpha1.ErrorPagePolicy
+		expResources     map[types.NamespacedName]*ErrorPageResources
+		expReferencedCMs map[types.NamespacedName]struct{}
+		name             string
+		expConditions    []conditions.Condition
+		expValid         bool
+	}{
+		{
+			name: "all resources are resolved",
+			epp:  createErrorPagePolicy(staticPage, binaryPage, servicePage, redirectPage),
+			expResources: map[types.NamespacedName]*ErrorPageResources{
+				eppKey.NsName: {
+					StaticBodies: map[int][]byte{
+						0: []byte("not found"),
+						1: []byte("forbidden"),
+					},
+					BackendRefs: map[int]BackendRef{
+						2: {
+							SvcNsName:   types.NamespacedName{Namespace: testNs, Name: "errors"},
+							ServicePort: svcPort,
+							Weight:      1,
+							Valid:       true,
+						},
+					},
+				},
+			},
+			expReferencedCMs: map[types.NamespacedName]struct{}{
+				{Namespace: testNs, Name: "error-pages"}: {},
+			},
+			expValid: true,
+		},
+		{
+			name: "missing resources",
+			epp: createErrorPagePolicy(
+				ngfAPIv1alpha1.ErrorPage{
+					Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+					Static: &ngfAPIv1alpha1.ErrorPageStatic{
+						ConfigMapName: "does-not-exist",
+						Key:           "404.html",
+					},
+				},
+				ngfAPIv1alpha1.ErrorPage{
+					Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{410},
+					Static: &ngfAPIv1alpha1.ErrorPageStatic{
+						ConfigMapName: "error-pages",
+						Key:           "410.html",
+					},
+				},
+				ngfAPIv1alpha1.ErrorPage{
+					Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{502},
+					Service: &ngfAPIv1alpha1.ErrorPageService{
+						Name: "does-not-exist",
+						Port: 80,
+					},
+				},
+				ngfAPIv1alpha1.ErrorPage{
+					Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{503},
+					Service: &ngfAPIv1alpha1.ErrorPageService{
+						Name: "errors",
+						Port: 8080,
+					},
+				},
+			),
+			expReferencedCMs: map[types.NamespacedName]struct{}{
+				{Namespace: testNs, Name: "does-not-exist"}: {},
+				{Namespace: testNs, Name: "error-pages"}:    {},
+			},
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.errorPages[0].static: Invalid value: \"does-not-exist\": " +
+					"ConfigMap does not exist, spec.errorPages[1].static: Invalid value: \"error-pages\": " +
+					"ConfigMap does not have the key \"410.html\", spec.errorPages[2].service.name: " +
+					"Not found: \"does-not-exist\", spec.errorPages[3].service.port: Invalid value: 8080: " +
+					"no matching port for Service errors and port 8080]"),
+			},
+			expValid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &Policy{
+				Source: test.epp,
+				Valid:  true,
+			}
+			pols := map[PolicyKey]*Policy{
+				eppKey: policy,
+				{NsName: types.NamespacedName{Namespace: testNs, Name: "other"}}: {
+					Source: &ngfAPIv1alpha1.ClientSettingsPolicy{},
+					Valid:  true,
+				},
+			}
+
+			resources, referencedCMs := processErrorPagePolicies(pols, configMaps, services)
+			g.Expect(resources).To(Equal(test.expResources))
+			g.Expect(referencedCMs).To(Equal(test.expReferencedCMs))
+			g.Expect(policy.Valid).To(Equal(test.expValid))
+			g.Expect(policy.Conditions).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestAddServicesForErrorPagePolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: testNs, Name: "gw"}
+	routeGwNsName := types.NamespacedName{Namespace: testNs, Name: "route-gw"}
+	routeNsName := types.NamespacedName{Namespace: testNs, Name: "hr"}
+
+	routes := map[RouteKey]*L7Route{
+		CreateRouteKey(&gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "hr"}}): {
+			ParentRefs: []ParentRef{
+				{Gateway: &ParentRefGateway{NamespacedName: routeGwNsName}},
+			},
+		},
+	}
+
+	epp := createErrorPagePolicy(ngfAPIv1alpha1.ErrorPage{
+		Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{502},
+		Service: &ngfAPIv1alpha1.ErrorPageService{
+			Name: "errors",
+			Port: 80,
+		},
+	})
+
+	pols := map[PolicyKey]*Policy{
+		{
+			NsName: types.NamespacedName{Namespace: testNs, Name: "epp"},
+			GVK:    schema.GroupVersionKind{Group: ngfAPIv1alpha1.GroupName, Kind: kinds.ErrorPagePolicy},
+		}: {
+			Source: epp,
+			TargetRefs: []PolicyTargetRef{
+				{Kind: kinds.Gateway, Group: gatewayv1.GroupName, Nsname: gwNsName},
+				{Kind: kinds.HTTPRoute, Group: gatewayv1.GroupName, Nsname: routeNsName},
+			},
+		},
+	}
+
+	referencedServices := addServicesForErrorPagePolicies(pols, routes, nil)
+	g.Expect(referencedServices).To(Equal(map[types.NamespacedName]*ReferencedService{
+		{Namespace: testNs, Name: "errors"}: {
+			GatewayNsNames: map[types.NamespacedName]struct{}{
+				gwNsName:      {},
+				routeGwNsName: {},
+			},
+		},
+	}))
+}
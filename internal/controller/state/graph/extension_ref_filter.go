@@ -1,6 +1,9 @@
 package graph
 
 import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -12,17 +15,63 @@ import (
 type ExtensionRefFilter struct {
 	// SnippetsFilter contains the SnippetsFilter. Will be non-nil if the Ref.Kind is SnippetsFilter and the
 	// SnippetsFilter exists.
-	// Once we support more filters, we can extend this struct with more filter kinds.
 	SnippetsFilter *SnippetsFilter
+	// DirectResponseFilter contains the DirectResponseFilter. Will be non-nil if the Ref.Kind is
+	// DirectResponseFilter and the DirectResponseFilter exists.
+	DirectResponseFilter *DirectResponseFilter
+	// GRPCRewriteFilter contains the GRPCRewriteFilter. Will be non-nil if the Ref.Kind is
+	// GRPCRewriteFilter and the GRPCRewriteFilter exists.
+	GRPCRewriteFilter *GRPCRewriteFilter
+	// GRPCWebFilter contains the GRPCWebFilter. Will be non-nil if the Ref.Kind is
+	// GRPCWebFilter and the GRPCWebFilter exists.
+	GRPCWebFilter *GRPCWebFilter
 	// Valid indicates whether the filter is valid.
 	Valid bool
 }
 
+var supportedGRPCExtRefKinds = []v1.Kind{kinds.SnippetsFilter, kinds.GRPCRewriteFilter, kinds.GRPCWebFilter}
+
+var supportedHTTPExtRefKinds = []v1.Kind{kinds.SnippetsFilter, kinds.DirectResponseFilter}
+
 // resolveExtRefFilter resolves a LocalObjectReference to an *ExtensionRefFilter.
 // If it cannot be resolved, *ExtensionRefFilter will be nil.
 type resolveExtRefFilter func(ref v1.LocalObjectReference) *ExtensionRefFilter
 
-func validateExtensionRefFilter(ref *v1.LocalObjectReference, path *field.Path) field.ErrorList {
+// getExtRefFilterResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to any of the supported NGF filters in the given namespace.
+func getExtRefFilterResolverForNamespace(
+	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	directResponseFilters map[types.NamespacedName]*DirectResponseFilter,
+	grpcRewriteFilters map[types.NamespacedName]*GRPCRewriteFilter,
+	grpcWebFilters map[types.NamespacedName]*GRPCWebFilter,
+	ns string,
+) resolveExtRefFilter {
+	resolveSnippetsFilter := getSnippetsFilterResolverForNamespace(snippetsFilters, ns)
+	resolveDirectResponseFilter := getDirectResponseFilterResolverForNamespace(directResponseFilters, ns)
+	resolveGRPCRewriteFilter := getGRPCRewriteFilterResolverForNamespace(grpcRewriteFilters, ns)
+	resolveGRPCWebFilter := getGRPCWebFilterResolverForNamespace(grpcWebFilters, ns)
+
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		switch ref.Kind {
+		case kinds.SnippetsFilter:
+			return resolveSnippetsFilter(ref)
+		case kinds.DirectResponseFilter:
+			return resolveDirectResponseFilter(ref)
+		case kinds.GRPCRewriteFilter:
+			return resolveGRPCRewriteFilter(ref)
+		case kinds.GRPCWebFilter:
+			return resolveGRPCWebFilter(ref)
+		default:
+			return nil
+		}
+	}
+}
+
+func validateExtensionRefFilter(
+	ref *v1.LocalObjectReference,
+	routeType RouteType,
+	path *field.Path,
+) field.ErrorList {
 	var allErrs field.ErrorList
 
 	extRefPath := path.Child("extensionRef")
@@ -39,10 +88,13 @@ func validateExtensionRefFilter(ref *v1.LocalObjectReference, path *field.Path)
 		allErrs = append(allErrs, field.NotSupported(extRefPath, ref.Group, []string{ngfAPI.GroupName}))
 	}
 
-	switch ref.Kind {
-	case kinds.SnippetsFilter:
-	default:
-		allErrs = append(allErrs, field.NotSupported(extRefPath, ref.Kind, []string{kinds.SnippetsFilter}))
+	supportedKinds := supportedHTTPExtRefKinds
+	if routeType == RouteTypeGRPC {
+		supportedKinds = supportedGRPCExtRefKinds
+	}
+
+	if !slices.Contains(supportedKinds, ref.Kind) {
+		allErrs = append(allErrs, field.NotSupported(extRefPath, ref.Kind, supportedKinds))
 	}
 
 	return allErrs
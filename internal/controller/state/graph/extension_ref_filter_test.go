@@ -18,6 +18,7 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 	tests := []struct {
 		ref          *v1.LocalObjectReference
 		name         string
+		routeType    RouteType
 		errSubString []string
 		expErrCount  int
 	}{
@@ -36,7 +37,7 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 			errSubString: []string{
 				`test.extensionRef: Required value: name cannot be empty`,
 				`test.extensionRef: Unsupported value: "": supported values: "gateway.nginx.org"`,
-				`test.extensionRef: Unsupported value: "": supported values: "SnippetsFilter"`,
+				`test.extensionRef: Unsupported value: "": supported values: "SnippetsFilter", "DirectResponseFilter"`,
 			},
 		},
 		{
@@ -71,7 +72,8 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 			},
 			expErrCount: 1,
 			errSubString: []string{
-				`test.extensionRef: Unsupported value: "unsupported": supported values: "SnippetsFilter"`,
+				`test.extensionRef: Unsupported value: "unsupported": supported values: "SnippetsFilter", ` +
+					`"DirectResponseFilter"`,
 			},
 		},
 		{
@@ -83,6 +85,62 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 			},
 			expErrCount: 0,
 		},
+		{
+			name: "valid DirectResponseFilter ref",
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.DirectResponseFilter,
+			},
+			expErrCount: 0,
+		},
+		{
+			name:      "DirectResponseFilter ref on GRPCRoute",
+			routeType: RouteTypeGRPC,
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.DirectResponseFilter,
+			},
+			expErrCount: 1,
+			errSubString: []string{
+				`test.extensionRef: Unsupported value: "DirectResponseFilter": supported values: "SnippetsFilter", ` +
+					`"GRPCRewriteFilter", "GRPCWebFilter"`,
+			},
+		},
+		{
+			name:      "valid GRPCRewriteFilter ref on GRPCRoute",
+			routeType: RouteTypeGRPC,
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.GRPCRewriteFilter,
+			},
+			expErrCount: 0,
+		},
+		{
+			name:      "valid GRPCWebFilter ref on GRPCRoute",
+			routeType: RouteTypeGRPC,
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.GRPCWebFilter,
+			},
+			expErrCount: 0,
+		},
+		{
+			name: "GRPCRewriteFilter ref on HTTPRoute",
+			ref: &v1.LocalObjectReference{
+				Name:  v1.ObjectName("filter"),
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.GRPCRewriteFilter,
+			},
+			expErrCount: 1,
+			errSubString: []string{
+				`test.extensionRef: Unsupported value: "GRPCRewriteFilter": supported values: "SnippetsFilter", ` +
+					`"DirectResponseFilter"`,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -91,7 +149,12 @@ func TestValidateExtensionRefFilter(t *testing.T) {
 
 			g := NewWithT(t)
 
-			errs := validateExtensionRefFilter(test.ref, testPath)
+			routeType := test.routeType
+			if routeType == "" {
+				routeType = RouteTypeHTTP
+			}
+
+			errs := validateExtensionRefFilter(test.ref, routeType, testPath)
 			g.Expect(errs).To(HaveLen(test.expErrCount))
 
 			if len(test.errSubString) > 0 {
@@ -15,6 +15,9 @@ import (
 type Gateway struct {
 	// LatestReloadResult is the result of the last nginx reload attempt.
 	LatestReloadResult NginxReloadResult
+	// LatestDataPlaneUpgrade is the condition of the latest blue/green upgrade of the nginx data plane.
+	// It is nil if the data plane was never upgraded with the blue/green strategy.
+	LatestDataPlaneUpgrade *conditions.Condition
 	// Source is the corresponding Gateway resource.
 	Source *v1.Gateway
 	// NginxProxy is the NginxProxy referenced by this Gateway.
@@ -23,16 +26,35 @@ type Gateway struct {
 	// the GatewayClass resource. This is the effective set of config that should be applied to the Gateway.
 	// If non-nil, then this config is valid.
 	EffectiveNginxProxy *EffectiveNginxProxy
+	// SessionTicketKeys is the Secret with the TLS session ticket keys configured in the EffectiveNginxProxy.
+	// It is nil if the keys are not configured or the Secret is invalid.
+	SessionTicketKeys *types.NamespacedName
+	// AgentTokenSecret is the Secret with the token that the NGINX agents of the static data plane configured in the
+	// EffectiveNginxProxy authenticate with. It is nil if no static data plane is configured or the Secret is invalid.
+	AgentTokenSecret *types.NamespacedName
 	// DeploymentName is the name of the nginx Deployment associated with this Gateway.
+	// For a Gateway merged into another Gateway, it is the Deployment of that Gateway.
 	DeploymentName types.NamespacedName
+	// MergedInto is the Gateway whose data plane also serves this Gateway.
+	// It is nil if the Gateway is not merged into another Gateway.
+	MergedInto *types.NamespacedName
+	// MergedGateways are the Gateways merged onto the data plane of this Gateway, sorted from oldest to newest.
+	MergedGateways []*v1.Gateway
 	// Listeners include the listeners of the Gateway.
 	Listeners []*Listener
 	// Conditions holds the conditions for the Gateway.
 	Conditions []conditions.Condition
 	// Policies holds the policies attached to the Gateway.
 	Policies []*Policy
+	// BackendServices are the Services that the Routes attached to the Gateway reference, sorted by NamespacedName.
+	BackendServices []BackendService
 	// Valid indicates whether the Gateway Spec is valid.
 	Valid bool
+	// PodMonitorCRDInstalled indicates whether the Prometheus Operator PodMonitor CRD is installed in the cluster.
+	PodMonitorCRDInstalled bool
+	// DataPlaneQuotaExceeded indicates whether the data plane of the Gateway exceeds the quota of its namespace,
+	// in which case the data plane is not created or updated.
+	DataPlaneQuotaExceeded bool
 }
 
 // processGateways determines which Gateway resources belong to NGF (determined by the Gateway GatewayClassName field).
@@ -63,6 +85,7 @@ func buildGateways(
 	gc *GatewayClass,
 	refGrantResolver *referenceGrantResolver,
 	nps map[types.NamespacedName]*NginxProxy,
+	podMonitorCRDInstalled bool,
 ) map[types.NamespacedName]*Gateway {
 	if len(gws) == 0 {
 		return nil
@@ -87,6 +110,16 @@ func buildGateways(
 
 		conds, valid := validateGateway(gw, gc, np)
 
+		sessionTicketKeys, ticketKeysCond := resolveSessionTicketKeys(gw.Namespace, effectiveNginxProxy, secretResolver)
+		if ticketKeysCond != nil {
+			conds = append(conds, *ticketKeysCond)
+		}
+
+		agentTokenSecret, agentTokenCond := resolveAgentToken(gw.Namespace, effectiveNginxProxy, secretResolver)
+		if agentTokenCond != nil {
+			conds = append(conds, *agentTokenCond)
+		}
+
 		protectedPorts := make(ProtectedPorts)
 		if port, enabled := MetricsEnabledForNginxProxy(effectiveNginxProxy); enabled {
 			metricsPort := config.DefaultNginxMetricsPort
@@ -103,29 +136,87 @@ func buildGateways(
 
 		if !valid {
 			builtGateways[gwNsName] = &Gateway{
-				Source:              gw,
-				Valid:               false,
-				NginxProxy:          np,
-				EffectiveNginxProxy: effectiveNginxProxy,
-				Conditions:          conds,
-				DeploymentName:      deploymentName,
+				Source:                 gw,
+				Valid:                  false,
+				NginxProxy:             np,
+				EffectiveNginxProxy:    effectiveNginxProxy,
+				Conditions:             conds,
+				SessionTicketKeys:      sessionTicketKeys,
+				AgentTokenSecret:       agentTokenSecret,
+				DeploymentName:         deploymentName,
+				PodMonitorCRDInstalled: podMonitorCRDInstalled,
 			}
 		} else {
 			builtGateways[gwNsName] = &Gateway{
-				Source:              gw,
-				Listeners:           buildListeners(gw, secretResolver, refGrantResolver, protectedPorts),
-				NginxProxy:          np,
-				EffectiveNginxProxy: effectiveNginxProxy,
-				Valid:               true,
-				Conditions:          conds,
-				DeploymentName:      deploymentName,
+				Source:                 gw,
+				Listeners:              buildListeners(gw, secretResolver, refGrantResolver, protectedPorts),
+				NginxProxy:             np,
+				EffectiveNginxProxy:    effectiveNginxProxy,
+				Valid:                  true,
+				Conditions:             conds,
+				SessionTicketKeys:      sessionTicketKeys,
+				AgentTokenSecret:       agentTokenSecret,
+				DeploymentName:         deploymentName,
+				PodMonitorCRDInstalled: podMonitorCRDInstalled,
 			}
 		}
 	}
 
+	mergeGateways(builtGateways)
+	enforceDataPlaneQuotas(builtGateways, quotaForGatewayClass(gc))
+
 	return builtGateways
 }
 
+// resolveSessionTicketKeys resolves the Secret with the TLS session ticket keys configured in the NginxProxy.
+// The Secret must be in the namespace of the Gateway.
+func resolveSessionTicketKeys(
+	namespace string,
+	np *EffectiveNginxProxy,
+	secretResolver *secretResolver,
+) (*types.NamespacedName, *conditions.Condition) {
+	if np == nil || np.TLS == nil || np.TLS.SessionTickets == nil {
+		return nil, nil
+	}
+
+	tickets := np.TLS.SessionTickets
+	if tickets.KeysSecretName == nil || (tickets.Disable != nil && *tickets.Disable) {
+		return nil, nil
+	}
+
+	nsname := types.NamespacedName{Namespace: namespace, Name: *tickets.KeysSecretName}
+	if err := secretResolver.resolveSessionTicketKeys(nsname); err != nil {
+		path := field.NewPath("spec", "tls", "sessionTickets", "keysSecretName")
+		cond := conditions.NewGatewaySessionTicketKeysInvalid(field.Invalid(path, nsname.String(), err.Error()).Error())
+
+		return nil, &cond
+	}
+
+	return &nsname, nil
+}
+
+// resolveAgentToken resolves the Secret with the token of the static data plane configured in the NginxProxy.
+// The Secret must be in the namespace of the Gateway.
+func resolveAgentToken(
+	namespace string,
+	np *EffectiveNginxProxy,
+	secretResolver *secretResolver,
+) (*types.NamespacedName, *conditions.Condition) {
+	if !StaticDataPlaneEnabledForNginxProxy(np) {
+		return nil, nil
+	}
+
+	nsname := types.NamespacedName{Namespace: namespace, Name: np.StaticDataPlane.TokenSecretName}
+	if err := secretResolver.resolveAgentToken(nsname); err != nil {
+		path := field.NewPath("spec", "staticDataPlane", "tokenSecretName")
+		cond := conditions.NewGatewayAgentTokenInvalid(field.Invalid(path, nsname.String(), err.Error()).Error())
+
+		return nil, &cond
+	}
+
+	return &nsname, nil
+}
+
 func validateGatewayParametersRef(npCfg *NginxProxy, ref v1.LocalParametersReference) []conditions.Condition {
 	var conds []conditions.Condition
 
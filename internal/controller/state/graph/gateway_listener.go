@@ -3,15 +3,20 @@ package graph
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
@@ -394,10 +399,8 @@ func createHTTPSListenerValidator(protectedPorts ProtectedPorts) listenerValidat
 			conds = append(conds, conditions.NewListenerUnsupportedValue(valErr.Error())...)
 		}
 
-		if len(listener.TLS.Options) > 0 {
-			path := tlsPath.Child("options")
-			valErr := field.Forbidden(path, "options are not supported")
-			conds = append(conds, conditions.NewListenerUnsupportedValue(valErr.Error())...)
+		if errs := validateTLSOptions(listener.TLS.Options, tlsPath.Child("options")); len(errs) > 0 {
+			conds = append(conds, conditions.NewListenerUnsupportedValue(errs.ToAggregate().Error())...)
 		}
 
 		if len(listener.TLS.CertificateRefs) == 0 {
@@ -434,6 +437,83 @@ func createHTTPSListenerValidator(protectedPorts ProtectedPorts) listenerValidat
 	}
 }
 
+// validateTLSOptions validates the TLS options of an HTTPS listener. Only the NGINX Gateway Fabric keys,
+// which override the TLS settings of the NginxProxy, are supported.
+func validateTLSOptions(options map[v1.AnnotationKey]v1.AnnotationValue, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	supportedKeys := []string{
+		ngfAPIv1alpha2.TLSOptionSSLCiphers,
+		ngfAPIv1alpha2.TLSOptionSSLPreferServerCiphers,
+		ngfAPIv1alpha2.TLSOptionSSLProtocols,
+	}
+
+	// sort the keys so that the errors are always reported in the same order.
+	for _, key := range slices.Sorted(maps.Keys(options)) {
+		value := string(options[key])
+		keyPath := path.Key(string(key))
+
+		switch string(key) {
+		case ngfAPIv1alpha2.TLSOptionSSLProtocols:
+			protocols := strings.Fields(value)
+			if len(protocols) == 0 {
+				allErrs = append(allErrs, field.Required(keyPath, "at least one protocol must be specified"))
+			}
+
+			for _, protocol := range protocols {
+				allErrs = append(allErrs, validateSSLProtocol(protocol, keyPath)...)
+			}
+		case ngfAPIv1alpha2.TLSOptionSSLCiphers:
+			if err := validateSSLCiphers(value); err != nil {
+				allErrs = append(allErrs, field.Invalid(keyPath, value, err.Error()))
+			}
+		case ngfAPIv1alpha2.TLSOptionSSLPreferServerCiphers:
+			if value != "true" && value != "false" {
+				allErrs = append(allErrs, field.NotSupported(keyPath, value, []string{"true", "false"}))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(path, key, supportedKeys))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedSSLProtocols = []string{
+	string(ngfAPIv1alpha2.TLSProtocolV1),
+	string(ngfAPIv1alpha2.TLSProtocolV11),
+	string(ngfAPIv1alpha2.TLSProtocolV12),
+	string(ngfAPIv1alpha2.TLSProtocolV13),
+}
+
+func validateSSLProtocol(protocol string, path *field.Path) field.ErrorList {
+	if !slices.Contains(supportedSSLProtocols, protocol) {
+		return field.ErrorList{field.NotSupported(path, protocol, supportedSSLProtocols)}
+	}
+
+	return nil
+}
+
+const (
+	sslCiphersFmt    = `[A-Za-z0-9_+!@=:.,\-]+`
+	sslCiphersErrMsg = "must be a list of ciphers in the format of the OpenSSL library"
+)
+
+var sslCiphersRegexp = regexp.MustCompile("^" + sslCiphersFmt + "$")
+
+func validateSSLCiphers(ciphers string) error {
+	if !sslCiphersRegexp.MatchString(ciphers) {
+		examples := []string{
+			"HIGH:!aNULL:!MD5",
+			"ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256",
+		}
+
+		return errors.New(k8svalidation.RegexError(sslCiphersErrMsg, sslCiphersFmt, examples...))
+	}
+
+	return nil
+}
+
 func createPortConflictResolver() listenerConflictResolver {
 	const (
 		secureProtocolGroup   int = 0
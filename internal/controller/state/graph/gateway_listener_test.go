@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
@@ -138,6 +139,22 @@ func TestValidateHTTPSListener(t *testing.T) {
 			),
 			name: "invalid protected port",
 		},
+		{
+			l: v1.Listener{
+				Port: 443,
+				TLS: &v1.GatewayTLSConfig{
+					Mode:            helpers.GetPointer(v1.TLSModeTerminate),
+					CertificateRefs: []v1.SecretObjectReference{validSecretRef},
+					Options: map[v1.AnnotationKey]v1.AnnotationValue{
+						"nginx.org/ssl-protocols":             "TLSv1.2 TLSv1.3",
+						"nginx.org/ssl-ciphers":               "HIGH:!aNULL:!MD5",
+						"nginx.org/ssl-prefer-server-ciphers": "true",
+					},
+				},
+			},
+			expected: nil,
+			name:     "valid options",
+		},
 		{
 			l: v1.Listener{
 				Port: 443,
@@ -147,8 +164,11 @@ func TestValidateHTTPSListener(t *testing.T) {
 					Options:         map[v1.AnnotationKey]v1.AnnotationValue{"key": "val"},
 				},
 			},
-			expected: conditions.NewListenerUnsupportedValue("tls.options: Forbidden: options are not supported"),
-			name:     "invalid options",
+			expected: conditions.NewListenerUnsupportedValue(
+				`tls.options: Unsupported value: "key": supported values: "nginx.org/ssl-ciphers", ` +
+					`"nginx.org/ssl-prefer-server-ciphers", "nginx.org/ssl-protocols"`,
+			),
+			name: "invalid options",
 		},
 		{
 			l: v1.Listener{
@@ -241,6 +261,86 @@ func TestValidateHTTPSListener(t *testing.T) {
 	}
 }
 
+func TestValidateTLSOptions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		options     map[v1.AnnotationKey]v1.AnnotationValue
+		name        string
+		errorString string
+	}{
+		{
+			options: nil,
+			name:    "no options",
+		},
+		{
+			options: map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-protocols":             "TLSv1 TLSv1.1 TLSv1.2 TLSv1.3",
+				"nginx.org/ssl-ciphers":               "ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE+AESGCM:@STRENGTH",
+				"nginx.org/ssl-prefer-server-ciphers": "false",
+			},
+			name: "valid options",
+		},
+		{
+			options: map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-protocols": "TLSv1.2 SSLv3",
+			},
+			name: "unsupported protocol",
+			errorString: `tls.options[nginx.org/ssl-protocols]: Unsupported value: "SSLv3": supported values: ` +
+				`"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"`,
+		},
+		{
+			options: map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-protocols": " ",
+			},
+			name:        "empty protocols",
+			errorString: "tls.options[nginx.org/ssl-protocols]: Required value: at least one protocol must be specified",
+		},
+		{
+			options: map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-ciphers": "HIGH; return 200",
+			},
+			name: "invalid ciphers",
+			errorString: `tls.options[nginx.org/ssl-ciphers]: Invalid value: "HIGH; return 200": must be a list ` +
+				`of ciphers in the format of the OpenSSL library (e.g. 'HIGH:!aNULL:!MD5',  or ` +
+				`'ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256', regex used for validation is ` +
+				`'[A-Za-z0-9_+!@=:.,\-]+')`,
+		},
+		{
+			options: map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-prefer-server-ciphers": "on",
+			},
+			name: "invalid prefer server ciphers",
+			errorString: `tls.options[nginx.org/ssl-prefer-server-ciphers]: Unsupported value: "on": ` +
+				`supported values: "true", "false"`,
+		},
+		{
+			options: map[v1.AnnotationKey]v1.AnnotationValue{
+				"nginx.org/ssl-protocols": "TLSv2",
+				"key":                     "val",
+			},
+			name: "multiple errors",
+			errorString: `[tls.options: Unsupported value: "key": supported values: "nginx.org/ssl-ciphers", ` +
+				`"nginx.org/ssl-prefer-server-ciphers", "nginx.org/ssl-protocols", ` +
+				`tls.options[nginx.org/ssl-protocols]: Unsupported value: "TLSv2": supported values: ` +
+				`"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			errs := validateTLSOptions(test.options, field.NewPath("tls", "options"))
+			if test.errorString == "" {
+				g.Expect(errs).To(BeEmpty())
+			} else {
+				g.Expect(errs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
 func TestValidateListenerHostname(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
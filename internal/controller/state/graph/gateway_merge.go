@@ -0,0 +1,131 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+)
+
+// MergeGatewaysEnabledForNginxProxy returns whether the Gateways that use the NginxProxy are merged onto a shared
+// data plane. By default, merging is disabled.
+func MergeGatewaysEnabledForNginxProxy(np *EffectiveNginxProxy) bool {
+	return np != nil && np.MergeGateways != nil && *np.MergeGateways
+}
+
+// mergeGroupKey identifies the Gateways that can be merged onto the same data plane. The provisioned nginx
+// resources are namespaced, and the merged Gateways must share the same NginxProxy configuration.
+type mergeGroupKey struct {
+	namespace  string
+	nginxProxy string
+}
+
+// mergeGateways merges the valid Gateways that have merging enabled onto a shared data plane. The Gateways are
+// grouped by namespace and referenced NginxProxy; the oldest Gateway of a group owns the data plane and the other
+// Gateways of the group are merged into it. Listeners that conflict with the listeners of an older Gateway of the
+// same group are invalidated.
+func mergeGateways(gws map[types.NamespacedName]*Gateway) {
+	groups := make(map[mergeGroupKey][]*Gateway)
+
+	for _, gw := range gws {
+		if !gw.Valid || !MergeGatewaysEnabledForNginxProxy(gw.EffectiveNginxProxy) {
+			continue
+		}
+
+		key := mergeGroupKey{namespace: gw.Source.Namespace}
+		if gw.Source.Spec.Infrastructure != nil && gw.Source.Spec.Infrastructure.ParametersRef != nil {
+			key.nginxProxy = gw.Source.Spec.Infrastructure.ParametersRef.Name
+		}
+
+		groups[key] = append(groups[key], gw)
+	}
+
+	for _, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+
+		slices.SortFunc(group, compareGatewaysByAge)
+
+		owner := group[0]
+		ownerNsName := client.ObjectKeyFromObject(owner.Source)
+
+		for _, gw := range group[1:] {
+			gw.MergedInto = &ownerNsName
+			gw.DeploymentName = owner.DeploymentName
+			owner.MergedGateways = append(owner.MergedGateways, gw.Source)
+		}
+
+		resolveMergedListenerConflicts(group)
+	}
+}
+
+// compareGatewaysByAge orders the Gateways from oldest to newest. Gateways of the same age are ordered by name.
+func compareGatewaysByAge(a, b *Gateway) int {
+	if c := a.Source.CreationTimestamp.Compare(b.Source.CreationTimestamp.Time); c != 0 {
+		return c
+	}
+	return strings.Compare(a.Source.Name, b.Source.Name)
+}
+
+// resolveMergedListenerConflicts invalidates the listeners of the merged Gateways that conflict with the listeners of
+// an older Gateway served by the same data plane. Listeners conflict when they use the same port with incompatible
+// protocols, or with compatible protocols and overlapping hostnames. The Gateways must be sorted by age.
+// Conflicts between the listeners of the same Gateway are resolved when the listeners are built.
+//
+// The servers of the merged configuration are generated for the hostnames and ports of the valid listeners, so
+// invalidating the conflicting listeners here ensures that no server of a merged Gateway collides with a server of
+// an older Gateway. Only the default server of a port is shared, and it is taken from the owner's configuration.
+func resolveMergedListenerConflicts(group []*Gateway) {
+	protocolGroups := map[v1.ProtocolType]int{
+		v1.HTTPProtocolType:  0,
+		v1.HTTPSProtocolType: 1,
+		v1.TLSProtocolType:   1,
+	}
+
+	protocolFormat := "Listener for port %d specifies a protocol that is incompatible with listener %s of " +
+		"Gateway %s; ensure only one protocol per port across merged Gateways"
+
+	hostnameFormat := "Listener for port %d specifies a hostname that overlaps with listener %s of " +
+		"Gateway %s; ensure no overlapping hostnames for the same port across merged Gateways"
+
+	listenersByPort := make(map[v1.PortNumber][]*Listener)
+
+	for _, gw := range group {
+		for _, l := range gw.Listeners {
+			if !l.Valid {
+				continue
+			}
+
+			port := l.Source.Port
+			for _, other := range listenersByPort[port] {
+				if protocolGroups[l.Source.Protocol] != protocolGroups[other.Source.Protocol] {
+					msg := fmt.Sprintf(protocolFormat, port, other.Name, other.GatewayName)
+					l.Conditions = append(l.Conditions, conditions.NewListenerProtocolConflict(msg)...)
+					l.Valid = false
+					break
+				}
+
+				if haveOverlap(l.Source.Hostname, other.Source.Hostname) {
+					msg := fmt.Sprintf(hostnameFormat, port, other.Name, other.GatewayName)
+					l.Conditions = append(l.Conditions, conditions.NewListenerHostnameConflict(msg)...)
+					l.Valid = false
+					break
+				}
+			}
+		}
+
+		// the listeners are added after the whole Gateway is processed, so that the listeners of
+		// the same Gateway are not checked against each other.
+		for _, l := range gw.Listeners {
+			if l.Valid {
+				listenersByPort[l.Source.Port] = append(listenersByPort[l.Source.Port], l)
+			}
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestMergeGatewaysEnabledForNginxProxy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		ep       *EffectiveNginxProxy
+		name     string
+		expected bool
+	}{
+		{
+			ep:       nil,
+			name:     "nil effective nginx proxy",
+			expected: false,
+		},
+		{
+			ep:       &EffectiveNginxProxy{},
+			name:     "merge gateways not set",
+			expected: false,
+		},
+		{
+			ep:       &EffectiveNginxProxy{MergeGateways: helpers.GetPointer(false)},
+			name:     "merge gateways disabled",
+			expected: false,
+		},
+		{
+			ep:       &EffectiveNginxProxy{MergeGateways: helpers.GetPointer(true)},
+			name:     "merge gateways enabled",
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(MergeGatewaysEnabledForNginxProxy(test.ep)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestMergeGateways(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	mergeEnabled := &EffectiveNginxProxy{MergeGateways: helpers.GetPointer(true)}
+
+	createGateway := func(
+		namespace, name string,
+		created time.Time,
+		paramsRef string,
+		np *EffectiveNginxProxy,
+		valid bool,
+	) *Gateway {
+		gw := &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+		}
+		if paramsRef != "" {
+			gw.Spec.Infrastructure = &v1.GatewayInfrastructure{
+				ParametersRef: &v1.LocalParametersReference{Name: paramsRef},
+			}
+		}
+
+		return &Gateway{
+			Source:              gw,
+			EffectiveNginxProxy: np,
+			Valid:               valid,
+			DeploymentName:      types.NamespacedName{Namespace: namespace, Name: name + "-nginx"},
+		}
+	}
+
+	oldest := createGateway("test", "oldest", now.Add(-time.Hour), "", mergeEnabled, true)
+	newer := createGateway("test", "newer", now, "", mergeEnabled, true)
+	sameAge := createGateway("test", "same-age", now, "", mergeEnabled, true)
+	otherNs := createGateway("other", "other-ns", now, "", mergeEnabled, true)
+	otherNp := createGateway("test", "other-np", now, "np", mergeEnabled, true)
+	disabled := createGateway("test", "disabled", now, "", nil, true)
+	invalid := createGateway("test", "invalid", now, "", mergeEnabled, false)
+
+	gws := map[types.NamespacedName]*Gateway{}
+	for _, gw := range []*Gateway{oldest, newer, sameAge, otherNs, otherNp, disabled, invalid} {
+		gws[types.NamespacedName{Namespace: gw.Source.Namespace, Name: gw.Source.Name}] = gw
+	}
+
+	mergeGateways(gws)
+
+	g := NewWithT(t)
+
+	ownerNsName := types.NamespacedName{Namespace: "test", Name: "oldest"}
+	ownerDeployment := types.NamespacedName{Namespace: "test", Name: "oldest-nginx"}
+
+	g.Expect(oldest.MergedInto).To(BeNil())
+	g.Expect(oldest.MergedGateways).To(Equal([]*v1.Gateway{newer.Source, sameAge.Source}))
+	g.Expect(oldest.DeploymentName).To(Equal(ownerDeployment))
+
+	for _, gw := range []*Gateway{newer, sameAge} {
+		g.Expect(gw.MergedInto).To(Equal(&ownerNsName))
+		g.Expect(gw.DeploymentName).To(Equal(ownerDeployment))
+		g.Expect(gw.MergedGateways).To(BeEmpty())
+	}
+
+	for _, gw := range []*Gateway{otherNs, otherNp, disabled, invalid} {
+		g.Expect(gw.MergedInto).To(BeNil())
+		g.Expect(gw.MergedGateways).To(BeEmpty())
+		g.Expect(gw.DeploymentName.Name).To(Equal(gw.Source.Name + "-nginx"))
+	}
+}
+
+func TestResolveMergedListenerConflicts(t *testing.T) {
+	t.Parallel()
+
+	createListener := func(
+		gwName string,
+		port v1.PortNumber,
+		protocol v1.ProtocolType,
+		hostname string,
+	) *Listener {
+		l := &Listener{
+			Name:        fmt.Sprintf("%s-%d", strings.ToLower(string(protocol)), port),
+			GatewayName: types.NamespacedName{Namespace: "test", Name: gwName},
+			Source: v1.Listener{
+				Port:     port,
+				Protocol: protocol,
+			},
+			Valid: true,
+		}
+		if hostname != "" {
+			l.Source.Hostname = helpers.GetPointer(v1.Hostname(hostname))
+		}
+
+		return l
+	}
+
+	ownerHTTP := createListener("owner", 80, v1.HTTPProtocolType, "foo.example.com")
+	ownerHTTPS := createListener("owner", 443, v1.HTTPSProtocolType, "foo.example.com")
+	ownerSameHostname := createListener("owner", 80, v1.HTTPProtocolType, "foo.example.com")
+
+	memberHTTP := createListener("member", 80, v1.HTTPProtocolType, "bar.example.com")
+	memberTLS := createListener("member", 443, v1.TLSProtocolType, "bar.example.com")
+	memberOverlap := createListener("member", 8080, v1.HTTPProtocolType, "")
+	memberWildcard := createListener("member", 80, v1.HTTPProtocolType, "*.example.com")
+	memberProtocol := createListener("member", 443, v1.HTTPProtocolType, "baz.example.com")
+	memberSameHostname := createListener("member", 80, v1.HTTPProtocolType, "foo.example.com")
+	memberSameHTTPSHostname := createListener("member", 443, v1.HTTPSProtocolType, "foo.example.com")
+
+	newestSameHostname := createListener("newest", 80, v1.HTTPProtocolType, "bar.example.com")
+	newestOtherPort := createListener("newest", 8081, v1.HTTPProtocolType, "bar.example.com")
+
+	ownerOverlap := createListener("owner", 8080, v1.HTTPProtocolType, "foo.example.com")
+	invalidOwnerListener := createListener("owner", 9090, v1.HTTPProtocolType, "")
+	invalidOwnerListener.Valid = false
+	memberSamePortAsInvalid := createListener("member", 9090, v1.HTTPSProtocolType, "")
+
+	group := []*Gateway{
+		{
+			Listeners: []*Listener{ownerHTTP, ownerHTTPS, ownerSameHostname, ownerOverlap, invalidOwnerListener},
+		},
+		{
+			Listeners: []*Listener{
+				memberHTTP,
+				memberTLS,
+				memberOverlap,
+				memberWildcard,
+				memberProtocol,
+				memberSamePortAsInvalid,
+				memberSameHostname,
+				memberSameHTTPSHostname,
+			},
+		},
+		{
+			Listeners: []*Listener{newestSameHostname, newestOtherPort},
+		},
+	}
+
+	resolveMergedListenerConflicts(group)
+
+	g := NewWithT(t)
+
+	// the listeners of the oldest Gateway are kept, even if they overlap with each other
+	for _, l := range []*Listener{ownerHTTP, ownerHTTPS, ownerSameHostname, ownerOverlap} {
+		g.Expect(l.Valid).To(BeTrue())
+		g.Expect(l.Conditions).To(BeEmpty())
+	}
+
+	for _, l := range []*Listener{memberHTTP, memberTLS, memberSamePortAsInvalid, newestOtherPort} {
+		g.Expect(l.Valid).To(BeTrue())
+		g.Expect(l.Conditions).To(BeEmpty())
+	}
+
+	for _, l := range []*Listener{memberOverlap, memberWildcard} {
+		g.Expect(l.Valid).To(BeFalse())
+		g.Expect(l.Conditions).To(HaveLen(3))
+		g.Expect(l.Conditions[0].Reason).To(Equal(string(v1.ListenerReasonHostnameConflict)))
+		g.Expect(l.Conditions[0].Message).To(ContainSubstring("test/owner"))
+	}
+
+	g.Expect(memberProtocol.Valid).To(BeFalse())
+	g.Expect(memberProtocol.Conditions).To(Equal(conditions.NewListenerProtocolConflict(
+		"Listener for port 443 specifies a protocol that is incompatible with listener https-443 of " +
+			"Gateway test/owner; ensure only one protocol per port across merged Gateways",
+	)))
+
+	// a listener with the same hostname and port as a listener of an older Gateway would generate the same server,
+	// so the listener of the newer Gateway is reported as conflicted instead of its server being dropped.
+	g.Expect(memberSameHostname.Valid).To(BeFalse())
+	g.Expect(memberSameHostname.Conditions).To(Equal(conditions.NewListenerHostnameConflict(
+		"Listener for port 80 specifies a hostname that overlaps with listener http-80 of " +
+			"Gateway test/owner; ensure no overlapping hostnames for the same port across merged Gateways",
+	)))
+
+	g.Expect(memberSameHTTPSHostname.Valid).To(BeFalse())
+	g.Expect(memberSameHTTPSHostname.Conditions).To(Equal(conditions.NewListenerHostnameConflict(
+		"Listener for port 443 specifies a hostname that overlaps with listener https-443 of " +
+			"Gateway test/owner; ensure no overlapping hostnames for the same port across merged Gateways",
+	)))
+
+	g.Expect(newestSameHostname.Valid).To(BeFalse())
+	g.Expect(newestSameHostname.Conditions).To(Equal(conditions.NewListenerHostnameConflict(
+		"Listener for port 80 specifies a hostname that overlaps with listener http-80 of " +
+			"Gateway test/member; ensure no overlapping hostnames for the same port across merged Gateways",
+	)))
+}
+
+func TestMergeGatewaysWithNginxProxy(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	np := &NginxProxy{
+		Source: &ngfAPIv1alpha2.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "np"},
+			Spec:       ngfAPIv1alpha2.NginxProxySpec{MergeGateways: helpers.GetPointer(true)},
+		},
+		Valid: true,
+	}
+
+	gc := &GatewayClass{Source: &v1.GatewayClass{}, Valid: true}
+
+	createGateway := func(name string, created time.Time) *v1.Gateway {
+		return &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "test",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: v1.GatewaySpec{
+				GatewayClassName: "nginx",
+				Infrastructure: &v1.GatewayInfrastructure{
+					ParametersRef: &v1.LocalParametersReference{
+						Group: ngfAPIv1alpha2.GroupName,
+						Kind:  "NginxProxy",
+						Name:  "np",
+					},
+				},
+				Listeners: []v1.Listener{
+					{
+						Name:     "http",
+						Port:     80,
+						Protocol: v1.HTTPProtocolType,
+						Hostname: helpers.GetPointer(v1.Hostname(name + ".example.com")),
+					},
+				},
+			},
+		}
+	}
+
+	now := time.Now()
+	gws := map[types.NamespacedName]*v1.Gateway{
+		{Namespace: "test", Name: "first"}:  createGateway("first", now.Add(-time.Minute)),
+		{Namespace: "test", Name: "second"}: createGateway("second", now),
+	}
+
+	built := buildGateways(
+		gws,
+		&secretResolver{},
+		gc,
+		&referenceGrantResolver{},
+		map[types.NamespacedName]*NginxProxy{{Namespace: "test", Name: "np"}: np},
+		false,
+	)
+
+	first := built[types.NamespacedName{Namespace: "test", Name: "first"}]
+	second := built[types.NamespacedName{Namespace: "test", Name: "second"}]
+
+	g.Expect(first.MergedGateways).To(Equal([]*v1.Gateway{gws[types.NamespacedName{Namespace: "test", Name: "second"}]}))
+	g.Expect(second.MergedInto).To(Equal(&types.NamespacedName{Namespace: "test", Name: "first"}))
+	g.Expect(second.DeploymentName).To(Equal(types.NamespacedName{Namespace: "test", Name: "first-nginx"}))
+	g.Expect(second.Listeners).To(HaveLen(1))
+	g.Expect(second.Listeners[0].Valid).To(BeTrue())
+}
@@ -1493,7 +1493,14 @@ func TestBuildGateway(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 			resolver := newReferenceGrantResolver(test.refGrants)
-			result := buildGateways(test.gateway, secretResolver, test.gatewayClass, resolver, nginxProxies)
+			result := buildGateways(
+				test.gateway,
+				secretResolver,
+				test.gatewayClass,
+				resolver,
+				nginxProxies,
+				false,
+			)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
 	}
@@ -1582,3 +1589,176 @@ func TestValidateGatewayParametersRef(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveSessionTicketKeys(t *testing.T) {
+	t.Parallel()
+
+	keysSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "ticket-keys",
+		},
+		Data: map[string][]byte{
+			"key": make([]byte, 80),
+		},
+	}
+
+	invalidKeysSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "invalid-ticket-keys",
+		},
+	}
+
+	newNginxProxy := func(tickets *ngfAPIv1alpha2.TLSSessionTickets) *EffectiveNginxProxy {
+		return &EffectiveNginxProxy{
+			TLS: &ngfAPIv1alpha2.NginxTLS{
+				SessionTickets: tickets,
+			},
+		}
+	}
+
+	tests := []struct {
+		np                 *EffectiveNginxProxy
+		expNsName          *types.NamespacedName
+		expCond            *conditions.Condition
+		name               string
+		expResolvedSecrets int
+	}{
+		{
+			name: "no nginx proxy",
+		},
+		{
+			name: "no session tickets",
+			np:   &EffectiveNginxProxy{TLS: &ngfAPIv1alpha2.NginxTLS{}},
+		},
+		{
+			name: "session tickets disabled",
+			np: newNginxProxy(&ngfAPIv1alpha2.TLSSessionTickets{
+				Disable:        helpers.GetPointer(true),
+				KeysSecretName: helpers.GetPointer(keysSecret.Name),
+			}),
+		},
+		{
+			name: "valid keys",
+			np: newNginxProxy(&ngfAPIv1alpha2.TLSSessionTickets{
+				KeysSecretName: helpers.GetPointer(keysSecret.Name),
+			}),
+			expNsName:          helpers.GetPointer(client.ObjectKeyFromObject(keysSecret)),
+			expResolvedSecrets: 1,
+		},
+		{
+			name: "invalid keys",
+			np: newNginxProxy(&ngfAPIv1alpha2.TLSSessionTickets{
+				KeysSecretName: helpers.GetPointer(invalidKeysSecret.Name),
+			}),
+			expCond: helpers.GetPointer(conditions.NewGatewaySessionTicketKeysInvalid(
+				"spec.tls.sessionTickets.keysSecretName: Invalid value: \"test/invalid-ticket-keys\": " +
+					"secret does not have any session ticket keys",
+			)),
+			expResolvedSecrets: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolver := newSecretResolver(map[types.NamespacedName]*apiv1.Secret{
+				client.ObjectKeyFromObject(keysSecret):        keysSecret,
+				client.ObjectKeyFromObject(invalidKeysSecret): invalidKeysSecret,
+			})
+
+			nsname, cond := resolveSessionTicketKeys("test", test.np, resolver)
+			g.Expect(nsname).To(Equal(test.expNsName))
+			g.Expect(cond).To(Equal(test.expCond))
+			g.Expect(resolver.getResolvedSecrets()).To(HaveLen(test.expResolvedSecrets))
+		})
+	}
+}
+
+func TestResolveAgentToken(t *testing.T) {
+	t.Parallel()
+
+	tokenSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "agent-token",
+		},
+		Data: map[string][]byte{
+			AgentTokenKey: []byte("token"),
+		},
+	}
+
+	invalidTokenSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "invalid-agent-token",
+		},
+	}
+
+	newNginxProxy := func(secretName string) *EffectiveNginxProxy {
+		return &EffectiveNginxProxy{
+			StaticDataPlane: &ngfAPIv1alpha2.StaticDataPlane{
+				TokenSecretName: secretName,
+			},
+		}
+	}
+
+	tests := []struct {
+		np                 *EffectiveNginxProxy
+		expNsName          *types.NamespacedName
+		expCond            *conditions.Condition
+		name               string
+		expResolvedSecrets int
+	}{
+		{
+			name: "no nginx proxy",
+		},
+		{
+			name: "static data plane not enabled",
+			np:   &EffectiveNginxProxy{},
+		},
+		{
+			name:               "valid token",
+			np:                 newNginxProxy(tokenSecret.Name),
+			expNsName:          helpers.GetPointer(client.ObjectKeyFromObject(tokenSecret)),
+			expResolvedSecrets: 1,
+		},
+		{
+			name: "invalid token",
+			np:   newNginxProxy(invalidTokenSecret.Name),
+			expCond: helpers.GetPointer(conditions.NewGatewayAgentTokenInvalid(
+				"spec.staticDataPlane.tokenSecretName: Invalid value: \"test/invalid-agent-token\": " +
+					"secret does not have the data field token",
+			)),
+			expResolvedSecrets: 1,
+		},
+		{
+			name: "missing secret",
+			np:   newNginxProxy("missing"),
+			expCond: helpers.GetPointer(conditions.NewGatewayAgentTokenInvalid(
+				"spec.staticDataPlane.tokenSecretName: Invalid value: \"test/missing\": secret does not exist",
+			)),
+			expResolvedSecrets: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolver := newSecretResolver(map[types.NamespacedName]*apiv1.Secret{
+				client.ObjectKeyFromObject(tokenSecret):        tokenSecret,
+				client.ObjectKeyFromObject(invalidTokenSecret): invalidTokenSecret,
+			})
+
+			nsname, cond := resolveAgentToken("test", test.np, resolver)
+			g.Expect(nsname).To(Equal(test.expNsName))
+			g.Expect(cond).To(Equal(test.expCond))
+			g.Expect(resolver.getResolvedSecrets()).To(HaveLen(test.expResolvedSecrets))
+		})
+	}
+}
@@ -18,6 +18,8 @@ const (
 	BundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
 	// SupportedVersion is the supported version of the Gateway API CRDs.
 	SupportedVersion = "v1.3.0"
+	// PodMonitorCRDName is the name of the Prometheus Operator PodMonitor CRD.
+	PodMonitorCRDName = "podmonitors.monitoring.coreos.com"
 )
 
 var gatewayCRDs = map[string]apiVersion{
@@ -249,3 +251,9 @@ func getBundleVersions(crdMetadata map[types.NamespacedName]*metav1.PartialObjec
 
 	return versions
 }
+
+// podMonitorCRDInstalled returns whether the Prometheus Operator PodMonitor CRD is installed.
+func podMonitorCRDInstalled(crdMetadata map[types.NamespacedName]*metav1.PartialObjectMetadata) bool {
+	_, ok := crdMetadata[types.NamespacedName{Name: PodMonitorCRDName}]
+	return ok
+}
@@ -452,3 +452,16 @@ func TestValidateCRDVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestPodMonitorCRDInstalled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	crds := map[types.NamespacedName]*metav1.PartialObjectMetadata{
+		{Name: "gateways.gateway.networking.k8s.io"}: {},
+	}
+	g.Expect(podMonitorCRDInstalled(crds)).To(BeFalse())
+
+	crds[types.NamespacedName{Name: PodMonitorCRDName}] = &metav1.PartialObjectMetadata{}
+	g.Expect(podMonitorCRDInstalled(crds)).To(BeTrue())
+}
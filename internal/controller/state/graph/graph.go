@@ -24,21 +24,24 @@ import (
 
 // ClusterState includes cluster resources necessary to build the Graph.
 type ClusterState struct {
-	GatewayClasses     map[types.NamespacedName]*gatewayv1.GatewayClass
-	Gateways           map[types.NamespacedName]*gatewayv1.Gateway
-	HTTPRoutes         map[types.NamespacedName]*gatewayv1.HTTPRoute
-	TLSRoutes          map[types.NamespacedName]*v1alpha2.TLSRoute
-	Services           map[types.NamespacedName]*v1.Service
-	Namespaces         map[types.NamespacedName]*v1.Namespace
-	ReferenceGrants    map[types.NamespacedName]*v1beta1.ReferenceGrant
-	Secrets            map[types.NamespacedName]*v1.Secret
-	CRDMetadata        map[types.NamespacedName]*metav1.PartialObjectMetadata
-	BackendTLSPolicies map[types.NamespacedName]*v1alpha3.BackendTLSPolicy
-	ConfigMaps         map[types.NamespacedName]*v1.ConfigMap
-	NginxProxies       map[types.NamespacedName]*ngfAPIv1alpha2.NginxProxy
-	GRPCRoutes         map[types.NamespacedName]*gatewayv1.GRPCRoute
-	NGFPolicies        map[PolicyKey]policies.Policy
-	SnippetsFilters    map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter
+	GatewayClasses        map[types.NamespacedName]*gatewayv1.GatewayClass
+	Gateways              map[types.NamespacedName]*gatewayv1.Gateway
+	HTTPRoutes            map[types.NamespacedName]*gatewayv1.HTTPRoute
+	TLSRoutes             map[types.NamespacedName]*v1alpha2.TLSRoute
+	Services              map[types.NamespacedName]*v1.Service
+	Namespaces            map[types.NamespacedName]*v1.Namespace
+	ReferenceGrants       map[types.NamespacedName]*v1beta1.ReferenceGrant
+	Secrets               map[types.NamespacedName]*v1.Secret
+	CRDMetadata           map[types.NamespacedName]*metav1.PartialObjectMetadata
+	BackendTLSPolicies    map[types.NamespacedName]*v1alpha3.BackendTLSPolicy
+	ConfigMaps            map[types.NamespacedName]*v1.ConfigMap
+	NginxProxies          map[types.NamespacedName]*ngfAPIv1alpha2.NginxProxy
+	GRPCRoutes            map[types.NamespacedName]*gatewayv1.GRPCRoute
+	NGFPolicies           map[PolicyKey]policies.Policy
+	SnippetsFilters       map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter
+	DirectResponseFilters map[types.NamespacedName]*ngfAPIv1alpha1.DirectResponseFilter
+	GRPCRewriteFilters    map[types.NamespacedName]*ngfAPIv1alpha1.GRPCRewriteFilter
+	GRPCWebFilters        map[types.NamespacedName]*ngfAPIv1alpha1.GRPCWebFilter
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
@@ -66,14 +69,26 @@ type Graph struct {
 	ReferencedServices map[types.NamespacedName]*ReferencedService
 	// ReferencedCaCertConfigMaps includes ConfigMaps that have been referenced by any BackendTLSPolicies.
 	ReferencedCaCertConfigMaps map[types.NamespacedName]*CaCertConfigMap
+	// ReferencedErrorPageConfigMaps includes ConfigMaps that have been referenced by any ErrorPagePolicies,
+	// including ones that do not exist in the cluster.
+	ReferencedErrorPageConfigMaps map[types.NamespacedName]struct{}
 	// ReferencedNginxProxies includes NginxProxies that have been referenced by a GatewayClass or a Gateway.
 	ReferencedNginxProxies map[types.NamespacedName]*NginxProxy
 	// BackendTLSPolicies holds BackendTLSPolicy resources.
 	BackendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy
 	// NGFPolicies holds all NGF Policies.
 	NGFPolicies map[PolicyKey]*Policy
+	// ErrorPageResources holds the resolved resources of the valid ErrorPagePolicies, keyed by the
+	// NamespacedName of the ErrorPagePolicy.
+	ErrorPageResources map[types.NamespacedName]*ErrorPageResources
 	// SnippetsFilters holds all the SnippetsFilters.
 	SnippetsFilters map[types.NamespacedName]*SnippetsFilter
+	// DirectResponseFilters holds all the DirectResponseFilters.
+	DirectResponseFilters map[types.NamespacedName]*DirectResponseFilter
+	// GRPCRewriteFilters holds all the GRPCRewriteFilters.
+	GRPCRewriteFilters map[types.NamespacedName]*GRPCRewriteFilter
+	// GRPCWebFilters holds all the GRPCWebFilters.
+	GRPCWebFilters map[types.NamespacedName]*GRPCWebFilter
 	// PlusSecrets holds the secrets related to NGINX Plus licensing.
 	PlusSecrets map[types.NamespacedName][]PlusSecretFile
 }
@@ -96,8 +111,9 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 		_, plusSecretExists := g.PlusSecrets[nsname]
 		return exists || plusSecretExists
 	case *v1.ConfigMap:
-		_, exists := g.ReferencedCaCertConfigMaps[nsname]
-		return exists
+		_, caCertExists := g.ReferencedCaCertConfigMaps[nsname]
+		_, errorPageExists := g.ReferencedErrorPageConfigMaps[nsname]
+		return caCertExists || errorPageExists || g.isReferencedByDirectResponseFilter(nsname)
 	case *v1.Namespace:
 		// `existed` is needed as it checks the graph's ReferencedNamespaces which stores all the namespaces that
 		// match the Gateway listener's label selector when the graph was created. This covers the case when
@@ -172,6 +188,16 @@ func (g *Graph) IsNGFPolicyRelevant(
 	return false
 }
 
+func (g *Graph) isReferencedByDirectResponseFilter(configMapNsName types.NamespacedName) bool {
+	for _, drf := range g.DirectResponseFilters {
+		if drf.ConfigMap != nil && *drf.ConfigMap == configMapNsName {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (g *Graph) gatewayAPIResourceExist(ref v1alpha2.LocalPolicyTargetReference, policyNs string) bool {
 	refNsName := types.NamespacedName{Name: string(ref.Name), Namespace: policyNs}
 
@@ -230,6 +256,7 @@ func BuildGraph(
 		gc,
 		refGrantResolver,
 		processedNginxProxies,
+		podMonitorCRDInstalled(state.CRDMetadata),
 	)
 
 	processedBackendTLSPolicies := processBackendTLSPolicies(
@@ -241,6 +268,13 @@ func BuildGraph(
 	)
 
 	processedSnippetsFilters := processSnippetsFilters(state.SnippetsFilters)
+	processedDirectResponseFilters := processDirectResponseFilters(
+		state.DirectResponseFilters,
+		state.ConfigMaps,
+		validators.GenericValidator,
+	)
+	processedGRPCRewriteFilters := processGRPCRewriteFilters(state.GRPCRewriteFilters, validators.HTTPFieldsValidator)
+	processedGRPCWebFilters := processGRPCWebFilters(state.GRPCWebFilters)
 
 	routes := buildRoutesForGateways(
 		validators.HTTPFieldsValidator,
@@ -248,6 +282,9 @@ func BuildGraph(
 		state.GRPCRoutes,
 		gws,
 		processedSnippetsFilters,
+		processedDirectResponseFilters,
+		processedGRPCRewriteFilters,
+		processedGRPCWebFilters,
 	)
 
 	l4routes := buildL4RoutesForGateways(
@@ -280,23 +317,36 @@ func BuildGraph(
 		gws,
 	)
 
+	errorPageResources, referencedErrorPageConfigMaps := processErrorPagePolicies(
+		processedPolicies,
+		state.ConfigMaps,
+		state.Services,
+	)
+	referencedServices = addServicesForErrorPagePolicies(processedPolicies, routes, referencedServices)
+	addBackendServicesToGateways(gws, referencedServices, state.Services)
+
 	setPlusSecretContent(state.Secrets, plusSecrets)
 
 	g := &Graph{
-		GatewayClass:               gc,
-		Gateways:                   gws,
-		Routes:                     routes,
-		L4Routes:                   l4routes,
-		IgnoredGatewayClasses:      processedGwClasses.Ignored,
-		ReferencedSecrets:          secretResolver.getResolvedSecrets(),
-		ReferencedNamespaces:       referencedNamespaces,
-		ReferencedServices:         referencedServices,
-		ReferencedCaCertConfigMaps: configMapResolver.getResolvedConfigMaps(),
-		ReferencedNginxProxies:     processedNginxProxies,
-		BackendTLSPolicies:         processedBackendTLSPolicies,
-		NGFPolicies:                processedPolicies,
-		SnippetsFilters:            processedSnippetsFilters,
-		PlusSecrets:                plusSecrets,
+		GatewayClass:                  gc,
+		Gateways:                      gws,
+		Routes:                        routes,
+		L4Routes:                      l4routes,
+		IgnoredGatewayClasses:         processedGwClasses.Ignored,
+		ReferencedSecrets:             secretResolver.getResolvedSecrets(),
+		ReferencedNamespaces:          referencedNamespaces,
+		ReferencedServices:            referencedServices,
+		ReferencedCaCertConfigMaps:    configMapResolver.getResolvedConfigMaps(),
+		ReferencedErrorPageConfigMaps: referencedErrorPageConfigMaps,
+		ReferencedNginxProxies:        processedNginxProxies,
+		BackendTLSPolicies:            processedBackendTLSPolicies,
+		NGFPolicies:                   processedPolicies,
+		ErrorPageResources:            errorPageResources,
+		SnippetsFilters:               processedSnippetsFilters,
+		DirectResponseFilters:         processedDirectResponseFilters,
+		GRPCRewriteFilters:            processedGRPCRewriteFilters,
+		GRPCWebFilters:                processedGRPCWebFilters,
+		PlusSecrets:                   plusSecrets,
 	}
 
 	g.attachPolicies(validators.PolicyValidator, controllerName)
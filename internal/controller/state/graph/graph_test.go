@@ -87,6 +87,7 @@ func TestBuildGraph(t *testing.T) {
 		Gateways:     []types.NamespacedName{{Namespace: testNs, Name: "gateway-1"}},
 		Conditions:   btpAcceptedConds,
 		CaCertRef:    types.NamespacedName{Namespace: "service", Name: "configmap"},
+		CaCertKind:   kinds.ConfigMap,
 	}
 
 	commonGWBackendRef := gatewayv1.BackendRef{
@@ -1426,6 +1427,12 @@ func TestIsReferenced(t *testing.T) {
 			Name:      "configmap",
 		},
 	}
+	directResponseConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "direct-response-configmap",
+		},
+	}
 
 	npNotReferenced := &ngfAPIv1alpha2.NginxProxy{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1465,6 +1472,12 @@ func TestIsReferenced(t *testing.T) {
 				Source: npReferenced,
 			},
 		},
+		DirectResponseFilters: map[types.NamespacedName]*DirectResponseFilter{
+			{Namespace: testNs, Name: "drf"}: {
+				ConfigMap: helpers.GetPointer(client.ObjectKeyFromObject(directResponseConfigMap)),
+			},
+			{Namespace: testNs, Name: "drf-inline"}: {},
+		},
 	}
 
 	tests := []struct {
@@ -1592,6 +1605,12 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: false,
 		},
+		{
+			name:     "ConfigMap referenced by a DirectResponseFilter is referenced",
+			resource: directResponseConfigMap,
+			graph:    graph,
+			expected: true,
+		},
 
 		// NginxProxy tests
 		{
@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+const (
+	grpcIdentFmt       = `[a-zA-Z_][a-zA-Z0-9_]*`
+	grpcServiceNameFmt = grpcIdentFmt + `(\.` + grpcIdentFmt + `)*`
+)
+
+var (
+	grpcServiceNameRegexp = regexp.MustCompile("^" + grpcServiceNameFmt + "$")
+	grpcMethodNameRegexp  = regexp.MustCompile("^" + grpcIdentFmt + "$")
+)
+
+// GRPCRewriteFilter represents a ngfAPI.GRPCRewriteFilter.
+type GRPCRewriteFilter struct {
+	// Source is the GRPCRewriteFilter.
+	Source *ngfAPI.GRPCRewriteFilter
+	// Conditions define the conditions to be reported in the status of the GRPCRewriteFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the GRPCRewriteFilter is semantically and syntactically valid.
+	Valid bool
+	// Referenced indicates whether the GRPCRewriteFilter is referenced by a Route.
+	Referenced bool
+}
+
+// getGRPCRewriteFilterResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to a GRPCRewriteFilter in the given namespace.
+// If the GRPCRewriteFilter exists, it is marked as referenced and returned as an ExtensionRefFilter.
+func getGRPCRewriteFilterResolverForNamespace(
+	grpcRewriteFilters map[types.NamespacedName]*GRPCRewriteFilter,
+	ns string,
+) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		if len(grpcRewriteFilters) == 0 {
+			return nil
+		}
+
+		if ref.Group != ngfAPI.GroupName || ref.Kind != kinds.GRPCRewriteFilter {
+			return nil
+		}
+
+		grf := grpcRewriteFilters[types.NamespacedName{Namespace: ns, Name: string(ref.Name)}]
+		if grf == nil {
+			return nil
+		}
+
+		grf.Referenced = true
+
+		return &ExtensionRefFilter{GRPCRewriteFilter: grf, Valid: grf.Valid}
+	}
+}
+
+func processGRPCRewriteFilters(
+	grpcRewriteFilters map[types.NamespacedName]*ngfAPI.GRPCRewriteFilter,
+	validator validation.HTTPFieldsValidator,
+) map[types.NamespacedName]*GRPCRewriteFilter {
+	if len(grpcRewriteFilters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*GRPCRewriteFilter)
+
+	for nsname, grf := range grpcRewriteFilters {
+		filter := &GRPCRewriteFilter{
+			Source: grf,
+			Valid:  true,
+		}
+
+		if errs := validateGRPCRewriteFilterSpec(grf.Spec, validator); len(errs) > 0 {
+			filter.Valid = false
+			filter.Conditions = []conditions.Condition{
+				conditions.NewGRPCRewriteFilterInvalid(errs.ToAggregate().Error()),
+			}
+		}
+
+		processed[nsname] = filter
+	}
+
+	return processed
+}
+
+func validateGRPCRewriteFilterSpec(
+	spec ngfAPI.GRPCRewriteFilterSpec,
+	validator validation.HTTPFieldsValidator,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	if spec.Hostname == nil && spec.Service == nil && spec.Method == nil {
+		return field.ErrorList{field.Required(specPath, "at least one of hostname, service or method must be set")}
+	}
+
+	if spec.Hostname != nil {
+		if err := validator.ValidateHostname(string(*spec.Hostname)); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("hostname"), *spec.Hostname, err.Error()))
+		}
+	}
+
+	if spec.Service != nil && !grpcServiceNameRegexp.MatchString(*spec.Service) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("service"),
+			*spec.Service,
+			"must be a fully qualified gRPC service name",
+		))
+	}
+
+	if spec.Method != nil && !grpcMethodNameRegexp.MatchString(*spec.Method) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("method"),
+			*spec.Method,
+			"must be a gRPC method name",
+		))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,206 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation/validationfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func createGRPCRewriteFilter(name string, spec ngfAPI.GRPCRewriteFilterSpec) *ngfAPI.GRPCRewriteFilter {
+	return &ngfAPI.GRPCRewriteFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: spec,
+	}
+}
+
+func TestProcessGRPCRewriteFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter    *ngfAPI.GRPCRewriteFilter
+		validator *validationfakes.FakeHTTPFieldsValidator
+		exp       *GRPCRewriteFilter
+		name      string
+	}{
+		{
+			name: "all fields",
+			filter: createGRPCRewriteFilter("all", ngfAPI.GRPCRewriteFilterSpec{
+				Hostname: helpers.GetPointer[v1.PreciseHostname]("billing.example.com"),
+				Service:  helpers.GetPointer("acme.billing.v2.Billing"),
+				Method:   helpers.GetPointer("GetInvoice"),
+			}),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			exp: &GRPCRewriteFilter{
+				Valid: true,
+			},
+		},
+		{
+			name: "service only",
+			filter: createGRPCRewriteFilter("service", ngfAPI.GRPCRewriteFilterSpec{
+				Service: helpers.GetPointer("Billing"),
+			}),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			exp: &GRPCRewriteFilter{
+				Valid: true,
+			},
+		},
+		{
+			name:      "empty spec",
+			filter:    createGRPCRewriteFilter("empty", ngfAPI.GRPCRewriteFilterSpec{}),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			exp: &GRPCRewriteFilter{
+				Conditions: []conditions.Condition{
+					conditions.NewGRPCRewriteFilterInvalid(
+						"spec: Required value: at least one of hostname, service or method must be set",
+					),
+				},
+			},
+		},
+		{
+			name: "invalid hostname",
+			filter: createGRPCRewriteFilter("invalid-hostname", ngfAPI.GRPCRewriteFilterSpec{
+				Hostname: helpers.GetPointer[v1.PreciseHostname]("billing.example.com"),
+			}),
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateHostnameReturns(errors.New("invalid hostname"))
+				return v
+			}(),
+			exp: &GRPCRewriteFilter{
+				Conditions: []conditions.Condition{
+					conditions.NewGRPCRewriteFilterInvalid(
+						"spec.hostname: Invalid value: \"billing.example.com\": invalid hostname",
+					),
+				},
+			},
+		},
+		{
+			name: "invalid service and method",
+			filter: createGRPCRewriteFilter("invalid-names", ngfAPI.GRPCRewriteFilterSpec{
+				Service: helpers.GetPointer("acme..Billing"),
+				Method:  helpers.GetPointer("Get/Invoice"),
+			}),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			exp: &GRPCRewriteFilter{
+				Conditions: []conditions.Condition{
+					conditions.NewGRPCRewriteFilterInvalid(
+						"[spec.service: Invalid value: \"acme..Billing\": must be a fully qualified gRPC service name, " +
+							"spec.method: Invalid value: \"Get/Invoice\": must be a gRPC method name]",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			nsname := types.NamespacedName{Namespace: test.filter.Namespace, Name: test.filter.Name}
+			processed := processGRPCRewriteFilters(
+				map[types.NamespacedName]*ngfAPI.GRPCRewriteFilter{nsname: test.filter},
+				test.validator,
+			)
+
+			test.exp.Source = test.filter
+			g.Expect(processed).To(HaveKeyWithValue(nsname, test.exp))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(processGRPCRewriteFilters(nil, &validationfakes.FakeHTTPFieldsValidator{})).To(BeNil())
+}
+
+func TestGetGRPCRewriteFilterResolverForNamespace(t *testing.T) {
+	t.Parallel()
+
+	createFilters := func() map[types.NamespacedName]*GRPCRewriteFilter {
+		return map[types.NamespacedName]*GRPCRewriteFilter{
+			{Namespace: "test", Name: "valid"}: {
+				Source: createGRPCRewriteFilter("valid", ngfAPI.GRPCRewriteFilterSpec{}),
+				Valid:  true,
+			},
+			{Namespace: "test", Name: "invalid"}: {
+				Source: createGRPCRewriteFilter("invalid", ngfAPI.GRPCRewriteFilterSpec{}),
+				Valid:  false,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ref        v1.LocalObjectReference
+		filters    map[types.NamespacedName]*GRPCRewriteFilter
+		namespace  string
+		expResolve bool
+		expValid   bool
+	}{
+		{
+			name:      "no filters",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCRewriteFilter, Name: "valid"},
+			namespace: "test",
+		},
+		{
+			name:      "wrong kind",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.SnippetsFilter, Name: "valid"},
+			filters:   createFilters(),
+			namespace: "test",
+		},
+		{
+			name:      "wrong namespace",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCRewriteFilter, Name: "valid"},
+			filters:   createFilters(),
+			namespace: "other",
+		},
+		{
+			name:       "valid filter",
+			ref:        v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCRewriteFilter, Name: "valid"},
+			filters:    createFilters(),
+			namespace:  "test",
+			expResolve: true,
+			expValid:   true,
+		},
+		{
+			name:       "invalid filter",
+			ref:        v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCRewriteFilter, Name: "invalid"},
+			filters:    createFilters(),
+			namespace:  "test",
+			expResolve: true,
+			expValid:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolve := getGRPCRewriteFilterResolverForNamespace(test.filters, test.namespace)
+			resolved := resolve(test.ref)
+			if !test.expResolve {
+				g.Expect(resolved).To(BeNil())
+				return
+			}
+
+			g.Expect(resolved).ToNot(BeNil())
+			g.Expect(resolved.GRPCRewriteFilter).ToNot(BeNil())
+			g.Expect(resolved.GRPCRewriteFilter.Referenced).To(BeTrue())
+			g.Expect(resolved.GRPCRewriteFilter.Source.Name).To(BeEquivalentTo(test.ref.Name))
+			g.Expect(resolved.Valid).To(Equal(test.expValid))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+var (
+	grpcWebOriginRegexp     = regexp.MustCompile(`^(\*|https?://[a-zA-Z0-9.\-]+(:[0-9]{1,5})?)$`)
+	grpcWebHeaderNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9\-]+$`)
+)
+
+// GRPCWebFilter represents a ngfAPI.GRPCWebFilter.
+type GRPCWebFilter struct {
+	// Source is the GRPCWebFilter.
+	Source *ngfAPI.GRPCWebFilter
+	// Conditions define the conditions to be reported in the status of the GRPCWebFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the GRPCWebFilter is semantically and syntactically valid.
+	Valid bool
+	// Referenced indicates whether the GRPCWebFilter is referenced by a Route.
+	Referenced bool
+}
+
+// getGRPCWebFilterResolverForNamespace returns a resolveExtRefFilter function.
+// This function resolves a LocalObjectReference to a GRPCWebFilter in the given namespace.
+// If the GRPCWebFilter exists, it is marked as referenced and returned as an ExtensionRefFilter.
+func getGRPCWebFilterResolverForNamespace(
+	grpcWebFilters map[types.NamespacedName]*GRPCWebFilter,
+	ns string,
+) resolveExtRefFilter {
+	return func(ref v1.LocalObjectReference) *ExtensionRefFilter {
+		if len(grpcWebFilters) == 0 {
+			return nil
+		}
+
+		if ref.Group != ngfAPI.GroupName || ref.Kind != kinds.GRPCWebFilter {
+			return nil
+		}
+
+		gwf := grpcWebFilters[types.NamespacedName{Namespace: ns, Name: string(ref.Name)}]
+		if gwf == nil {
+			return nil
+		}
+
+		gwf.Referenced = true
+
+		return &ExtensionRefFilter{GRPCWebFilter: gwf, Valid: gwf.Valid}
+	}
+}
+
+func processGRPCWebFilters(
+	grpcWebFilters map[types.NamespacedName]*ngfAPI.GRPCWebFilter,
+) map[types.NamespacedName]*GRPCWebFilter {
+	if len(grpcWebFilters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*GRPCWebFilter)
+
+	for nsname, gwf := range grpcWebFilters {
+		filter := &GRPCWebFilter{
+			Source: gwf,
+			Valid:  true,
+		}
+
+		if errs := validateGRPCWebFilterSpec(gwf.Spec); len(errs) > 0 {
+			filter.Valid = false
+			filter.Conditions = []conditions.Condition{
+				conditions.NewGRPCWebFilterInvalid(errs.ToAggregate().Error()),
+			}
+		}
+
+		processed[nsname] = filter
+	}
+
+	return processed
+}
+
+func validateGRPCWebFilterSpec(spec ngfAPI.GRPCWebFilterSpec) field.ErrorList {
+	if spec.CORS == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	corsPath := field.NewPath("spec").Child("cors")
+
+	if len(spec.CORS.AllowOrigins) == 0 {
+		allErrs = append(allErrs, field.Required(corsPath.Child("allowOrigins"), "at least one origin must be set"))
+	}
+
+	for i, origin := range spec.CORS.AllowOrigins {
+		if !grpcWebOriginRegexp.MatchString(string(origin)) {
+			allErrs = append(allErrs, field.Invalid(
+				corsPath.Child("allowOrigins").Index(i),
+				origin,
+				"must be \"*\" or an origin with an http or https scheme and an optional port",
+			))
+		}
+	}
+
+	for i, header := range spec.CORS.AllowHeaders {
+		if !grpcWebHeaderNameRegexp.MatchString(string(header)) {
+			allErrs = append(allErrs, field.Invalid(
+				corsPath.Child("allowHeaders").Index(i),
+				header,
+				"must only contain alphanumeric characters and '-'",
+			))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,186 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func createGRPCWebFilter(name string, spec ngfAPI.GRPCWebFilterSpec) *ngfAPI.GRPCWebFilter {
+	return &ngfAPI.GRPCWebFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: spec,
+	}
+}
+
+func TestProcessGRPCWebFilters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter *ngfAPI.GRPCWebFilter
+		exp    *GRPCWebFilter
+		name   string
+	}{
+		{
+			name:   "no cors",
+			filter: createGRPCWebFilter("no-cors", ngfAPI.GRPCWebFilterSpec{}),
+			exp: &GRPCWebFilter{
+				Valid: true,
+			},
+		},
+		{
+			name: "cors",
+			filter: createGRPCWebFilter("cors", ngfAPI.GRPCWebFilterSpec{
+				CORS: &ngfAPI.GRPCWebCORS{
+					AllowOrigins: []ngfAPI.GRPCWebOrigin{"https://app.example.com", "http://localhost:8080"},
+					AllowHeaders: []ngfAPI.GRPCWebHeaderName{"X-Tenant"},
+					MaxAge:       helpers.GetPointer[int32](600),
+				},
+			}),
+			exp: &GRPCWebFilter{
+				Valid: true,
+			},
+		},
+		{
+			name: "cors with no origins",
+			filter: createGRPCWebFilter("no-origins", ngfAPI.GRPCWebFilterSpec{
+				CORS: &ngfAPI.GRPCWebCORS{},
+			}),
+			exp: &GRPCWebFilter{
+				Conditions: []conditions.Condition{
+					conditions.NewGRPCWebFilterInvalid(
+						"spec.cors.allowOrigins: Required value: at least one origin must be set",
+					),
+				},
+			},
+		},
+		{
+			name: "invalid origin and header",
+			filter: createGRPCWebFilter("invalid", ngfAPI.GRPCWebFilterSpec{
+				CORS: &ngfAPI.GRPCWebCORS{
+					AllowOrigins: []ngfAPI.GRPCWebOrigin{"*", "app.example.com"},
+					AllowHeaders: []ngfAPI.GRPCWebHeaderName{"X Tenant"},
+				},
+			}),
+			exp: &GRPCWebFilter{
+				Conditions: []conditions.Condition{
+					conditions.NewGRPCWebFilterInvalid(
+						"[spec.cors.allowOrigins[1]: Invalid value: \"app.example.com\": " +
+							"must be \"*\" or an origin with an http or https scheme and an optional port, " +
+							"spec.cors.allowHeaders[0]: Invalid value: \"X Tenant\": " +
+							"must only contain alphanumeric characters and '-']",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			nsname := types.NamespacedName{Namespace: test.filter.Namespace, Name: test.filter.Name}
+			processed := processGRPCWebFilters(
+				map[types.NamespacedName]*ngfAPI.GRPCWebFilter{nsname: test.filter},
+			)
+
+			test.exp.Source = test.filter
+			g.Expect(processed).To(HaveKeyWithValue(nsname, test.exp))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(processGRPCWebFilters(nil)).To(BeNil())
+}
+
+func TestGetGRPCWebFilterResolverForNamespace(t *testing.T) {
+	t.Parallel()
+
+	createFilters := func() map[types.NamespacedName]*GRPCWebFilter {
+		return map[types.NamespacedName]*GRPCWebFilter{
+			{Namespace: "test", Name: "valid"}: {
+				Source: createGRPCWebFilter("valid", ngfAPI.GRPCWebFilterSpec{}),
+				Valid:  true,
+			},
+			{Namespace: "test", Name: "invalid"}: {
+				Source: createGRPCWebFilter("invalid", ngfAPI.GRPCWebFilterSpec{}),
+				Valid:  false,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ref        v1.LocalObjectReference
+		filters    map[types.NamespacedName]*GRPCWebFilter
+		namespace  string
+		expResolve bool
+		expValid   bool
+	}{
+		{
+			name:      "no filters",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCWebFilter, Name: "valid"},
+			namespace: "test",
+		},
+		{
+			name:      "wrong kind",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.SnippetsFilter, Name: "valid"},
+			filters:   createFilters(),
+			namespace: "test",
+		},
+		{
+			name:      "wrong namespace",
+			ref:       v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCWebFilter, Name: "valid"},
+			filters:   createFilters(),
+			namespace: "other",
+		},
+		{
+			name:       "valid filter",
+			ref:        v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCWebFilter, Name: "valid"},
+			filters:    createFilters(),
+			namespace:  "test",
+			expResolve: true,
+			expValid:   true,
+		},
+		{
+			name:       "invalid filter",
+			ref:        v1.LocalObjectReference{Group: ngfAPI.GroupName, Kind: kinds.GRPCWebFilter, Name: "invalid"},
+			filters:    createFilters(),
+			namespace:  "test",
+			expResolve: true,
+			expValid:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resolve := getGRPCWebFilterResolverForNamespace(test.filters, test.namespace)
+			resolved := resolve(test.ref)
+			if !test.expResolve {
+				g.Expect(resolved).To(BeNil())
+				return
+			}
+
+			g.Expect(resolved).ToNot(BeNil())
+			g.Expect(resolved.GRPCWebFilter).ToNot(BeNil())
+			g.Expect(resolved.GRPCWebFilter.Referenced).To(BeTrue())
+			g.Expect(resolved.GRPCWebFilter.Source.Name).To(BeEquivalentTo(test.ref.Name))
+			g.Expect(resolved.Valid).To(Equal(test.expValid))
+		})
+	}
+}
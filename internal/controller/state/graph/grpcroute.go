@@ -2,6 +2,7 @@ package graph
 
 import (
 	"fmt"
+	"regexp/syntax"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -20,6 +21,8 @@ func buildGRPCRoute(
 	ghr *v1.GRPCRoute,
 	gws map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	grpcRewriteFilters map[types.NamespacedName]*GRPCRewriteFilter,
+	grpcWebFilters map[types.NamespacedName]*GRPCWebFilter,
 ) *L7Route {
 	r := &L7Route{
 		Source:    ghr,
@@ -54,7 +57,13 @@ func buildGRPCRoute(
 	rules, valid, conds := processGRPCRouteRules(
 		ghr.Spec.Rules,
 		validator,
-		getSnippetsFilterResolverForNamespace(snippetsFilters, r.Source.GetNamespace()),
+		getExtRefFilterResolverForNamespace(
+			snippetsFilters,
+			nil,
+			grpcRewriteFilters,
+			grpcWebFilters,
+			r.Source.GetNamespace(),
+		),
 	)
 
 	r.Spec.Rules = rules
@@ -70,6 +79,8 @@ func buildGRPCMirrorRoutes(
 	route *v1.GRPCRoute,
 	gateways map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	grpcRewriteFilters map[types.NamespacedName]*GRPCRewriteFilter,
+	grpcWebFilters map[types.NamespacedName]*GRPCWebFilter,
 ) {
 	for idx, rule := range l7route.Spec.Rules {
 		if rule.Filters.Valid {
@@ -101,6 +112,8 @@ func buildGRPCMirrorRoutes(
 					tmpMirrorRoute,
 					gateways,
 					snippetsFilters,
+					grpcRewriteFilters,
+					grpcWebFilters,
 				)
 
 				if mirrorRoute != nil {
@@ -250,7 +263,7 @@ func processGRPCRouteRules(
 		rules[i] = rr
 	}
 
-	conds = make([]conditions.Condition, 0, 2)
+	conds = make([]conditions.Condition, 0, 3)
 	valid = true
 
 	if len(allRulesErrors.invalid) > 0 {
@@ -271,6 +284,11 @@ func processGRPCRouteRules(
 		conds = append(conds, conditions.NewRouteResolvedRefsInvalidFilter(msg))
 	}
 
+	// rounded mirror percentages do not invalidate routes
+	if msgs := getMirrorPercentRoundedMessages(rules); len(msgs) > 0 {
+		conds = append(conds, conditions.NewRouteMirrorPercentRounded(strings.Join(msgs, "; ")))
+	}
+
 	return rules, valid, conds
 }
 
@@ -304,6 +322,16 @@ func ConvertGRPCMatches(grpcMatches []v1.GRPCRouteMatch) []v1.HTTPRouteMatch {
 		}
 		hm.Headers = hmHeaders
 
+		if gm.Method != nil && gm.Method.Type != nil && *gm.Method.Type == v1.GRPCMethodMatchRegularExpression {
+			hm.Path = &v1.HTTPPathMatch{
+				Type:  helpers.GetPointer(v1.PathMatchRegularExpression),
+				Value: helpers.GetPointer(createGRPCMethodPathRegex(gm.Method)),
+			}
+
+			hms = append(hms, hm)
+			continue
+		}
+
 		if gm.Method != nil && gm.Method.Service != nil {
 			// service path used in mirror routes are special case; method is not specified
 			if strings.HasPrefix(*gm.Method.Service, http.InternalMirrorRoutePathPrefix) {
@@ -374,16 +402,25 @@ func validateGRPCMethodMatch(
 	var allErrs field.ErrorList
 
 	if method != nil {
-		methodServicePath := methodPath.Child("service")
-		methodMethodPath := methodPath.Child("method")
 		if method.Type == nil {
 			allErrs = append(allErrs, field.Required(methodPath.Child("type"), "cannot be empty"))
-		} else if *method.Type != v1.GRPCMethodMatchExact {
+		} else if *method.Type != v1.GRPCMethodMatchExact && *method.Type != v1.GRPCMethodMatchRegularExpression {
 			allErrs = append(
 				allErrs,
-				field.NotSupported(methodPath.Child("type"), *method.Type, []string{string(v1.GRPCMethodMatchExact)}),
+				field.NotSupported(
+					methodPath.Child("type"),
+					*method.Type,
+					[]string{string(v1.GRPCMethodMatchExact), string(v1.GRPCMethodMatchRegularExpression)},
+				),
 			)
 		}
+
+		if method.Type != nil && *method.Type == v1.GRPCMethodMatchRegularExpression {
+			return append(allErrs, validateGRPCMethodRegexMatch(validator, method, methodPath)...)
+		}
+
+		methodServicePath := methodPath.Child("service")
+		methodMethodPath := methodPath.Child("method")
 		if method.Service == nil || *method.Service == "" {
 			allErrs = append(allErrs, field.Required(methodServicePath, "service is required"))
 		} else {
@@ -414,6 +451,111 @@ func validateGRPCMethodMatch(
 	return allErrs
 }
 
+// validateGRPCMethodRegexMatch validates a RegularExpression method match. At least one of the service or method
+// patterns must be set. The patterns are combined into a single regular expression for the request path
+// (see createGRPCMethodPathRegex), so they can only be anchored at their start and end.
+func validateGRPCMethodRegexMatch(
+	validator validation.HTTPFieldsValidator,
+	method *v1.GRPCMethodMatch,
+	methodPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if method.Service == nil && method.Method == nil {
+		return field.ErrorList{field.Required(methodPath, "one of service or method is required")}
+	}
+
+	validatePattern := func(pattern *string, patternPath *field.Path) {
+		if pattern == nil {
+			return
+		}
+
+		if *pattern == "" {
+			allErrs = append(allErrs, field.Required(patternPath, "cannot be empty"))
+			return
+		}
+
+		trimmed := trimRegexAnchors(*pattern)
+		if err := validator.ValidatePathRegexInMatch(trimmed); err != nil {
+			allErrs = append(allErrs, field.Invalid(patternPath, *pattern, err.Error()))
+			return
+		}
+
+		if containsRegexAnchors(trimmed) {
+			msg := "can only contain the '^' and '$' anchors at the start and end of the regular expression"
+			allErrs = append(allErrs, field.Invalid(patternPath, *pattern, msg))
+		}
+	}
+
+	validatePattern(method.Service, methodPath.Child("service"))
+	validatePattern(method.Method, methodPath.Child("method"))
+
+	return allErrs
+}
+
+// createGRPCMethodPathRegex returns the regular expression that matches the path "/{service}/{method}" of the gRPC
+// requests for a RegularExpression method match. The service and method patterns must match the whole service and
+// method names, so their anchors are removed and the whole path is anchored instead.
+// If the service or method pattern is not set, any service or method matches.
+func createGRPCMethodPathRegex(method *v1.GRPCMethodMatch) string {
+	servicePattern := "[^/]+"
+	if method.Service != nil {
+		servicePattern = trimRegexAnchors(*method.Service)
+	}
+
+	methodPattern := "[^/]+"
+	if method.Method != nil {
+		methodPattern = trimRegexAnchors(*method.Method)
+	}
+
+	return fmt.Sprintf("^/(?:%s)/(?:%s)$", servicePattern, methodPattern)
+}
+
+// trimRegexAnchors removes the leading '^' and the trailing unescaped '$' from a regular expression.
+func trimRegexAnchors(pattern string) string {
+	pattern = strings.TrimPrefix(pattern, "^")
+
+	if !strings.HasSuffix(pattern, "$") {
+		return pattern
+	}
+
+	// the '$' is escaped if it's preceded by an odd number of backslashes.
+	withoutDollar := strings.TrimSuffix(pattern, "$")
+	backslashes := len(withoutDollar) - len(strings.TrimRight(withoutDollar, `\`))
+	if backslashes%2 == 1 {
+		return pattern
+	}
+
+	return withoutDollar
+}
+
+// containsRegexAnchors returns true if the regular expression contains any '^' or '$' anchors.
+// It returns false if the regular expression can't be parsed.
+func containsRegexAnchors(pattern string) bool {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return false
+	}
+
+	var hasAnchors func(re *syntax.Regexp) bool
+	hasAnchors = func(re *syntax.Regexp) bool {
+		switch re.Op {
+		case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+			return true
+		}
+
+		for _, sub := range re.Sub {
+			if hasAnchors(sub) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return hasAnchors(re)
+}
+
 func validateGRPCHeaderMatch(
 	validator validation.HTTPFieldsValidator,
 	headerType *v1.GRPCHeaderMatchType,
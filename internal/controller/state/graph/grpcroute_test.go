@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -230,6 +231,9 @@ func TestBuildGRPCRoutes(t *testing.T) {
 				grRoutes,
 				test.gateways,
 				snippetsFilters,
+				nil,
+				nil,
+				nil,
 			)
 			g.Expect(helpers.Diff(test.expected, routes)).To(BeEmpty())
 		})
@@ -647,7 +651,8 @@ func TestBuildGRPCRoute(t *testing.T) {
 				Conditions: []conditions.Condition{
 					conditions.NewRouteUnsupportedValue(
 						`All rules are invalid: ` +
-							`[spec.rules[0].matches[0].method.type: Unsupported value: "": supported values: "Exact",` +
+							`[spec.rules[0].matches[0].method.type: Unsupported value: "": ` +
+							`supported values: "Exact", "RegularExpression",` +
 							` spec.rules[0].matches[0].method.service: Required value: service is required,` +
 							` spec.rules[0].matches[0].method.method: Required value: method is required]`,
 					),
@@ -1082,7 +1087,7 @@ func TestBuildGRPCRoute(t *testing.T) {
 			snippetsFilters := map[types.NamespacedName]*SnippetsFilter{
 				{Namespace: "test", Name: "sf"}: {Valid: true},
 			}
-			route := buildGRPCRoute(test.validator, test.gr, gws, snippetsFilters)
+			route := buildGRPCRoute(test.validator, test.gr, gws, snippetsFilters, nil, nil)
 			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
 		})
 	}
@@ -1235,10 +1240,10 @@ func TestBuildGRPCRouteWithMirrorRoutes(t *testing.T) {
 	g := NewWithT(t)
 
 	routes := map[RouteKey]*L7Route{}
-	l7route := buildGRPCRoute(validator, gr, gateways, snippetsFilters)
+	l7route := buildGRPCRoute(validator, gr, gateways, snippetsFilters, nil, nil)
 	g.Expect(l7route).NotTo(BeNil())
 
-	buildGRPCMirrorRoutes(routes, l7route, gr, gateways, snippetsFilters)
+	buildGRPCMirrorRoutes(routes, l7route, gr, gateways, snippetsFilters, nil, nil)
 
 	obj, ok := expectedMirrorRoute.Source.(*v1.GRPCRoute)
 	g.Expect(ok).To(BeTrue())
@@ -1331,6 +1336,26 @@ func TestConvertGRPCMatches(t *testing.T) {
 			methodMatches: []v1.GRPCRouteMatch{},
 			expected:      expectedEmptyMatches,
 		},
+		{
+			name: "method matches regular expression",
+			methodMatches: []v1.GRPCRouteMatch{
+				{
+					Method: &v1.GRPCMethodMatch{
+						Type:    helpers.GetPointer(v1.GRPCMethodMatchRegularExpression),
+						Service: helpers.GetPointer(`^acme\.billing\..*`),
+					},
+				},
+			},
+			expected: []v1.HTTPRouteMatch{
+				{
+					Path: &v1.HTTPPathMatch{
+						Type:  helpers.GetPointer(v1.PathMatchRegularExpression),
+						Value: helpers.GetPointer(`^/(?:acme\.billing\..*)/(?:[^/]+)$`),
+					},
+					Headers: []v1.HTTPHeaderMatch{},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1376,3 +1401,135 @@ func TestConvertGRPCHeaderMatchType(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGRPCMethodMatch_RegularExpression(t *testing.T) {
+	t.Parallel()
+
+	createRegexMethodMatch := func(service, method *string) *v1.GRPCMethodMatch {
+		return &v1.GRPCMethodMatch{
+			Type:    helpers.GetPointer(v1.GRPCMethodMatchRegularExpression),
+			Service: service,
+			Method:  method,
+		}
+	}
+
+	tests := []struct {
+		method        *v1.GRPCMethodMatch
+		validator     *validationfakes.FakeHTTPFieldsValidator
+		name          string
+		expErrStrings []string
+	}{
+		{
+			name:      "service and method",
+			method:    createRegexMethodMatch(helpers.GetPointer(`^acme\.billing\..*$`), helpers.GetPointer("Get.*")),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+		},
+		{
+			name:      "service only",
+			method:    createRegexMethodMatch(helpers.GetPointer(`acme\.billing\..*`), nil),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+		},
+		{
+			name:      "method only",
+			method:    createRegexMethodMatch(nil, helpers.GetPointer("(Get|List).*")),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+		},
+		{
+			name:          "neither service nor method",
+			method:        createRegexMethodMatch(nil, nil),
+			validator:     &validationfakes.FakeHTTPFieldsValidator{},
+			expErrStrings: []string{"test.method: Required value: one of service or method is required"},
+		},
+		{
+			name:          "empty service",
+			method:        createRegexMethodMatch(helpers.GetPointer(""), helpers.GetPointer("Get")),
+			validator:     &validationfakes.FakeHTTPFieldsValidator{},
+			expErrStrings: []string{"test.method.service: Required value: cannot be empty"},
+		},
+		{
+			name:      "anchors in the middle",
+			method:    createRegexMethodMatch(helpers.GetPointer("acme|^billing"), helpers.GetPointer("Get$|List")),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			expErrStrings: []string{
+				`test.method.service: Invalid value: "acme|^billing": can only contain the '^' and '$' anchors ` +
+					"at the start and end of the regular expression",
+				`test.method.method: Invalid value: "Get$|List": can only contain the '^' and '$' anchors ` +
+					"at the start and end of the regular expression",
+			},
+		},
+		{
+			name:   "invalid regular expressions",
+			method: createRegexMethodMatch(helpers.GetPointer("acme("), helpers.GetPointer("Get(")),
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidatePathRegexInMatchReturns(errors.New("invalid regex"))
+				return v
+			}(),
+			expErrStrings: []string{
+				`test.method.service: Invalid value: "acme(": invalid regex`,
+				`test.method.method: Invalid value: "Get(": invalid regex`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateGRPCMethodMatch(test.validator, test.method, field.NewPath("test").Child("method"))
+			g.Expect(allErrs).To(HaveLen(len(test.expErrStrings)))
+			for i, err := range allErrs {
+				g.Expect(err.Error()).To(Equal(test.expErrStrings[i]))
+			}
+		})
+	}
+}
+
+func TestCreateGRPCMethodPathRegex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		service  *string
+		method   *string
+		name     string
+		expected string
+	}{
+		{
+			name:     "service and method",
+			service:  helpers.GetPointer(`acme\.billing\.v1\.Billing`),
+			method:   helpers.GetPointer("(Get|List)Invoices?"),
+			expected: `^/(?:acme\.billing\.v1\.Billing)/(?:(Get|List)Invoices?)$`,
+		},
+		{
+			name:     "anchored service",
+			service:  helpers.GetPointer(`^acme\.billing\..*$`),
+			expected: `^/(?:acme\.billing\..*)/(?:[^/]+)$`,
+		},
+		{
+			name:     "escaped trailing dollar is preserved",
+			method:   helpers.GetPointer(`Get\$`),
+			expected: `^/(?:[^/]+)/(?:Get\$)$`,
+		},
+		{
+			name:     "escaped backslash before trailing dollar",
+			method:   helpers.GetPointer(`Get\\$`),
+			expected: `^/(?:[^/]+)/(?:Get\\)$`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			method := &v1.GRPCMethodMatch{
+				Type:    helpers.GetPointer(v1.GRPCMethodMatchRegularExpression),
+				Service: test.service,
+				Method:  test.method,
+			}
+
+			g.Expect(createGRPCMethodPathRegex(method)).To(Equal(test.expected))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -26,6 +27,7 @@ func buildHTTPRoute(
 	ghr *v1.HTTPRoute,
 	gws map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	directResponseFilters map[types.NamespacedName]*DirectResponseFilter,
 ) *L7Route {
 	r := &L7Route{
 		Source:    ghr,
@@ -60,7 +62,13 @@ func buildHTTPRoute(
 	rules, valid, conds := processHTTPRouteRules(
 		ghr.Spec.Rules,
 		validator,
-		getSnippetsFilterResolverForNamespace(snippetsFilters, r.Source.GetNamespace()),
+		getExtRefFilterResolverForNamespace(
+			snippetsFilters,
+			directResponseFilters,
+			nil,
+			nil,
+			r.Source.GetNamespace(),
+		),
 	)
 
 	r.Spec.Rules = rules
@@ -76,6 +84,7 @@ func buildHTTPMirrorRoutes(
 	route *v1.HTTPRoute,
 	gateways map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	directResponseFilters map[types.NamespacedName]*DirectResponseFilter,
 ) {
 	for idx, rule := range l7route.Spec.Rules {
 		if rule.Filters.Valid {
@@ -107,6 +116,7 @@ func buildHTTPMirrorRoutes(
 					tmpMirrorRoute,
 					gateways,
 					snippetsFilters,
+					directResponseFilters,
 				)
 
 				if mirrorRoute != nil {
@@ -183,6 +193,13 @@ func processHTTPRouteRule(
 
 	errors = errors.append(filterErrors)
 
+	if routeFilters.Valid {
+		if errs := validateDirectResponseRule(specRule, routeFilters.Filters, rulePath); len(errs) > 0 {
+			errors.invalid = append(errors.invalid, errs...)
+			routeFilters.Valid = false
+		}
+	}
+
 	backendRefs := make([]RouteBackendRef, 0, len(specRule.BackendRefs))
 
 	// rule.BackendRefs are validated separately because of their special requirements
@@ -225,6 +242,56 @@ func processHTTPRouteRule(
 	}, errors
 }
 
+func hasDirectResponseFilter(filters []Filter) bool {
+	return slices.ContainsFunc(filters, isDirectResponseFilter)
+}
+
+func isDirectResponseFilter(f Filter) bool {
+	return f.ResolvedExtensionRef != nil && f.ResolvedExtensionRef.DirectResponseFilter != nil
+}
+
+// validateDirectResponseRule validates a rule with a DirectResponseFilter. Since NGINX returns the response of
+// the DirectResponseFilter instead of proxying the request, the rule cannot have backendRefs, and it cannot
+// have another filter that returns a response: a second DirectResponseFilter or a RequestRedirect filter.
+func validateDirectResponseRule(specRule v1.HTTPRouteRule, filters []Filter, rulePath *field.Path) field.ErrorList {
+	if !hasDirectResponseFilter(filters) {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if len(specRule.BackendRefs) > 0 {
+		allErrs = append(allErrs, field.Invalid(
+			rulePath.Child("backendRefs"),
+			len(specRule.BackendRefs),
+			"backendRefs cannot be set when the rule has a DirectResponseFilter",
+		))
+	}
+
+	filtersPath := rulePath.Child("filters")
+	var directResponseFilters int
+
+	for i, f := range filters {
+		switch {
+		case isDirectResponseFilter(f):
+			directResponseFilters++
+			if directResponseFilters > 1 {
+				allErrs = append(allErrs, field.Forbidden(
+					filtersPath.Index(i),
+					"only one DirectResponseFilter can be set per rule",
+				))
+			}
+		case f.FilterType == FilterRequestRedirect:
+			allErrs = append(allErrs, field.Forbidden(
+				filtersPath.Index(i),
+				"RequestRedirect cannot be set when the rule has a DirectResponseFilter",
+			))
+		}
+	}
+
+	return allErrs
+}
+
 func processHTTPRouteRules(
 	specRules []v1.HTTPRouteRule,
 	validator validation.HTTPFieldsValidator,
@@ -256,7 +323,7 @@ func processHTTPRouteRules(
 		rules[i] = rr
 	}
 
-	conds = make([]conditions.Condition, 0, 2)
+	conds = make([]conditions.Condition, 0, 3)
 
 	valid = true
 
@@ -278,6 +345,11 @@ func processHTTPRouteRules(
 		conds = append(conds, conditions.NewRouteResolvedRefsInvalidFilter(msg))
 	}
 
+	// rounded mirror percentages do not invalidate routes
+	if msgs := getMirrorPercentRoundedMessages(rules); len(msgs) > 0 {
+		conds = append(conds, conditions.NewRouteMirrorPercentRounded(strings.Join(msgs, "; ")))
+	}
+
 	return rules, valid, conds
 }
 
@@ -390,20 +462,30 @@ func validatePathMatch(
 		return field.ErrorList{field.Invalid(fieldPath.Child("value"), *path.Value, msg)}
 	}
 
-	if *path.Type != v1.PathMatchPathPrefix && *path.Type != v1.PathMatchExact {
+	switch *path.Type {
+	case v1.PathMatchPathPrefix, v1.PathMatchExact:
+		if err := validator.ValidatePathInMatch(*path.Value); err != nil {
+			valErr := field.Invalid(fieldPath.Child("value"), *path.Value, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+	case v1.PathMatchRegularExpression:
+		if err := validator.ValidatePathRegexInMatch(*path.Value); err != nil {
+			valErr := field.Invalid(fieldPath.Child("value"), *path.Value, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+	default:
 		valErr := field.NotSupported(
 			fieldPath.Child("type"),
 			*path.Type,
-			[]string{string(v1.PathMatchExact), string(v1.PathMatchPathPrefix)},
+			[]string{
+				string(v1.PathMatchExact),
+				string(v1.PathMatchPathPrefix),
+				string(v1.PathMatchRegularExpression),
+			},
 		)
 		allErrs = append(allErrs, valErr)
 	}
 
-	if err := validator.ValidatePathInMatch(*path.Value); err != nil {
-		valErr := field.Invalid(fieldPath.Child("value"), *path.Value, err.Error())
-		allErrs = append(allErrs, valErr)
-	}
-
 	return allErrs
 }
 
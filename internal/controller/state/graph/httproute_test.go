@@ -263,6 +263,9 @@ func TestBuildHTTPRoutes(t *testing.T) {
 				map[types.NamespacedName]*gatewayv1.GRPCRoute{},
 				test.gateways,
 				snippetsFilters,
+				nil,
+				nil,
+				nil,
 			)
 			g.Expect(helpers.Diff(test.expected, routes)).To(BeEmpty())
 		})
@@ -377,6 +380,35 @@ func TestBuildHTTPRoute(t *testing.T) {
 	addFilterToPath(hrInvalidAndUnresolvableSnippetsFilter, "/filter", invalidSnippetsFilterExtRef)
 	addFilterToPath(hrInvalidAndUnresolvableSnippetsFilter, "/filter", unresolvableSnippetsFilterExtRef)
 
+	// route with direct response filter extension ref
+	directResponseFilterExtRef := gatewayv1.HTTPRouteFilter{
+		Type: gatewayv1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &gatewayv1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kinds.DirectResponseFilter,
+			Name:  "drf",
+		},
+	}
+	hrDirectResponseFilter := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/filter")
+	addFilterToPath(hrDirectResponseFilter, "/filter", directResponseFilterExtRef)
+	hrDirectResponseFilter.Spec.Rules[0].BackendRefs = nil
+
+	// route with direct response filter extension ref and backendRefs
+	hrDirectResponseFilterWithBackendRefs := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/filter")
+	addFilterToPath(hrDirectResponseFilterWithBackendRefs, "/filter", directResponseFilterExtRef)
+
+	// route with two direct response filter extension refs
+	hrTwoDirectResponseFilters := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/filter")
+	addFilterToPath(hrTwoDirectResponseFilters, "/filter", directResponseFilterExtRef)
+	addFilterToPath(hrTwoDirectResponseFilters, "/filter", directResponseFilterExtRef)
+	hrTwoDirectResponseFilters.Spec.Rules[0].BackendRefs = nil
+
+	// route with direct response filter extension ref and request redirect filter
+	hrDirectResponseFilterWithRedirect := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/filter")
+	addFilterToPath(hrDirectResponseFilterWithRedirect, "/filter", directResponseFilterExtRef)
+	addFilterToPath(hrDirectResponseFilterWithRedirect, "/filter", validFilter)
+	hrDirectResponseFilterWithRedirect.Spec.Rules[0].BackendRefs = nil
+
 	validatorInvalidFieldsInRule := &validationfakes.FakeHTTPFieldsValidator{
 		ValidatePathInMatchStub: func(path string) error {
 			if path == invalidPath {
@@ -943,6 +975,206 @@ func TestBuildHTTPRoute(t *testing.T) {
 			},
 			name: "rule with one invalid and one unresolvable snippets filter extension ref filter",
 		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrDirectResponseFilter,
+			expected: &L7Route{
+				RouteType:  RouteTypeHTTP,
+				Source:     hrDirectResponseFilter,
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     CreateParentRefGateway(gw),
+						SectionName: hrDirectResponseFilter.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Spec: L7RouteSpec{
+					Hostnames: hrDirectResponseFilter.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Matches:      hrDirectResponseFilter.Spec.Rules[0].Matches,
+							Filters: RouteRuleFilters{
+								Filters: []Filter{
+									{
+										RouteType:    RouteTypeHTTP,
+										FilterType:   FilterExtensionRef,
+										ExtensionRef: directResponseFilterExtRef.ExtensionRef,
+										ResolvedExtensionRef: &ExtensionRefFilter{
+											Valid:                true,
+											DirectResponseFilter: &DirectResponseFilter{Valid: true, Referenced: true},
+										},
+									},
+								},
+								Valid: true,
+							},
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "rule with direct response filter extension ref filter",
+		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrDirectResponseFilterWithBackendRefs,
+			expected: &L7Route{
+				RouteType:  RouteTypeHTTP,
+				Source:     hrDirectResponseFilterWithBackendRefs,
+				Valid:      false,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     CreateParentRefGateway(gw),
+						SectionName: hrDirectResponseFilterWithBackendRefs.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					conditions.NewRouteUnsupportedValue(
+						"All rules are invalid: spec.rules[0].backendRefs: Invalid value: 1: " +
+							"backendRefs cannot be set when the rule has a DirectResponseFilter",
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: hrDirectResponseFilterWithBackendRefs.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Matches:      hrDirectResponseFilterWithBackendRefs.Spec.Rules[0].Matches,
+							Filters: RouteRuleFilters{
+								Filters: []Filter{
+									{
+										RouteType:    RouteTypeHTTP,
+										FilterType:   FilterExtensionRef,
+										ExtensionRef: directResponseFilterExtRef.ExtensionRef,
+										ResolvedExtensionRef: &ExtensionRefFilter{
+											Valid:                true,
+											DirectResponseFilter: &DirectResponseFilter{Valid: true, Referenced: true},
+										},
+									},
+								},
+								Valid: false,
+							},
+							RouteBackendRefs: []RouteBackendRef{expRouteBackendRef},
+						},
+					},
+				},
+			},
+			name: "rule with direct response filter extension ref filter and backendRefs",
+		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrTwoDirectResponseFilters,
+			expected: &L7Route{
+				RouteType:  RouteTypeHTTP,
+				Source:     hrTwoDirectResponseFilters,
+				Valid:      false,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     CreateParentRefGateway(gw),
+						SectionName: hrTwoDirectResponseFilters.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					conditions.NewRouteUnsupportedValue(
+						"All rules are invalid: spec.rules[0].filters[1]: Forbidden: " +
+							"only one DirectResponseFilter can be set per rule",
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: hrTwoDirectResponseFilters.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Matches:      hrTwoDirectResponseFilters.Spec.Rules[0].Matches,
+							Filters: RouteRuleFilters{
+								Filters: []Filter{
+									{
+										RouteType:    RouteTypeHTTP,
+										FilterType:   FilterExtensionRef,
+										ExtensionRef: directResponseFilterExtRef.ExtensionRef,
+										ResolvedExtensionRef: &ExtensionRefFilter{
+											Valid:                true,
+											DirectResponseFilter: &DirectResponseFilter{Valid: true, Referenced: true},
+										},
+									},
+									{
+										RouteType:    RouteTypeHTTP,
+										FilterType:   FilterExtensionRef,
+										ExtensionRef: directResponseFilterExtRef.ExtensionRef,
+										ResolvedExtensionRef: &ExtensionRefFilter{
+											Valid:                true,
+											DirectResponseFilter: &DirectResponseFilter{Valid: true, Referenced: true},
+										},
+									},
+								},
+								Valid: false,
+							},
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "rule with two direct response filter extension ref filters",
+		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrDirectResponseFilterWithRedirect,
+			expected: &L7Route{
+				RouteType:  RouteTypeHTTP,
+				Source:     hrDirectResponseFilterWithRedirect,
+				Valid:      false,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     CreateParentRefGateway(gw),
+						SectionName: hrDirectResponseFilterWithRedirect.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					conditions.NewRouteUnsupportedValue(
+						"All rules are invalid: spec.rules[0].filters[1]: Forbidden: " +
+							"RequestRedirect cannot be set when the rule has a DirectResponseFilter",
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: hrDirectResponseFilterWithRedirect.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Matches:      hrDirectResponseFilterWithRedirect.Spec.Rules[0].Matches,
+							Filters: RouteRuleFilters{
+								Filters: []Filter{
+									{
+										RouteType:    RouteTypeHTTP,
+										FilterType:   FilterExtensionRef,
+										ExtensionRef: directResponseFilterExtRef.ExtensionRef,
+										ResolvedExtensionRef: &ExtensionRefFilter{
+											Valid:                true,
+											DirectResponseFilter: &DirectResponseFilter{Valid: true, Referenced: true},
+										},
+									},
+									{
+										RouteType:       RouteTypeHTTP,
+										FilterType:      FilterRequestRedirect,
+										RequestRedirect: validFilter.RequestRedirect,
+									},
+								},
+								Valid: false,
+							},
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "rule with direct response filter extension ref filter and request redirect filter",
+		},
 	}
 
 	gws := map[types.NamespacedName]*Gateway{
@@ -957,8 +1189,11 @@ func TestBuildHTTPRoute(t *testing.T) {
 			snippetsFilters := map[types.NamespacedName]*SnippetsFilter{
 				{Namespace: "test", Name: "sf"}: {Valid: true},
 			}
+			directResponseFilters := map[types.NamespacedName]*DirectResponseFilter{
+				{Namespace: "test", Name: "drf"}: {Valid: true},
+			}
 
-			route := buildHTTPRoute(test.validator, test.hr, gws, snippetsFilters)
+			route := buildHTTPRoute(test.validator, test.hr, gws, snippetsFilters, directResponseFilters)
 			g.Expect(helpers.Diff(test.expected, route)).To(BeEmpty())
 		})
 	}
@@ -1090,10 +1325,10 @@ func TestBuildHTTPRouteWithMirrorRoutes(t *testing.T) {
 	g := NewWithT(t)
 
 	routes := map[RouteKey]*L7Route{}
-	l7route := buildHTTPRoute(validator, hr, gateways, snippetsFilters)
+	l7route := buildHTTPRoute(validator, hr, gateways, snippetsFilters, nil)
 	g.Expect(l7route).NotTo(BeNil())
 
-	buildHTTPMirrorRoutes(routes, l7route, hr, gateways, snippetsFilters)
+	buildHTTPMirrorRoutes(routes, l7route, hr, gateways, snippetsFilters, nil)
 
 	obj, ok := expectedMirrorRoute.Source.(*gatewayv1.HTTPRoute)
 	g.Expect(ok).To(BeTrue())
@@ -1161,6 +1396,32 @@ func TestValidateMatch(t *testing.T) {
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
 					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer(`^/legacy/(\d+)\.php$`),
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid regular expression match",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidatePathRegexInMatchReturns(errors.New("invalid path regex"))
+				return validator
+			}(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/(foo"),
+				},
+			},
+			expectErrCount: 1,
+			name:           "bad path regex",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchType("invalid")),
 					Value: helpers.GetPointer("/"),
 				},
 			},
@@ -1337,7 +1598,7 @@ func TestValidateMatch(t *testing.T) {
 			validator: createAllValidValidator(),
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
-					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression), // invalid
+					Type:  helpers.GetPointer(gatewayv1.PathMatchType("invalid")), // invalid
 					Value: helpers.GetPointer("/"),
 				},
 				Headers: []gatewayv1.HTTPHeaderMatch{
@@ -3,8 +3,15 @@ package graph
 import (
 	"encoding/json"
 	"fmt"
+	"net/netip"
+	"regexp"
 	"slices"
+	"strconv"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/types"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -13,6 +20,7 @@ import (
 
 	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 )
@@ -91,6 +99,12 @@ func buildEffectiveNginxProxy(gatewayClassNp, gatewayNp *NginxProxy) *EffectiveN
 		}
 	}
 
+	// the split key name only applies to the split key type it is set with, so the local split key
+	// replaces the global one instead of being merged with it.
+	if local.SplitKey != nil {
+		global.SplitKey = local.SplitKey
+	}
+
 	return &global
 }
 
@@ -123,6 +137,18 @@ func MetricsEnabledForNginxProxy(np *EffectiveNginxProxy) (*int32, bool) {
 	return nil, true
 }
 
+// HTTP3EnabledForNginxProxy returns whether HTTP/3 is enabled for the servers of HTTPS listeners.
+// By default, HTTP/3 is disabled.
+func HTTP3EnabledForNginxProxy(np *EffectiveNginxProxy) bool {
+	return np != nil && np.HTTP3 != nil && np.HTTP3.Enable != nil && *np.HTTP3.Enable
+}
+
+// StaticDataPlaneEnabledForNginxProxy returns whether the Gateways that use the NginxProxy are served by NGINX
+// instances that are not provisioned by NGINX Gateway Fabric.
+func StaticDataPlaneEnabledForNginxProxy(np *EffectiveNginxProxy) bool {
+	return np != nil && np.StaticDataPlane != nil
+}
+
 func processNginxProxies(
 	nps map[types.NamespacedName]*ngfAPIv1alpha2.NginxProxy,
 	validator validation.GenericValidator,
@@ -274,8 +300,136 @@ func validateNginxProxy(
 
 	allErrs = append(allErrs, validateRewriteClientIP(npCfg)...)
 
+	if npCfg.Spec.SplitKey != nil {
+		allErrs = append(allErrs, policies.ValidateSplitKey(*npCfg.Spec.SplitKey, spec.Child("splitKey"))...)
+	}
+
 	allErrs = append(allErrs, validateNginxPlus(npCfg)...)
 
+	allErrs = append(allErrs, validateHTTP3(npCfg)...)
+
+	allErrs = append(allErrs, validateNginxTLS(validator, npCfg)...)
+
+	allErrs = append(allErrs, validateService(npCfg)...)
+
+	allErrs = append(allErrs, validatePodContainers(npCfg)...)
+
+	allErrs = append(allErrs, validatePodMonitor(npCfg)...)
+
+	return allErrs
+}
+
+// validatePodMonitor validates the labels and the relabeling rules of the PodMonitor.
+func validatePodMonitor(npCfg *ngfAPIv1alpha2.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if npCfg.Spec.Metrics == nil || npCfg.Spec.Metrics.PodMonitor == nil {
+		return allErrs
+	}
+
+	podMonitor := npCfg.Spec.Metrics.PodMonitor
+	podMonitorPath := field.NewPath("spec", "metrics", "podMonitor")
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(podMonitor.Labels, podMonitorPath.Child("labels"))...)
+
+	validateRelabelings := func(relabelings []ngfAPIv1alpha2.RelabelConfig, path *field.Path) {
+		for i, relabeling := range relabelings {
+			if relabeling.Regex == nil {
+				continue
+			}
+
+			if _, err := regexp.Compile(*relabeling.Regex); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(path.Index(i).Child("regex"), *relabeling.Regex, err.Error()),
+				)
+			}
+		}
+	}
+
+	validateRelabelings(podMonitor.Relabelings, podMonitorPath.Child("relabelings"))
+	validateRelabelings(podMonitor.MetricRelabelings, podMonitorPath.Child("metricRelabelings"))
+
+	return allErrs
+}
+
+// validatePodContainers validates that the additional containers of the NGINX pod don't use the names of
+// the containers that NGINX Gateway Fabric adds to the pod, or the names of each other.
+func validatePodContainers(npCfg *ngfAPIv1alpha2.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	k8s := npCfg.Spec.Kubernetes
+	if k8s == nil {
+		return allErrs
+	}
+
+	var podSpec ngfAPIv1alpha2.PodSpec
+	var podPath *field.Path
+	switch {
+	case k8s.Deployment != nil:
+		podSpec = k8s.Deployment.Pod
+		podPath = field.NewPath("spec", "kubernetes", "deployment", "pod")
+	case k8s.DaemonSet != nil:
+		podSpec = k8s.DaemonSet.Pod
+		podPath = field.NewPath("spec", "kubernetes", "daemonSet", "pod")
+	default:
+		return allErrs
+	}
+
+	// init and regular containers share the same namespace of names
+	names := map[string]struct{}{
+		"init":  {},
+		"nginx": {},
+	}
+
+	validateNames := func(containers []corev1.Container, path *field.Path) {
+		for i, container := range containers {
+			namePath := path.Index(i).Child("name")
+			if _, exists := names[container.Name]; exists {
+				allErrs = append(allErrs, field.Duplicate(namePath, container.Name))
+				continue
+			}
+			names[container.Name] = struct{}{}
+		}
+	}
+
+	validateNames(podSpec.InitContainers, podPath.Child("initContainers"))
+	validateNames(podSpec.Containers, podPath.Child("containers"))
+
+	return allErrs
+}
+
+func validateService(npCfg *ngfAPIv1alpha2.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if npCfg.Spec.Kubernetes == nil || npCfg.Spec.Kubernetes.Service == nil {
+		return allErrs
+	}
+
+	service := npCfg.Spec.Kubernetes.Service
+	servicePath := field.NewPath("spec", "kubernetes", "service")
+
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(service.Annotations, servicePath.Child("annotations"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(service.Labels, servicePath.Child("labels"))...)
+
+	for i, ip := range service.ExternalIPs {
+		if _, err := netip.ParseAddr(ip); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(servicePath.Child("externalIPs").Index(i), ip, "must be an IP address"),
+			)
+		}
+	}
+
+	if internal := service.InternalService; internal != nil {
+		internalPath := servicePath.Child("internalService")
+		allErrs = append(
+			allErrs,
+			apivalidation.ValidateAnnotations(internal.Annotations, internalPath.Child("annotations"))...,
+		)
+		allErrs = append(allErrs, metav1validation.ValidateLabels(internal.Labels, internalPath.Child("labels"))...)
+	}
+
 	return allErrs
 }
 
@@ -434,3 +588,176 @@ func validateNginxPlus(npCfg *ngfAPIv1alpha2.NginxProxy) field.ErrorList {
 
 	return allErrs
 }
+
+func validateNginxTLS(validator validation.GenericValidator, npCfg *ngfAPIv1alpha2.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	tls := npCfg.Spec.TLS
+	if tls == nil {
+		return allErrs
+	}
+
+	tlsPath := field.NewPath("spec", "tls")
+
+	for _, protocol := range tls.Protocols {
+		allErrs = append(allErrs, validateSSLProtocol(string(protocol), tlsPath.Child("protocols"))...)
+	}
+
+	if tls.Ciphers != nil {
+		if err := validateSSLCiphers(*tls.Ciphers); err != nil {
+			allErrs = append(allErrs, field.Invalid(tlsPath.Child("ciphers"), *tls.Ciphers, err.Error()))
+		}
+	}
+
+	if cache := tls.SessionCache; cache != nil {
+		cachePath := tlsPath.Child("sessionCache")
+
+		if cache.Size != nil {
+			if err := validator.ValidateNginxSize(string(*cache.Size)); err != nil {
+				allErrs = append(allErrs, field.Invalid(cachePath.Child("size"), *cache.Size, err.Error()))
+			}
+		}
+
+		if cache.Timeout != nil {
+			if err := validator.ValidateNginxDuration(string(*cache.Timeout)); err != nil {
+				allErrs = append(allErrs, field.Invalid(cachePath.Child("timeout"), *cache.Timeout, err.Error()))
+			}
+		}
+	}
+
+	if tickets := tls.SessionTickets; tickets != nil && tickets.KeysSecretName != nil {
+		keysPath := tlsPath.Child("sessionTickets", "keysSecretName")
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(*tickets.KeysSecretName) {
+			allErrs = append(allErrs, field.Invalid(keysPath, *tickets.KeysSecretName, msg))
+		}
+	}
+
+	if stapling := tls.OCSPStapling; stapling != nil {
+		resolverPath := tlsPath.Child("ocspStapling", "resolver")
+
+		switch {
+		case stapling.Resolver != nil:
+			allErrs = append(allErrs, validateDNSResolver(validator, stapling.Resolver, resolverPath)...)
+		case stapling.Enable != nil && *stapling.Enable:
+			// without a resolver, NGINX cannot resolve the OCSP responders, and no responses are stapled.
+			allErrs = append(allErrs, field.Required(resolverPath, "a resolver is required when OCSP stapling is enabled"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDNSResolver(
+	validator validation.GenericValidator,
+	resolver *ngfAPIv1alpha2.DNSResolver,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(resolver.Addresses) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("addresses"), "at least one address is required"))
+	}
+
+	for i, addr := range resolver.Addresses {
+		if _, err := netip.ParseAddr(addr); err == nil {
+			continue
+		}
+
+		if _, err := netip.ParseAddrPort(addr); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(path.Child("addresses").Index(i), addr, "must be an IP address with an optional port"),
+			)
+		}
+	}
+
+	if resolver.Valid != nil {
+		if err := validator.ValidateNginxDuration(string(*resolver.Valid)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("valid"), *resolver.Valid, err.Error()))
+		}
+	}
+
+	if resolver.Timeout != nil {
+		if err := validator.ValidateNginxDuration(string(*resolver.Timeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeout"), *resolver.Timeout, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// nginxVersionTagRegexp matches image tags that start with an NGINX version, such as 1.25.3 or 1.27-alpine.
+var nginxVersionTagRegexp = regexp.MustCompile(`^(\d+)\.(\d+)(\.\d+)?(-.+)?$`)
+
+// minHTTP3NginxMinorVersion is the minor version of the first NGINX 1.x release that supports HTTP/3.
+const minHTTP3NginxMinorVersion = 25
+
+func validateHTTP3(npCfg *ngfAPIv1alpha2.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	http3 := npCfg.Spec.HTTP3
+	if http3 == nil || http3.Enable == nil || !*http3.Enable {
+		return allErrs
+	}
+
+	k8s := npCfg.Spec.Kubernetes
+	if k8s == nil {
+		return allErrs
+	}
+
+	var container ngfAPIv1alpha2.ContainerSpec
+	var containerPath *field.Path
+	switch {
+	case k8s.Deployment != nil:
+		container = k8s.Deployment.Container
+		containerPath = field.NewPath("spec", "kubernetes", "deployment", "container")
+	case k8s.DaemonSet != nil:
+		container = k8s.DaemonSet.Container
+		containerPath = field.NewPath("spec", "kubernetes", "daemonSet", "container")
+	default:
+		return allErrs
+	}
+
+	// The NGINX images built for NGINX Gateway Fabric support HTTP/3, and their tags are versions of
+	// NGINX Gateway Fabric, not NGINX. So we only check images from other repositories,
+	// whose tags usually start with the NGINX version.
+	image := container.Image
+	if image == nil || image.Repository == nil || image.Tag == nil ||
+		strings.Contains(*image.Repository, "nginx-gateway-fabric") {
+		return allErrs
+	}
+
+	if !nginxVersionSupportsHTTP3(*image.Tag) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				containerPath.Child("image", "tag"),
+				*image.Tag,
+				"HTTP/3 requires NGINX 1.25.0 or later",
+			),
+		)
+	}
+
+	return allErrs
+}
+
+// nginxVersionSupportsHTTP3 returns false if the image tag is an NGINX version that doesn't support HTTP/3.
+// Tags that are not NGINX versions are assumed to support it.
+func nginxVersionSupportsHTTP3(tag string) bool {
+	matches := nginxVersionTagRegexp.FindStringSubmatch(tag)
+	if matches == nil {
+		return true
+	}
+
+	major, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return true
+	}
+
+	minor, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return true
+	}
+
+	return major > 1 || (major == 1 && minor >= minHTTP3NginxMinorVersion)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -24,6 +25,8 @@ func createValidValidator() *validationfakes.FakeGenericValidator {
 	v.ValidateEndpointReturns(nil)
 	v.ValidateServiceNameReturns(nil)
 	v.ValidateNginxDurationReturns(nil)
+	v.ValidateNginxSizeReturns(nil)
+	v.ValidateContentTypeReturns(nil)
 
 	return v
 }
@@ -34,6 +37,8 @@ func createInvalidValidator() *validationfakes.FakeGenericValidator {
 	v.ValidateEndpointReturns(errors.New("error"))
 	v.ValidateServiceNameReturns(errors.New("error"))
 	v.ValidateNginxDurationReturns(errors.New("error"))
+	v.ValidateNginxSizeReturns(errors.New("error"))
+	v.ValidateContentTypeReturns(errors.New("error"))
 
 	return v
 }
@@ -273,6 +278,32 @@ func TestBuildEffectiveNginxProxy(t *testing.T) {
 				return np
 			}),
 		},
+		{
+			name: "gateway nginx proxy replaces split key",
+			gcNp: &NginxProxy{
+				Valid: true,
+				Source: func() *ngfAPIv1alpha2.NginxProxy {
+					np := getNginxProxy()
+					np.Spec.SplitKey = &ngfAPIv1alpha1.SplitKey{
+						Type: ngfAPIv1alpha1.SplitKeyTypeCookie,
+						Name: helpers.GetPointer("session_id"),
+					}
+					return np
+				}(),
+			},
+			gwNp: &NginxProxy{
+				Valid: true,
+				Source: &ngfAPIv1alpha2.NginxProxy{
+					Spec: ngfAPIv1alpha2.NginxProxySpec{
+						SplitKey: &ngfAPIv1alpha1.SplitKey{Type: ngfAPIv1alpha1.SplitKeyTypeClientIP},
+					},
+				},
+			},
+			exp: getModifiedExpSpec(func(np *ngfAPIv1alpha2.NginxProxy) *ngfAPIv1alpha2.NginxProxy {
+				np.Spec.SplitKey = &ngfAPIv1alpha1.SplitKey{Type: ngfAPIv1alpha1.SplitKeyTypeClientIP}
+				return np
+			}),
+		},
 		{
 			name: "gateway nginx proxy unsets slices values",
 			gcNp: &NginxProxy{Valid: true, Source: getNginxProxy()},
@@ -460,6 +491,45 @@ func TestMetricsEnabledForNginxProxy(t *testing.T) {
 	}
 }
 
+func TestHTTP3EnabledForNginxProxy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		ep       *EffectiveNginxProxy
+		name     string
+		expected bool
+	}{
+		{
+			ep:       nil,
+			name:     "nil effective nginx proxy",
+			expected: false,
+		},
+		{
+			ep:       &EffectiveNginxProxy{HTTP3: &ngfAPIv1alpha2.HTTP3{}},
+			name:     "enable not set",
+			expected: false,
+		},
+		{
+			ep:       &EffectiveNginxProxy{HTTP3: &ngfAPIv1alpha2.HTTP3{Enable: helpers.GetPointer(false)}},
+			name:     "http3 disabled",
+			expected: false,
+		},
+		{
+			ep:       &EffectiveNginxProxy{HTTP3: &ngfAPIv1alpha2.HTTP3{Enable: helpers.GetPointer(true)}},
+			name:     "http3 enabled",
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(HTTP3EnabledForNginxProxy(test.ep)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestProcessNginxProxies(t *testing.T) {
 	t.Parallel()
 
@@ -890,6 +960,17 @@ func TestValidateNginxProxy(t *testing.T) {
 			expErrSubstring: "spec.ipFamily",
 			expectErrCount:  1,
 		},
+		{
+			name:      "invalid splitKey",
+			validator: createValidValidator(),
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					SplitKey: &ngfAPIv1alpha1.SplitKey{Type: ngfAPIv1alpha1.SplitKeyTypeHeader},
+				},
+			},
+			expErrSubstring: "spec.splitKey.name",
+			expectErrCount:  1,
+		},
 	}
 
 	for _, test := range tests {
@@ -1408,3 +1489,510 @@ func TestValidateNginxProxy_NilCase(t *testing.T) {
 	// Just testing the nil case for coverage reasons. The rest of the function is covered by other tests.
 	g.Expect(buildNginxProxy(nil, &validationfakes.FakeGenericValidator{})).To(BeNil())
 }
+
+func TestValidateHTTP3(t *testing.T) {
+	t.Parallel()
+
+	createNginxProxy := func(enable bool, repository, tag *string) *ngfAPIv1alpha2.NginxProxy {
+		return &ngfAPIv1alpha2.NginxProxy{
+			Spec: ngfAPIv1alpha2.NginxProxySpec{
+				HTTP3: &ngfAPIv1alpha2.HTTP3{
+					Enable: helpers.GetPointer(enable),
+				},
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+						Container: ngfAPIv1alpha2.ContainerSpec{
+							Image: &ngfAPIv1alpha2.Image{
+								Repository: repository,
+								Tag:        tag,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		np             *ngfAPIv1alpha2.NginxProxy
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					HTTP3: &ngfAPIv1alpha2.HTTP3{Enable: helpers.GetPointer(true)},
+				},
+			},
+			name:           "HTTP/3 enabled with default image",
+			expectErrCount: 0,
+		},
+		{
+			np:             createNginxProxy(true, helpers.GetPointer("nginx"), helpers.GetPointer("1.27.4-alpine")),
+			name:           "HTTP/3 enabled with supported NGINX version",
+			expectErrCount: 0,
+		},
+		{
+			np:             createNginxProxy(true, helpers.GetPointer("nginx"), helpers.GetPointer("1.25")),
+			name:           "HTTP/3 enabled with first supported NGINX version",
+			expectErrCount: 0,
+		},
+		{
+			np:             createNginxProxy(true, helpers.GetPointer("nginx"), helpers.GetPointer("latest")),
+			name:           "HTTP/3 enabled with tag that is not a version",
+			expectErrCount: 0,
+		},
+		{
+			np: createNginxProxy(
+				true,
+				helpers.GetPointer("ghcr.io/nginx/nginx-gateway-fabric/nginx"),
+				helpers.GetPointer("1.6.0"),
+			),
+			name:           "HTTP/3 enabled with NGINX Gateway Fabric image",
+			expectErrCount: 0,
+		},
+		{
+			np:             createNginxProxy(false, helpers.GetPointer("nginx"), helpers.GetPointer("1.24.0")),
+			name:           "HTTP/3 disabled with unsupported NGINX version",
+			expectErrCount: 0,
+		},
+		{
+			np:   createNginxProxy(true, helpers.GetPointer("nginx"), helpers.GetPointer("1.24.0-alpine")),
+			name: "HTTP/3 enabled with unsupported NGINX version",
+			errorString: "spec.kubernetes.deployment.container.image.tag: Invalid value: \"1.24.0-alpine\": " +
+				"HTTP/3 requires NGINX 1.25.0 or later",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					HTTP3: &ngfAPIv1alpha2.HTTP3{Enable: helpers.GetPointer(true)},
+					Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+						DaemonSet: &ngfAPIv1alpha2.DaemonSetSpec{
+							Container: ngfAPIv1alpha2.ContainerSpec{
+								Image: &ngfAPIv1alpha2.Image{
+									Repository: helpers.GetPointer("my-registry/nginx"),
+									Tag:        helpers.GetPointer("1.23.4"),
+								},
+							},
+						},
+					},
+				},
+			},
+			name: "HTTP/3 enabled with unsupported NGINX version in DaemonSet",
+			errorString: "spec.kubernetes.daemonSet.container.image.tag: Invalid value: \"1.23.4\": " +
+				"HTTP/3 requires NGINX 1.25.0 or later",
+			expectErrCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateHTTP3(test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateService(t *testing.T) {
+	t.Parallel()
+
+	createNginxProxy := func(service *ngfAPIv1alpha2.ServiceSpec) *ngfAPIv1alpha2.NginxProxy {
+		return &ngfAPIv1alpha2.NginxProxy{
+			Spec: ngfAPIv1alpha2.NginxProxySpec{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Service: service,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		np             *ngfAPIv1alpha2.NginxProxy
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np:             &ngfAPIv1alpha2.NginxProxy{},
+			name:           "service not set",
+			expectErrCount: 0,
+		},
+		{
+			np: createNginxProxy(&ngfAPIv1alpha2.ServiceSpec{
+				Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "nlb"},
+				Labels:      map[string]string{"team": "edge"},
+				ExternalIPs: []string{"10.0.0.1", "2001:db8::1"},
+				InternalService: &ngfAPIv1alpha2.InternalServiceSpec{
+					Enable:      true,
+					Annotations: map[string]string{"example.com/internal": "true"},
+					Labels:      map[string]string{"scope": "internal"},
+				},
+			}),
+			name:           "valid service",
+			expectErrCount: 0,
+		},
+		{
+			np: createNginxProxy(&ngfAPIv1alpha2.ServiceSpec{
+				ExternalIPs: []string{"10.0.0.1", "not-an-ip"},
+			}),
+			name:           "invalid external IP",
+			errorString:    "spec.kubernetes.service.externalIPs[1]: Invalid value: \"not-an-ip\": must be an IP address",
+			expectErrCount: 1,
+		},
+		{
+			np: createNginxProxy(&ngfAPIv1alpha2.ServiceSpec{
+				Annotations: map[string]string{"invalid key": "value"},
+				Labels:      map[string]string{"team": "invalid value"},
+				InternalService: &ngfAPIv1alpha2.InternalServiceSpec{
+					Annotations: map[string]string{"invalid key": "value"},
+					Labels:      map[string]string{"invalid key": "value"},
+				},
+			}),
+			name:           "invalid annotations and labels",
+			expectErrCount: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateService(test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if test.errorString != "" {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidatePodContainers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np             *ngfAPIv1alpha2.NginxProxy
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np:             &ngfAPIv1alpha2.NginxProxy{},
+			name:           "kubernetes not set",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+						Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+							Pod: ngfAPIv1alpha2.PodSpec{
+								InitContainers: []corev1.Container{{Name: "wait-for-network"}},
+								Containers:     []corev1.Container{{Name: "log-shipper"}},
+							},
+						},
+					},
+				},
+			},
+			name:           "valid containers",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+						Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+							Pod: ngfAPIv1alpha2.PodSpec{
+								InitContainers: []corev1.Container{{Name: "init"}},
+								Containers:     []corev1.Container{{Name: "nginx"}},
+							},
+						},
+					},
+				},
+			},
+			name: "reserved container names",
+			errorString: "[spec.kubernetes.deployment.pod.initContainers[0].name: Duplicate value: \"init\", " +
+				"spec.kubernetes.deployment.pod.containers[0].name: Duplicate value: \"nginx\"]",
+			expectErrCount: 2,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+						DaemonSet: &ngfAPIv1alpha2.DaemonSetSpec{
+							Pod: ngfAPIv1alpha2.PodSpec{
+								InitContainers: []corev1.Container{{Name: "setup"}},
+								Containers:     []corev1.Container{{Name: "setup"}},
+							},
+						},
+					},
+				},
+			},
+			name:           "duplicate container names in DaemonSet",
+			errorString:    "spec.kubernetes.daemonSet.pod.containers[0].name: Duplicate value: \"setup\"",
+			expectErrCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validatePodContainers(test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if test.errorString != "" {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidatePodMonitor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np             *ngfAPIv1alpha2.NginxProxy
+		name           string
+		expectErrCount int
+	}{
+		{
+			np:             &ngfAPIv1alpha2.NginxProxy{},
+			name:           "pod monitor not set",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Metrics: &ngfAPIv1alpha2.Metrics{
+						PodMonitor: &ngfAPIv1alpha2.PodMonitor{
+							Labels: map[string]string{"release": "prometheus"},
+							Relabelings: []ngfAPIv1alpha2.RelabelConfig{
+								{Regex: helpers.GetPointer("nginx_(.*)")},
+							},
+						},
+					},
+				},
+			},
+			name:           "valid pod monitor",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Metrics: &ngfAPIv1alpha2.Metrics{
+						PodMonitor: &ngfAPIv1alpha2.PodMonitor{
+							Labels: map[string]string{"release": "invalid value!"},
+							Relabelings: []ngfAPIv1alpha2.RelabelConfig{
+								{Regex: helpers.GetPointer("nginx_(.*)")},
+							},
+							MetricRelabelings: []ngfAPIv1alpha2.RelabelConfig{
+								{Regex: helpers.GetPointer("nginx_(.*")},
+							},
+						},
+					},
+				},
+			},
+			name:           "invalid labels and regex",
+			expectErrCount: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validatePodMonitor(test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestValidateNginxTLS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		validator      *validationfakes.FakeGenericValidator
+		np             *ngfAPIv1alpha2.NginxProxy
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						Protocols: []ngfAPIv1alpha2.TLSProtocolType{
+							ngfAPIv1alpha2.TLSProtocolV12,
+							ngfAPIv1alpha2.TLSProtocolV13,
+						},
+						Ciphers:             helpers.GetPointer("HIGH:!aNULL:!MD5"),
+						PreferServerCiphers: helpers.GetPointer(true),
+					},
+				},
+			},
+			name:           "valid TLS",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						Protocols: []ngfAPIv1alpha2.TLSProtocolType{"SSLv3"},
+					},
+				},
+			},
+			name: "invalid protocol",
+			errorString: "spec.tls.protocols: Unsupported value: \"SSLv3\": supported values: " +
+				"\"TLSv1\", \"TLSv1.1\", \"TLSv1.2\", \"TLSv1.3\"",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						Ciphers: helpers.GetPointer("HIGH;"),
+					},
+				},
+			},
+			name: "invalid ciphers",
+			errorString: "spec.tls.ciphers: Invalid value: \"HIGH;\": must be a list of ciphers in the format of " +
+				"the OpenSSL library (e.g. 'HIGH:!aNULL:!MD5',  or " +
+				"'ECDHE-ECDSA-AES128-GCM-SHA256:ECDHE-RSA-AES128-GCM-SHA256', " +
+				"regex used for validation is '[A-Za-z0-9_+!@=:.,\\-]+')",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						SessionCache: &ngfAPIv1alpha2.TLSSessionCache{
+							Size:    helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+							Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+						},
+						SessionTickets: &ngfAPIv1alpha2.TLSSessionTickets{
+							KeysSecretName: helpers.GetPointer("ticket-keys"),
+						},
+						OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+							Enable: helpers.GetPointer(true),
+							Resolver: &ngfAPIv1alpha2.DNSResolver{
+								Addresses: []string{"10.0.0.10", "10.0.0.11:53", "fd00::10", "[fd00::11]:53"},
+								Valid:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("30s"),
+								Timeout:   helpers.GetPointer[ngfAPIv1alpha1.Duration]("5s"),
+							},
+						},
+					},
+				},
+			},
+			name:           "valid session resumption and OCSP stapling",
+			expectErrCount: 0,
+		},
+		{
+			validator: createInvalidValidator(),
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						SessionCache: &ngfAPIv1alpha2.TLSSessionCache{
+							Size:    helpers.GetPointer[ngfAPIv1alpha1.Size]("big"),
+							Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("long"),
+						},
+						OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+							Resolver: &ngfAPIv1alpha2.DNSResolver{
+								Addresses: []string{"10.0.0.10"},
+								Valid:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("long"),
+								Timeout:   helpers.GetPointer[ngfAPIv1alpha1.Duration]("long"),
+							},
+						},
+					},
+				},
+			},
+			name: "invalid sizes and durations",
+			errorString: "[spec.tls.sessionCache.size: Invalid value: \"big\": error, " +
+				"spec.tls.sessionCache.timeout: Invalid value: \"long\": error, " +
+				"spec.tls.ocspStapling.resolver.valid: Invalid value: \"long\": error, " +
+				"spec.tls.ocspStapling.resolver.timeout: Invalid value: \"long\": error]",
+			expectErrCount: 4,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						SessionTickets: &ngfAPIv1alpha2.TLSSessionTickets{
+							KeysSecretName: helpers.GetPointer("Ticket_Keys"),
+						},
+						OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+							Resolver: &ngfAPIv1alpha2.DNSResolver{
+								Addresses: []string{"dns.example.com", "10.0.0.10:dns"},
+							},
+						},
+					},
+				},
+			},
+			name: "invalid secret name and resolver addresses",
+			errorString: "[spec.tls.sessionTickets.keysSecretName: Invalid value: \"Ticket_Keys\": " +
+				"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', " +
+				"and must start and end with an alphanumeric character (e.g. 'example.com', regex used for " +
+				"validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'), " +
+				"spec.tls.ocspStapling.resolver.addresses[0]: Invalid value: \"dns.example.com\": " +
+				"must be an IP address with an optional port, " +
+				"spec.tls.ocspStapling.resolver.addresses[1]: Invalid value: \"10.0.0.10:dns\": " +
+				"must be an IP address with an optional port]",
+			expectErrCount: 3,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+							Enable: helpers.GetPointer(true),
+						},
+					},
+				},
+			},
+			name: "OCSP stapling without resolver",
+			errorString: "spec.tls.ocspStapling.resolver: Required value: " +
+				"a resolver is required when OCSP stapling is enabled",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					TLS: &ngfAPIv1alpha2.NginxTLS{
+						OCSPStapling: &ngfAPIv1alpha2.OCSPStapling{
+							Enable: helpers.GetPointer(false),
+						},
+					},
+				},
+			},
+			name:           "disabled OCSP stapling without resolver",
+			expectErrCount: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			validator := test.validator
+			if validator == nil {
+				validator = createValidValidator()
+			}
+
+			allErrs := validateNginxTLS(validator, test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
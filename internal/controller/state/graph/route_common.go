@@ -15,6 +15,7 @@ import (
 
 	ngfSort "github.com/nginx/nginx-gateway-fabric/internal/controller/sort"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/mirror"
 	"github.com/nginx/nginx-gateway-fabric/internal/controller/state/validation"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
 )
@@ -205,6 +206,47 @@ func (e routeRuleErrors) append(newErrors routeRuleErrors) routeRuleErrors {
 	}
 }
 
+// getMirrorPercentRoundedMessages returns a message for every RequestMirror filter in the valid rules whose
+// fraction of requests to mirror had to be rounded to be supported by NGINX.
+func getMirrorPercentRoundedMessages(rules []RouteRule) []string {
+	var msgs []string
+
+	for i, rule := range rules {
+		if !rule.Filters.Valid {
+			continue
+		}
+
+		for j, filter := range rule.Filters.Filters {
+			if filter.RequestMirror == nil || filter.RequestMirror.Fraction == nil {
+				continue
+			}
+
+			percent, rounded := mirror.Percent(filter.RequestMirror)
+			if !rounded {
+				continue
+			}
+
+			fraction := filter.RequestMirror.Fraction
+			denominator := int32(100)
+			if fraction.Denominator != nil {
+				denominator = *fraction.Denominator
+			}
+
+			path := field.NewPath("spec").Child("rules").Index(i).Child("filters").Index(j).
+				Child("requestMirror", "fraction")
+			msgs = append(msgs, fmt.Sprintf(
+				"%s: %d/%d is rounded to %.2f%%",
+				path,
+				fraction.Numerator,
+				denominator,
+				percent,
+			))
+		}
+	}
+
+	return msgs
+}
+
 func buildL4RoutesForGateways(
 	tlsRoutes map[types.NamespacedName]*v1alpha.TLSRoute,
 	services map[types.NamespacedName]*apiv1.Service,
@@ -238,6 +280,9 @@ func buildRoutesForGateways(
 	grpcRoutes map[types.NamespacedName]*v1.GRPCRoute,
 	gateways map[types.NamespacedName]*Gateway,
 	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	directResponseFilters map[types.NamespacedName]*DirectResponseFilter,
+	grpcRewriteFilters map[types.NamespacedName]*GRPCRewriteFilter,
+	grpcWebFilters map[types.NamespacedName]*GRPCWebFilter,
 ) map[RouteKey]*L7Route {
 	if len(gateways) == 0 {
 		return nil
@@ -246,7 +291,7 @@ func buildRoutesForGateways(
 	routes := make(map[RouteKey]*L7Route)
 
 	for _, route := range httpRoutes {
-		r := buildHTTPRoute(validator, route, gateways, snippetsFilters)
+		r := buildHTTPRoute(validator, route, gateways, snippetsFilters, directResponseFilters)
 		if r == nil {
 			continue
 		}
@@ -254,11 +299,18 @@ func buildRoutesForGateways(
 		routes[CreateRouteKey(route)] = r
 
 		// if this route has a RequestMirror filter, build a duplicate route for the mirror
-		buildHTTPMirrorRoutes(routes, r, route, gateways, snippetsFilters)
+		buildHTTPMirrorRoutes(routes, r, route, gateways, snippetsFilters, directResponseFilters)
 	}
 
 	for _, route := range grpcRoutes {
-		r := buildGRPCRoute(validator, route, gateways, snippetsFilters)
+		r := buildGRPCRoute(
+			validator,
+			route,
+			gateways,
+			snippetsFilters,
+			grpcRewriteFilters,
+			grpcWebFilters,
+		)
 		if r == nil {
 			continue
 		}
@@ -266,7 +318,15 @@ func buildRoutesForGateways(
 		routes[CreateRouteKey(route)] = r
 
 		// if this route has a RequestMirror filter, build a duplicate route for the mirror
-		buildGRPCMirrorRoutes(routes, r, route, gateways, snippetsFilters)
+		buildGRPCMirrorRoutes(
+			routes,
+			r,
+			route,
+			gateways,
+			snippetsFilters,
+			grpcRewriteFilters,
+			grpcWebFilters,
+		)
 	}
 
 	return routes
@@ -3720,3 +3720,61 @@ func TestBindRoutesToListeners(t *testing.T) {
 		bindRoutesToListeners(nil, nil, nil, nil)
 	}).ToNot(Panic())
 }
+
+func TestGetMirrorPercentRoundedMessages(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	createMirrorFilter := func(mirror *gatewayv1.HTTPRequestMirrorFilter) Filter {
+		return Filter{
+			RouteType:     RouteTypeHTTP,
+			FilterType:    FilterRequestMirror,
+			RequestMirror: mirror,
+		}
+	}
+
+	roundedMirror := &gatewayv1.HTTPRequestMirrorFilter{
+		Fraction: &gatewayv1.Fraction{Numerator: 1, Denominator: helpers.GetPointer[int32](3)},
+	}
+
+	rules := []RouteRule{
+		{
+			Filters: RouteRuleFilters{
+				Valid: true,
+				Filters: []Filter{
+					{RouteType: RouteTypeHTTP, FilterType: FilterRequestHeaderModifier},
+					createMirrorFilter(roundedMirror),
+					createMirrorFilter(&gatewayv1.HTTPRequestMirrorFilter{
+						Fraction: &gatewayv1.Fraction{Numerator: 1, Denominator: helpers.GetPointer[int32](8)},
+					}),
+					createMirrorFilter(&gatewayv1.HTTPRequestMirrorFilter{Percent: helpers.GetPointer[int32](10)}),
+					createMirrorFilter(&gatewayv1.HTTPRequestMirrorFilter{}),
+				},
+			},
+		},
+		{
+			// invalid rules are skipped
+			Filters: RouteRuleFilters{
+				Valid:   false,
+				Filters: []Filter{createMirrorFilter(roundedMirror)},
+			},
+		},
+		{
+			Filters: RouteRuleFilters{
+				Valid: true,
+				Filters: []Filter{
+					createMirrorFilter(&gatewayv1.HTTPRequestMirrorFilter{
+						Fraction: &gatewayv1.Fraction{Numerator: 1, Denominator: helpers.GetPointer[int32](1000000)},
+					}),
+				},
+			},
+		},
+	}
+
+	g.Expect(getMirrorPercentRoundedMessages(rules)).To(Equal([]string{
+		"spec.rules[0].filters[1].requestMirror.fraction: 1/3 is rounded to 33.33%",
+		"spec.rules[2].filters[0].requestMirror.fraction: 1/1000000 is rounded to 0.01%",
+	}))
+
+	g.Expect(getMirrorPercentRoundedMessages(rules[:0])).To(BeEmpty())
+}
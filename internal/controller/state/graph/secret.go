@@ -3,11 +3,16 @@ package graph
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// AgentTokenKey is the key of the token in the Secret of a static data plane.
+const AgentTokenKey = "token"
+
 // Secret represents a Secret resource.
 type Secret struct {
 	// Source holds the actual Secret resource. Can be nil if the Secret does not exist.
@@ -21,6 +26,12 @@ type secretEntry struct {
 	Secret
 	// err holds the corresponding error if the Secret is invalid or does not exist.
 	err error
+	// caErr holds the corresponding error if the Secret cannot be used as a CA certificate bundle.
+	caErr error
+	// ticketKeysErr holds the corresponding error if the Secret cannot be used as a set of TLS session ticket keys.
+	ticketKeysErr error
+	// agentTokenErr holds the corresponding error if the Secret cannot be used as the token of a static data plane.
+	agentTokenErr error
 }
 
 // secretResolver wraps the cluster Secrets so that they can be resolved (includes validation). All resolved
@@ -38,22 +49,51 @@ func newSecretResolver(secrets map[types.NamespacedName]*apiv1.Secret) *secretRe
 }
 
 func (r *secretResolver) resolve(nsname types.NamespacedName) error {
+	return r.resolveEntry(nsname).err
+}
+
+// resolveCACert resolves a Secret that is referenced as a CA certificate bundle, for example by a BackendTLSPolicy.
+// Unlike resolve, it doesn't require the Secret to be of type kubernetes.io/tls, only to hold a valid ca.crt entry.
+func (r *secretResolver) resolveCACert(nsname types.NamespacedName) error {
+	return r.resolveEntry(nsname).caErr
+}
+
+// resolveSessionTicketKeys resolves a Secret that is referenced as a set of TLS session ticket keys by an NginxProxy.
+func (r *secretResolver) resolveSessionTicketKeys(nsname types.NamespacedName) error {
+	return r.resolveEntry(nsname).ticketKeysErr
+}
+
+// resolveAgentToken resolves a Secret that is referenced as the token of a static data plane by an NginxProxy.
+func (r *secretResolver) resolveAgentToken(nsname types.NamespacedName) error {
+	return r.resolveEntry(nsname).agentTokenErr
+}
+
+func (r *secretResolver) resolveEntry(nsname types.NamespacedName) *secretEntry {
 	if s, resolved := r.resolvedSecrets[nsname]; resolved {
-		return s.err
+		return s
 	}
 
 	secret, exist := r.clusterSecrets[nsname]
 
-	var validationErr error
+	var validationErr, caValidationErr, ticketKeysValidationErr, agentTokenValidationErr error
 	var certBundle *CertificateBundle
 
 	switch {
 	case !exist:
 		validationErr = errors.New("secret does not exist")
+		caValidationErr = validationErr
+		ticketKeysValidationErr = validationErr
+		agentTokenValidationErr = validationErr
 
 	case secret.Type != apiv1.SecretTypeTLS:
 		validationErr = fmt.Errorf("secret type must be %q not %q", apiv1.SecretTypeTLS, secret.Type)
 
+		// Secrets of any type can hold a CA certificate, for example an Opaque Secret issued by cert-manager.
+		caValidationErr = validateSecretCA(secret)
+		if _, exists := secret.Data[CAKey]; exists {
+			certBundle = NewCertificateBundle(nsname, "Secret", &Certificate{CACert: secret.Data[CAKey]})
+		}
+
 	default:
 		// A TLS Secret is guaranteed to have these data fields.
 		cert := &Certificate{
@@ -70,19 +110,64 @@ func (r *secretResolver) resolve(nsname types.NamespacedName) error {
 			cert.CACert = secret.Data[CAKey]
 			validationErr = validateCA(cert.CACert)
 		}
+		caValidationErr = validateSecretCA(secret)
 
 		certBundle = NewCertificateBundle(nsname, "Secret", cert)
 	}
 
-	r.resolvedSecrets[nsname] = &secretEntry{
+	if exist {
+		ticketKeysValidationErr = validateSessionTicketKeys(secret)
+		agentTokenValidationErr = validateAgentToken(secret)
+	}
+
+	entry := &secretEntry{
 		Secret: Secret{
 			Source:     secret,
 			CertBundle: certBundle,
 		},
-		err: validationErr,
+		err:           validationErr,
+		caErr:         caValidationErr,
+		ticketKeysErr: ticketKeysValidationErr,
+		agentTokenErr: agentTokenValidationErr,
+	}
+	r.resolvedSecrets[nsname] = entry
+
+	return entry
+}
+
+// validateSecretCA validates that the Secret holds a valid ca.crt entry.
+func validateSecretCA(secret *apiv1.Secret) error {
+	caCert, exists := secret.Data[CAKey]
+	if !exists {
+		return fmt.Errorf("secret does not have the data field %v", CAKey)
+	}
+
+	return validateCA(caCert)
+}
+
+// validateSessionTicketKeys validates that every data field of the Secret holds a TLS session ticket key
+// of the size supported by NGINX.
+func validateSessionTicketKeys(secret *apiv1.Secret) error {
+	if len(secret.Data) == 0 {
+		return errors.New("secret does not have any session ticket keys")
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(secret.Data)) {
+		if size := len(secret.Data[name]); size != 48 && size != 80 {
+			return fmt.Errorf("session ticket key %q must be 48 or 80 bytes, not %d", name, size)
+		}
+	}
+
+	return nil
+}
+
+// validateAgentToken validates that the Secret holds a non-empty agent token.
+func validateAgentToken(secret *apiv1.Secret) error {
+	if len(secret.Data[AgentTokenKey]) == 0 {
+		return fmt.Errorf("secret does not have the data field %v", AgentTokenKey)
 	}
 
-	return validationErr
+	return nil
 }
 
 func (r *secretResolver) getResolvedSecrets() map[types.NamespacedName]*Secret {
@@ -284,3 +284,199 @@ func TestSecretResolver(t *testing.T) {
 	resolved := resolver.getResolvedSecrets()
 	g.Expect(resolved).To(Equal(expectedResolved), "getResolvedSecrets()")
 }
+
+func TestSecretResolverCACert(t *testing.T) {
+	t.Parallel()
+	var (
+		opaqueCASecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "opaque-ca",
+			},
+			Data: map[string][]byte{
+				CAKey: []byte(caBlock),
+			},
+			Type: apiv1.SecretTypeOpaque,
+		}
+
+		tlsCASecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "tls-ca",
+			},
+			Data: map[string][]byte{
+				apiv1.TLSCertKey:       cert,
+				apiv1.TLSPrivateKeyKey: key,
+				CAKey:                  []byte(caBlock),
+			},
+			Type: apiv1.SecretTypeTLS,
+		}
+
+		tlsNoCASecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "tls-no-ca",
+			},
+			Data: map[string][]byte{
+				apiv1.TLSCertKey:       cert,
+				apiv1.TLSPrivateKeyKey: key,
+			},
+			Type: apiv1.SecretTypeTLS,
+		}
+
+		invalidCASecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "invalid-ca",
+			},
+			Data: map[string][]byte{
+				CAKey: invalidCert,
+			},
+			Type: apiv1.SecretTypeOpaque,
+		}
+	)
+
+	resolver := newSecretResolver(
+		map[types.NamespacedName]*apiv1.Secret{
+			client.ObjectKeyFromObject(opaqueCASecret):  opaqueCASecret,
+			client.ObjectKeyFromObject(tlsCASecret):     tlsCASecret,
+			client.ObjectKeyFromObject(tlsNoCASecret):   tlsNoCASecret,
+			client.ObjectKeyFromObject(invalidCASecret): invalidCASecret,
+		})
+
+	tests := []struct {
+		name           string
+		nsname         types.NamespacedName
+		expectedErrMsg string
+	}{
+		{
+			name:   "valid opaque secret with ca certificate",
+			nsname: client.ObjectKeyFromObject(opaqueCASecret),
+		},
+		{
+			name:   "valid tls secret with ca certificate",
+			nsname: client.ObjectKeyFromObject(tlsCASecret),
+		},
+		{
+			name:           "tls secret without ca certificate",
+			nsname:         client.ObjectKeyFromObject(tlsNoCASecret),
+			expectedErrMsg: `secret does not have the data field ca.crt`,
+		},
+		{
+			name:           "invalid ca certificate",
+			nsname:         client.ObjectKeyFromObject(invalidCASecret),
+			expectedErrMsg: "failed to validate certificate: x509: malformed certificate",
+		},
+		{
+			name:           "doesn't exist",
+			nsname:         types.NamespacedName{Namespace: "test", Name: "not-exist"},
+			expectedErrMsg: "secret does not exist",
+		},
+	}
+
+	g := NewWithT(t)
+
+	for _, test := range tests {
+		err := resolver.resolveCACert(test.nsname)
+		if test.expectedErrMsg == "" {
+			g.Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("case %q", test.name))
+		} else {
+			g.Expect(err).To(MatchError(test.expectedErrMsg), fmt.Sprintf("case %q", test.name))
+		}
+	}
+
+	// an opaque Secret can't be used as a TLS key pair, but its CA certificate is still bundled.
+	g.Expect(resolver.resolve(client.ObjectKeyFromObject(opaqueCASecret))).To(
+		MatchError(`secret type must be "kubernetes.io/tls" not "Opaque"`),
+	)
+
+	resolved := resolver.getResolvedSecrets()
+	g.Expect(resolved[client.ObjectKeyFromObject(opaqueCASecret)].CertBundle).To(Equal(
+		NewCertificateBundle(client.ObjectKeyFromObject(opaqueCASecret), "Secret", &Certificate{
+			CACert: []byte(caBlock),
+		}),
+	))
+}
+
+func TestSecretResolverSessionTicketKeys(t *testing.T) {
+	t.Parallel()
+	var (
+		validKeysSecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "valid-keys",
+			},
+			Data: map[string][]byte{
+				"2025-01": make([]byte, 80),
+				"2025-02": make([]byte, 48),
+			},
+			Type: apiv1.SecretTypeOpaque,
+		}
+
+		emptyKeysSecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "empty-keys",
+			},
+			Type: apiv1.SecretTypeOpaque,
+		}
+
+		invalidKeySizeSecret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "invalid-key-size",
+			},
+			Data: map[string][]byte{
+				"a": make([]byte, 80),
+				"b": make([]byte, 32),
+			},
+			Type: apiv1.SecretTypeOpaque,
+		}
+	)
+
+	resolver := newSecretResolver(
+		map[types.NamespacedName]*apiv1.Secret{
+			client.ObjectKeyFromObject(validKeysSecret):      validKeysSecret,
+			client.ObjectKeyFromObject(emptyKeysSecret):      emptyKeysSecret,
+			client.ObjectKeyFromObject(invalidKeySizeSecret): invalidKeySizeSecret,
+		})
+
+	tests := []struct {
+		name           string
+		nsname         types.NamespacedName
+		expectedErrMsg string
+	}{
+		{
+			name:   "valid keys",
+			nsname: client.ObjectKeyFromObject(validKeysSecret),
+		},
+		{
+			name:           "no keys",
+			nsname:         client.ObjectKeyFromObject(emptyKeysSecret),
+			expectedErrMsg: "secret does not have any session ticket keys",
+		},
+		{
+			name:           "invalid key size",
+			nsname:         client.ObjectKeyFromObject(invalidKeySizeSecret),
+			expectedErrMsg: `session ticket key "b" must be 48 or 80 bytes, not 32`,
+		},
+		{
+			name:           "doesn't exist",
+			nsname:         types.NamespacedName{Namespace: "test", Name: "not-exist"},
+			expectedErrMsg: "secret does not exist",
+		},
+	}
+
+	g := NewWithT(t)
+
+	for _, test := range tests {
+		err := resolver.resolveSessionTicketKeys(test.nsname)
+		if test.expectedErrMsg == "" {
+			g.Expect(err).ToNot(HaveOccurred(), fmt.Sprintf("case %q", test.name))
+		} else {
+			g.Expect(err).To(MatchError(test.expectedErrMsg), fmt.Sprintf("case %q", test.name))
+		}
+	}
+
+	g.Expect(resolver.getResolvedSecrets()).To(HaveKey(client.ObjectKeyFromObject(validKeysSecret)))
+}
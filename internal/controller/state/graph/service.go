@@ -1,6 +1,10 @@
 package graph
 
 import (
+	"slices"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -101,3 +105,64 @@ func addServicesAndGatewayForL7Routes(
 		}
 	}
 }
+
+// BackendService is a Service that a Gateway routes traffic to, with the information needed to allow the traffic
+// from the NGINX Pods to the Pods of the Service.
+type BackendService struct {
+	// Selector selects the Pods of the Service.
+	Selector map[string]string
+	// NsName is the NamespacedName of the Service.
+	NsName types.NamespacedName
+	// Ports are the ports of the Service.
+	Ports []v1.ServicePort
+}
+
+// addBackendServicesToGateways adds the referenced Services to the Gateways they belong to.
+// Services that don't exist or don't have a selector are skipped, because their Pods cannot be selected.
+func addBackendServicesToGateways(
+	gws map[types.NamespacedName]*Gateway,
+	referencedServices map[types.NamespacedName]*ReferencedService,
+	services map[types.NamespacedName]*v1.Service,
+) {
+	for svcNsName, refSvc := range referencedServices {
+		svc, exists := services[svcNsName]
+		if !exists || len(svc.Spec.Selector) == 0 {
+			continue
+		}
+
+		for gwNsName := range refSvc.GatewayNsNames {
+			gw, ok := gws[gwNsName]
+			if !ok || gw == nil {
+				continue
+			}
+
+			backendSvc := BackendService{
+				NsName:   svcNsName,
+				Selector: svc.Spec.Selector,
+				Ports:    svc.Spec.Ports,
+			}
+
+			gw.BackendServices = append(gw.BackendServices, backendSvc)
+
+			// the data plane of a merged Gateway is owned by another Gateway, which needs to reach the Service too.
+			if gw.MergedInto != nil {
+				if owner, exists := gws[*gw.MergedInto]; exists && owner != nil {
+					owner.BackendServices = append(owner.BackendServices, backendSvc)
+				}
+			}
+		}
+	}
+
+	for _, gw := range gws {
+		if gw == nil {
+			continue
+		}
+
+		slices.SortFunc(gw.BackendServices, func(a, b BackendService) int {
+			return strings.Compare(a.NsName.String(), b.NsName.String())
+		})
+		gw.BackendServices = slices.CompactFunc(gw.BackendServices, func(a, b BackendService) bool {
+			return a.NsName == b.NsName
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -341,3 +342,52 @@ func TestBuildReferencedServices(t *testing.T) {
 		})
 	}
 }
+
+func TestAddBackendServicesToGateways(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gwNsname"}
+	otherGwNsName := types.NamespacedName{Namespace: "test", Name: "other-gw"}
+	mergedGwNsName := types.NamespacedName{Namespace: "test", Name: "merged-gw"}
+	gws := map[types.NamespacedName]*Gateway{
+		gwNsName:       {},
+		otherGwNsName:  {},
+		mergedGwNsName: {MergedInto: &otherGwNsName},
+	}
+
+	tea := types.NamespacedName{Namespace: "test", Name: "tea"}
+	coffee := types.NamespacedName{Namespace: "test", Name: "coffee"}
+	external := types.NamespacedName{Namespace: "test", Name: "external"}
+	missing := types.NamespacedName{Namespace: "test", Name: "missing"}
+
+	referencedServices := map[types.NamespacedName]*ReferencedService{
+		tea:      {GatewayNsNames: map[types.NamespacedName]struct{}{gwNsName: {}, mergedGwNsName: {}}},
+		coffee:   {GatewayNsNames: map[types.NamespacedName]struct{}{gwNsName: {}, otherGwNsName: {}, mergedGwNsName: {}}},
+		external: {GatewayNsNames: map[types.NamespacedName]struct{}{gwNsName: {}}},
+		missing:  {GatewayNsNames: map[types.NamespacedName]struct{}{gwNsName: {}}},
+	}
+
+	ports := []apiv1.ServicePort{{Port: 80}}
+	services := map[types.NamespacedName]*apiv1.Service{
+		tea: {
+			Spec: apiv1.ServiceSpec{Selector: map[string]string{"app": "tea"}, Ports: ports},
+		},
+		coffee: {
+			Spec: apiv1.ServiceSpec{Selector: map[string]string{"app": "coffee"}, Ports: ports},
+		},
+		external: {
+			Spec: apiv1.ServiceSpec{Type: apiv1.ServiceTypeExternalName, ExternalName: "example.com"},
+		},
+	}
+
+	addBackendServicesToGateways(gws, referencedServices, services)
+
+	coffeeBackend := BackendService{NsName: coffee, Selector: map[string]string{"app": "coffee"}, Ports: ports}
+	teaBackend := BackendService{NsName: tea, Selector: map[string]string{"app": "tea"}, Ports: ports}
+
+	g.Expect(gws[gwNsName].BackendServices).To(Equal([]BackendService{coffeeBackend, teaBackend}))
+	g.Expect(gws[mergedGwNsName].BackendServices).To(Equal([]BackendService{coffeeBackend, teaBackend}))
+	// the Gateway that owns the data plane of a merged Gateway also gets the Services of the merged Gateway
+	g.Expect(gws[otherGwNsName].BackendServices).To(Equal([]BackendService{coffeeBackend, teaBackend}))
+}
@@ -2,6 +2,7 @@ package mirror
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -38,3 +39,31 @@ func BackendPath(idx int, namespace *string, service string) *string {
 
 	return &mirrorPath
 }
+
+// Percent returns the percentage of requests that the RequestMirror filter mirrors.
+// If neither Percent nor Fraction is set, all requests are mirrored.
+// NGINX supports percentages with up to two decimal places, so a Fraction is rounded to the nearest hundredth
+// of a percent, and rounded reports whether the result differs from the Fraction. A nonzero Fraction is never
+// rounded down to zero.
+func Percent(filter *v1.HTTPRequestMirrorFilter) (percent float64, rounded bool) {
+	switch {
+	case filter.Percent != nil:
+		return float64(*filter.Percent), false
+	case filter.Fraction != nil:
+		denominator := int32(100)
+		if filter.Fraction.Denominator != nil {
+			denominator = *filter.Fraction.Denominator
+		}
+
+		exact := float64(filter.Fraction.Numerator) * 100 / float64(denominator)
+
+		percent = math.Round(exact*100) / 100
+		if percent == 0 && exact > 0 {
+			percent = 0.01
+		}
+
+		return percent, percent != exact
+	default:
+		return 100, false
+	}
+}
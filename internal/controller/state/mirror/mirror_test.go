@@ -92,3 +92,78 @@ func TestBackendPath(t *testing.T) {
 		})
 	}
 }
+
+func TestPercent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter     *v1.HTTPRequestMirrorFilter
+		name       string
+		expPercent float64
+		expRounded bool
+	}{
+		{
+			name:       "neither percent nor fraction",
+			filter:     &v1.HTTPRequestMirrorFilter{},
+			expPercent: 100,
+		},
+		{
+			name:       "percent",
+			filter:     &v1.HTTPRequestMirrorFilter{Percent: helpers.GetPointer[int32](25)},
+			expPercent: 25,
+		},
+		{
+			name:       "zero percent",
+			filter:     &v1.HTTPRequestMirrorFilter{Percent: helpers.GetPointer[int32](0)},
+			expPercent: 0,
+		},
+		{
+			name: "fraction with default denominator",
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{Numerator: 5},
+			},
+			expPercent: 5,
+		},
+		{
+			name: "fraction that is exact",
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{Numerator: 1, Denominator: helpers.GetPointer[int32](8)},
+			},
+			expPercent: 12.5,
+		},
+		{
+			name: "fraction that is rounded",
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{Numerator: 2, Denominator: helpers.GetPointer[int32](3)},
+			},
+			expPercent: 66.67,
+			expRounded: true,
+		},
+		{
+			name: "small fraction is not rounded to zero",
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{Numerator: 1, Denominator: helpers.GetPointer[int32](1000000)},
+			},
+			expPercent: 0.01,
+			expRounded: true,
+		},
+		{
+			name: "zero fraction",
+			filter: &v1.HTTPRequestMirrorFilter{
+				Fraction: &v1.Fraction{Numerator: 0, Denominator: helpers.GetPointer[int32](3)},
+			},
+			expPercent: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			percent, rounded := Percent(tt.filter)
+			g.Expect(percent).To(Equal(tt.expPercent))
+			g.Expect(rounded).To(Equal(tt.expRounded))
+		})
+	}
+}
@@ -8,6 +8,17 @@ import (
 )
 
 type FakeGenericValidator struct {
+	ValidateContentTypeStub        func(string) error
+	validateContentTypeMutex       sync.RWMutex
+	validateContentTypeArgsForCall []struct {
+		arg1 string
+	}
+	validateContentTypeReturns struct {
+		result1 error
+	}
+	validateContentTypeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateEndpointStub        func(string) error
 	validateEndpointMutex       sync.RWMutex
 	validateEndpointArgsForCall []struct {
@@ -67,6 +78,67 @@ type FakeGenericValidator struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeGenericValidator) ValidateContentType(arg1 string) error {
+	fake.validateContentTypeMutex.Lock()
+	ret, specificReturn := fake.validateContentTypeReturnsOnCall[len(fake.validateContentTypeArgsForCall)]
+	fake.validateContentTypeArgsForCall = append(fake.validateContentTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateContentTypeStub
+	fakeReturns := fake.validateContentTypeReturns
+	fake.recordInvocation("ValidateContentType", []interface{}{arg1})
+	fake.validateContentTypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateContentTypeCallCount() int {
+	fake.validateContentTypeMutex.RLock()
+	defer fake.validateContentTypeMutex.RUnlock()
+	return len(fake.validateContentTypeArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateContentTypeCalls(stub func(string) error) {
+	fake.validateContentTypeMutex.Lock()
+	defer fake.validateContentTypeMutex.Unlock()
+	fake.ValidateContentTypeStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateContentTypeArgsForCall(i int) string {
+	fake.validateContentTypeMutex.RLock()
+	defer fake.validateContentTypeMutex.RUnlock()
+	argsForCall := fake.validateContentTypeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateContentTypeReturns(result1 error) {
+	fake.validateContentTypeMutex.Lock()
+	defer fake.validateContentTypeMutex.Unlock()
+	fake.ValidateContentTypeStub = nil
+	fake.validateContentTypeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateContentTypeReturnsOnCall(i int, result1 error) {
+	fake.validateContentTypeMutex.Lock()
+	defer fake.validateContentTypeMutex.Unlock()
+	fake.ValidateContentTypeStub = nil
+	if fake.validateContentTypeReturnsOnCall == nil {
+		fake.validateContentTypeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateContentTypeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateEndpoint(arg1 string) error {
 	fake.validateEndpointMutex.Lock()
 	ret, specificReturn := fake.validateEndpointReturnsOnCall[len(fake.validateEndpointArgsForCall)]
@@ -375,6 +447,8 @@ func (fake *FakeGenericValidator) ValidateServiceNameReturnsOnCall(i int, result
 func (fake *FakeGenericValidator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.validateContentTypeMutex.RLock()
+	defer fake.validateContentTypeMutex.RUnlock()
 	fake.validateEndpointMutex.RLock()
 	defer fake.validateEndpointMutex.RUnlock()
 	fake.validateEscapedStringNoVarExpansionMutex.RLock()
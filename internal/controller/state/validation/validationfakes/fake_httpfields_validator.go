@@ -108,6 +108,17 @@ type FakeHTTPFieldsValidator struct {
 	validatePathInMatchReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidatePathRegexInMatchStub        func(string) error
+	validatePathRegexInMatchMutex       sync.RWMutex
+	validatePathRegexInMatchArgsForCall []struct {
+		arg1 string
+	}
+	validatePathRegexInMatchReturns struct {
+		result1 error
+	}
+	validatePathRegexInMatchReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateQueryParamNameInMatchStub        func(string) error
 	validateQueryParamNameInMatchMutex       sync.RWMutex
 	validateQueryParamNameInMatchArgsForCall []struct {
@@ -715,6 +726,67 @@ func (fake *FakeHTTPFieldsValidator) ValidatePathInMatchReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatch(arg1 string) error {
+	fake.validatePathRegexInMatchMutex.Lock()
+	ret, specificReturn := fake.validatePathRegexInMatchReturnsOnCall[len(fake.validatePathRegexInMatchArgsForCall)]
+	fake.validatePathRegexInMatchArgsForCall = append(fake.validatePathRegexInMatchArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidatePathRegexInMatchStub
+	fakeReturns := fake.validatePathRegexInMatchReturns
+	fake.recordInvocation("ValidatePathRegexInMatch", []interface{}{arg1})
+	fake.validatePathRegexInMatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchCallCount() int {
+	fake.validatePathRegexInMatchMutex.RLock()
+	defer fake.validatePathRegexInMatchMutex.RUnlock()
+	return len(fake.validatePathRegexInMatchArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchCalls(stub func(string) error) {
+	fake.validatePathRegexInMatchMutex.Lock()
+	defer fake.validatePathRegexInMatchMutex.Unlock()
+	fake.ValidatePathRegexInMatchStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchArgsForCall(i int) string {
+	fake.validatePathRegexInMatchMutex.RLock()
+	defer fake.validatePathRegexInMatchMutex.RUnlock()
+	argsForCall := fake.validatePathRegexInMatchArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchReturns(result1 error) {
+	fake.validatePathRegexInMatchMutex.Lock()
+	defer fake.validatePathRegexInMatchMutex.Unlock()
+	fake.ValidatePathRegexInMatchStub = nil
+	fake.validatePathRegexInMatchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidatePathRegexInMatchReturnsOnCall(i int, result1 error) {
+	fake.validatePathRegexInMatchMutex.Lock()
+	defer fake.validatePathRegexInMatchMutex.Unlock()
+	fake.ValidatePathRegexInMatchStub = nil
+	if fake.validatePathRegexInMatchReturnsOnCall == nil {
+		fake.validatePathRegexInMatchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validatePathRegexInMatchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHTTPFieldsValidator) ValidateQueryParamNameInMatch(arg1 string) error {
 	fake.validateQueryParamNameInMatchMutex.Lock()
 	ret, specificReturn := fake.validateQueryParamNameInMatchReturnsOnCall[len(fake.validateQueryParamNameInMatchArgsForCall)]
@@ -1047,6 +1119,8 @@ func (fake *FakeHTTPFieldsValidator) Invocations() map[string][][]interface{} {
 	defer fake.validatePathMutex.RUnlock()
 	fake.validatePathInMatchMutex.RLock()
 	defer fake.validatePathInMatchMutex.RUnlock()
+	fake.validatePathRegexInMatchMutex.RLock()
+	defer fake.validatePathRegexInMatchMutex.RUnlock()
 	fake.validateQueryParamNameInMatchMutex.RLock()
 	defer fake.validateQueryParamNameInMatchMutex.RUnlock()
 	fake.validateQueryParamValueInMatchMutex.RLock()
@@ -24,6 +24,7 @@ type Validators struct {
 type HTTPFieldsValidator interface {
 	SkipValidation() bool
 	ValidatePathInMatch(path string) error
+	ValidatePathRegexInMatch(path string) error
 	ValidateHeaderNameInMatch(name string) error
 	ValidateHeaderValueInMatch(value string) error
 	ValidateQueryParamNameInMatch(name string) error
@@ -48,6 +49,7 @@ type GenericValidator interface {
 	ValidateNginxDuration(duration string) error
 	ValidateNginxSize(size string) error
 	ValidateEndpoint(endpoint string) error
+	ValidateContentType(contentType string) error
 }
 
 // PolicyValidator validates an NGF Policy.
@@ -68,6 +70,7 @@ type SkipValidator struct{}
 func (SkipValidator) SkipValidation() bool { return true }
 
 func (SkipValidator) ValidatePathInMatch(string) error                { return nil }
+func (SkipValidator) ValidatePathRegexInMatch(string) error           { return nil }
 func (SkipValidator) ValidateHeaderNameInMatch(string) error          { return nil }
 func (SkipValidator) ValidateHeaderValueInMatch(string) error         { return nil }
 func (SkipValidator) ValidateQueryParamNameInMatch(string) error      { return nil }
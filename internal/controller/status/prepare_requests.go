@@ -331,6 +331,10 @@ func prepareGatewayRequest(
 		)
 	}
 
+	if gateway.LatestDataPlaneUpgrade != nil {
+		gwConds = append(gwConds, *gateway.LatestDataPlaneUpgrade)
+	}
+
 	apiGwConds := conditions.ConvertConditions(
 		conditions.DeduplicateConditions(gwConds),
 		gateway.Source.Generation,
@@ -477,6 +481,120 @@ func PrepareSnippetsFilterRequests(
 	return reqs
 }
 
+// PrepareDirectResponseFilterRequests prepares status UpdateRequests for the given DirectResponseFilters.
+func PrepareDirectResponseFilterRequests(
+	directResponseFilters map[types.NamespacedName]*graph.DirectResponseFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []UpdateRequest {
+	reqs := make([]UpdateRequest, 0, len(directResponseFilters))
+
+	for nsname, filter := range directResponseFilters {
+		allConds := make([]conditions.Condition, 0, len(filter.Conditions)+1)
+
+		// The order of conditions matters here.
+		// We add the default condition first, followed by the filter conditions.
+		// DeduplicateConditions will ensure the last condition wins.
+		allConds = append(allConds, conditions.NewDirectResponseFilterAccepted())
+		allConds = append(allConds, filter.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, filter.Source.GetGeneration(), transitionTime)
+		status := ngfAPI.DirectResponseFilterStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+				},
+			},
+		}
+
+		reqs = append(reqs, UpdateRequest{
+			NsName:       nsname,
+			ResourceType: filter.Source,
+			Setter:       newDirectResponseFilterStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareGRPCRewriteFilterRequests prepares status UpdateRequests for the given GRPCRewriteFilters.
+func PrepareGRPCRewriteFilterRequests(
+	grpcRewriteFilters map[types.NamespacedName]*graph.GRPCRewriteFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []UpdateRequest {
+	reqs := make([]UpdateRequest, 0, len(grpcRewriteFilters))
+
+	for nsname, filter := range grpcRewriteFilters {
+		allConds := make([]conditions.Condition, 0, len(filter.Conditions)+1)
+
+		// The order of conditions matters here.
+		// We add the default condition first, followed by the filter conditions.
+		// DeduplicateConditions will ensure the last condition wins.
+		allConds = append(allConds, conditions.NewGRPCRewriteFilterAccepted())
+		allConds = append(allConds, filter.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, filter.Source.GetGeneration(), transitionTime)
+		status := ngfAPI.GRPCRewriteFilterStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+				},
+			},
+		}
+
+		reqs = append(reqs, UpdateRequest{
+			NsName:       nsname,
+			ResourceType: filter.Source,
+			Setter:       newGRPCRewriteFilterStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareGRPCWebFilterRequests prepares status UpdateRequests for the given GRPCWebFilters.
+func PrepareGRPCWebFilterRequests(
+	grpcWebFilters map[types.NamespacedName]*graph.GRPCWebFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []UpdateRequest {
+	reqs := make([]UpdateRequest, 0, len(grpcWebFilters))
+
+	for nsname, filter := range grpcWebFilters {
+		allConds := make([]conditions.Condition, 0, len(filter.Conditions)+1)
+
+		// The order of conditions matters here.
+		// We add the default condition first, followed by the filter conditions.
+		// DeduplicateConditions will ensure the last condition wins.
+		allConds = append(allConds, conditions.NewGRPCWebFilterAccepted())
+		allConds = append(allConds, filter.Conditions...)
+
+		conds := conditions.DeduplicateConditions(allConds)
+		apiConds := conditions.ConvertConditions(conds, filter.Source.GetGeneration(), transitionTime)
+		status := ngfAPI.GRPCWebFilterStatus{
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions:     apiConds,
+					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+				},
+			},
+		}
+
+		reqs = append(reqs, UpdateRequest{
+			NsName:       nsname,
+			ResourceType: filter.Source,
+			Setter:       newGRPCWebFilterStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
 // ControlPlaneUpdateResult describes the result of a control plane update.
 type ControlPlaneUpdateResult struct {
 	// Error is the error that occurred during the update.
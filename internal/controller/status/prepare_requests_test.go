@@ -961,6 +961,61 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid gateway; data plane upgrade in progress",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Valid: true,
+				LatestDataPlaneUpgrade: helpers.GetPointer(
+					conditions.NewGatewayDataPlaneUpgradeProgressing("waiting for staged Deployment"),
+				),
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+						{
+							Type:               string(conditions.GatewayDataPlaneUpgraded),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(conditions.GatewayReasonDataPlaneUpgradeProgressing),
+							Message:            "waiting for staged Deployment",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "valid gateway; some valid listeners",
 			gateway: &graph.Gateway{
@@ -2158,3 +2213,297 @@ func TestBuildSnippetsFilterStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDirectResponseFilterStatuses(t *testing.T) {
+	t.Parallel()
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	validFilter := &graph.DirectResponseFilter{
+		Source: &ngfAPI.DirectResponseFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "valid-filter",
+				Namespace:  "test",
+				Generation: 1,
+			},
+			Spec: ngfAPI.DirectResponseFilterSpec{
+				StatusCode: 503,
+			},
+		},
+		Valid: true,
+	}
+
+	invalidFilter := &graph.DirectResponseFilter{
+		Source: &ngfAPI.DirectResponseFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "invalid-filter",
+				Namespace:  "test",
+				Generation: 1,
+			},
+			Spec: ngfAPI.DirectResponseFilterSpec{
+				StatusCode: 503,
+			},
+		},
+		Conditions: []conditions.Condition{conditions.NewDirectResponseFilterInvalid("invalid filter")},
+		Valid:      false,
+	}
+
+	k8sClient := createK8sClientFor(&ngfAPI.DirectResponseFilter{})
+
+	filters := map[types.NamespacedName]*graph.DirectResponseFilter{
+		{Namespace: "test", Name: "valid-filter"}:   validFilter,
+		{Namespace: "test", Name: "invalid-filter"}: invalidFilter,
+	}
+
+	g := NewWithT(t)
+
+	for _, filter := range filters {
+		err := k8sClient.Create(context.Background(), filter.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := NewUpdater(k8sClient, logr.Discard())
+
+	reqs := PrepareDirectResponseFilterRequests(filters, transitionTime, gatewayCtlrName)
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(context.Background(), reqs...)
+
+	expected := map[types.NamespacedName]ngfAPI.DirectResponseFilterStatus{
+		{Namespace: "test", Name: "valid-filter"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.DirectResponseFilterConditionTypeAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.DirectResponseFilterConditionReasonAccepted),
+							Message:            "DirectResponseFilter is accepted",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+		{Namespace: "test", Name: "invalid-filter"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.DirectResponseFilterConditionTypeAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.DirectResponseFilterConditionReasonInvalid),
+							Message:            "invalid filter",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+	}
+
+	for nsname, exp := range expected {
+		var filter ngfAPI.DirectResponseFilter
+
+		err := k8sClient.Get(context.Background(), nsname, &filter)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, filter.Status)).To(BeEmpty())
+	}
+}
+
+func TestBuildGRPCRewriteFilterStatuses(t *testing.T) {
+	t.Parallel()
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	validFilter := &graph.GRPCRewriteFilter{
+		Source: &ngfAPI.GRPCRewriteFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "valid-filter",
+				Namespace:  "test",
+				Generation: 1,
+			},
+			Spec: ngfAPI.GRPCRewriteFilterSpec{
+				Method: helpers.GetPointer("GetInvoice"),
+			},
+		},
+		Valid: true,
+	}
+
+	invalidFilter := &graph.GRPCRewriteFilter{
+		Source: &ngfAPI.GRPCRewriteFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "invalid-filter",
+				Namespace:  "test",
+				Generation: 1,
+			},
+			Spec: ngfAPI.GRPCRewriteFilterSpec{
+				Method: helpers.GetPointer("GetInvoice"),
+			},
+		},
+		Conditions: []conditions.Condition{conditions.NewGRPCRewriteFilterInvalid("invalid filter")},
+		Valid:      false,
+	}
+
+	k8sClient := createK8sClientFor(&ngfAPI.GRPCRewriteFilter{})
+
+	filters := map[types.NamespacedName]*graph.GRPCRewriteFilter{
+		{Namespace: "test", Name: "valid-filter"}:   validFilter,
+		{Namespace: "test", Name: "invalid-filter"}: invalidFilter,
+	}
+
+	g := NewWithT(t)
+
+	for _, filter := range filters {
+		err := k8sClient.Create(context.Background(), filter.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := NewUpdater(k8sClient, logr.Discard())
+
+	reqs := PrepareGRPCRewriteFilterRequests(filters, transitionTime, gatewayCtlrName)
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(context.Background(), reqs...)
+
+	expected := map[types.NamespacedName]ngfAPI.GRPCRewriteFilterStatus{
+		{Namespace: "test", Name: "valid-filter"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.GRPCRewriteFilterConditionTypeAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.GRPCRewriteFilterConditionReasonAccepted),
+							Message:            "GRPCRewriteFilter is accepted",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+		{Namespace: "test", Name: "invalid-filter"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.GRPCRewriteFilterConditionTypeAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.GRPCRewriteFilterConditionReasonInvalid),
+							Message:            "invalid filter",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+	}
+
+	for nsname, exp := range expected {
+		var filter ngfAPI.GRPCRewriteFilter
+
+		err := k8sClient.Get(context.Background(), nsname, &filter)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, filter.Status)).To(BeEmpty())
+	}
+}
+
+func TestBuildGRPCWebFilterStatuses(t *testing.T) {
+	t.Parallel()
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	validFilter := &graph.GRPCWebFilter{
+		Source: &ngfAPI.GRPCWebFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "valid-filter",
+				Namespace:  "test",
+				Generation: 1,
+			},
+		},
+		Valid: true,
+	}
+
+	invalidFilter := &graph.GRPCWebFilter{
+		Source: &ngfAPI.GRPCWebFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "invalid-filter",
+				Namespace:  "test",
+				Generation: 1,
+			},
+		},
+		Conditions: []conditions.Condition{conditions.NewGRPCWebFilterInvalid("invalid filter")},
+		Valid:      false,
+	}
+
+	k8sClient := createK8sClientFor(&ngfAPI.GRPCWebFilter{})
+
+	filters := map[types.NamespacedName]*graph.GRPCWebFilter{
+		{Namespace: "test", Name: "valid-filter"}:   validFilter,
+		{Namespace: "test", Name: "invalid-filter"}: invalidFilter,
+	}
+
+	g := NewWithT(t)
+
+	for _, filter := range filters {
+		err := k8sClient.Create(context.Background(), filter.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := NewUpdater(k8sClient, logr.Discard())
+
+	reqs := PrepareGRPCWebFilterRequests(filters, transitionTime, gatewayCtlrName)
+	g.Expect(reqs).To(HaveLen(2))
+
+	updater.Update(context.Background(), reqs...)
+
+	expected := map[types.NamespacedName]ngfAPI.GRPCWebFilterStatus{
+		{Namespace: "test", Name: "valid-filter"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.GRPCWebFilterConditionTypeAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.GRPCWebFilterConditionReasonAccepted),
+							Message:            "GRPCWebFilter is accepted",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+		{Namespace: "test", Name: "invalid-filter"}: {
+			Controllers: []ngfAPI.ControllerStatus{
+				{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(ngfAPI.GRPCWebFilterConditionTypeAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 1,
+							LastTransitionTime: transitionTime,
+							Reason:             string(ngfAPI.GRPCWebFilterConditionReasonInvalid),
+							Message:            "invalid filter",
+						},
+					},
+					ControllerName: gatewayCtlrName,
+				},
+			},
+		},
+	}
+
+	for nsname, exp := range expected {
+		var filter ngfAPI.GRPCWebFilter
+
+		err := k8sClient.Get(context.Background(), nsname, &filter)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, filter.Status)).To(BeEmpty())
+	}
+}
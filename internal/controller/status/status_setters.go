@@ -354,7 +354,7 @@ func newSnippetsFilterStatusSetter(
 		controllerStatuses = append(controllerStatuses, snippetsFilterStatus.Controllers...)
 		snippetsFilterStatus.Controllers = controllerStatuses
 
-		if snippetsFilterStatusEqual(gatewayCtlrName, snippetsFilterStatus.Controllers, sf.Status.Controllers) {
+		if controllerStatusesEqual(gatewayCtlrName, snippetsFilterStatus.Controllers, sf.Status.Controllers) {
 			return false
 		}
 
@@ -363,8 +363,98 @@ func newSnippetsFilterStatusSetter(
 	}
 }
 
-func snippetsFilterStatusEqual(gatewayCtlrName string, currStatus, prevStatus []ngfAPI.ControllerStatus) bool {
-	// Since other controllers may update snippetsFilter status we can't assume anything about the order of the statuses,
+func newDirectResponseFilterStatusSetter(
+	directResponseFilterStatus ngfAPI.DirectResponseFilterStatus,
+	gatewayCtlrName string,
+) Setter {
+	return func(obj client.Object) (wasSet bool) {
+		drf := helpers.MustCastObject[*ngfAPI.DirectResponseFilter](obj)
+
+		// maxControllerStatus is the max number of controller statuses which is the sum of all new controller statuses
+		// and all old controller statuses.
+		maxControllerStatus := 1 + len(drf.Status.Controllers)
+		controllerStatuses := make([]ngfAPI.ControllerStatus, 0, maxControllerStatus)
+
+		for _, status := range drf.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, directResponseFilterStatus.Controllers...)
+		directResponseFilterStatus.Controllers = controllerStatuses
+
+		if controllerStatusesEqual(gatewayCtlrName, directResponseFilterStatus.Controllers, drf.Status.Controllers) {
+			return false
+		}
+
+		drf.Status = directResponseFilterStatus
+		return true
+	}
+}
+
+func newGRPCRewriteFilterStatusSetter(
+	grpcRewriteFilterStatus ngfAPI.GRPCRewriteFilterStatus,
+	gatewayCtlrName string,
+) Setter {
+	return func(obj client.Object) (wasSet bool) {
+		grf := helpers.MustCastObject[*ngfAPI.GRPCRewriteFilter](obj)
+
+		// maxControllerStatus is the max number of controller statuses which is the sum of all new controller statuses
+		// and all old controller statuses.
+		maxControllerStatus := 1 + len(grf.Status.Controllers)
+		controllerStatuses := make([]ngfAPI.ControllerStatus, 0, maxControllerStatus)
+
+		for _, status := range grf.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, grpcRewriteFilterStatus.Controllers...)
+		grpcRewriteFilterStatus.Controllers = controllerStatuses
+
+		if controllerStatusesEqual(gatewayCtlrName, grpcRewriteFilterStatus.Controllers, grf.Status.Controllers) {
+			return false
+		}
+
+		grf.Status = grpcRewriteFilterStatus
+		return true
+	}
+}
+
+func newGRPCWebFilterStatusSetter(
+	grpcWebFilterStatus ngfAPI.GRPCWebFilterStatus,
+	gatewayCtlrName string,
+) Setter {
+	return func(obj client.Object) (wasSet bool) {
+		gwf := helpers.MustCastObject[*ngfAPI.GRPCWebFilter](obj)
+
+		// maxControllerStatus is the max number of controller statuses which is the sum of all new controller statuses
+		// and all old controller statuses.
+		maxControllerStatus := 1 + len(gwf.Status.Controllers)
+		controllerStatuses := make([]ngfAPI.ControllerStatus, 0, maxControllerStatus)
+
+		for _, status := range gwf.Status.Controllers {
+			if string(status.ControllerName) != gatewayCtlrName {
+				controllerStatuses = append(controllerStatuses, status)
+			}
+		}
+
+		controllerStatuses = append(controllerStatuses, grpcWebFilterStatus.Controllers...)
+		grpcWebFilterStatus.Controllers = controllerStatuses
+
+		if controllerStatusesEqual(gatewayCtlrName, grpcWebFilterStatus.Controllers, gwf.Status.Controllers) {
+			return false
+		}
+
+		gwf.Status = grpcWebFilterStatus
+		return true
+	}
+}
+
+func controllerStatusesEqual(gatewayCtlrName string, currStatus, prevStatus []ngfAPI.ControllerStatus) bool {
+	// Since other controllers may update the filter status we can't assume anything about the order of the statuses,
 	// and we have to ignore statuses written by other controllers when checking for equality.
 	// Therefore, we can't use slices.EqualFunc here because it cares about the order.
 
@@ -375,7 +465,7 @@ func snippetsFilterStatusEqual(gatewayCtlrName string, currStatus, prevStatus []
 		}
 
 		exists := slices.ContainsFunc(currStatus, func(currStatus ngfAPI.ControllerStatus) bool {
-			return snippetsStatusEqual(currStatus, prev)
+			return controllerStatusEqual(currStatus, prev)
 		})
 
 		if !exists {
@@ -386,7 +476,7 @@ func snippetsFilterStatusEqual(gatewayCtlrName string, currStatus, prevStatus []
 	// Then, we check if the currStatus has any ControllerStatuses that are no longer present in the prevStatus.
 	for _, curr := range currStatus {
 		exists := slices.ContainsFunc(prevStatus, func(prevStatus ngfAPI.ControllerStatus) bool {
-			return snippetsStatusEqual(curr, prevStatus)
+			return controllerStatusEqual(curr, prevStatus)
 		})
 
 		if !exists {
@@ -397,7 +487,7 @@ func snippetsFilterStatusEqual(gatewayCtlrName string, currStatus, prevStatus []
 	return true
 }
 
-func snippetsStatusEqual(status1, status2 ngfAPI.ControllerStatus) bool {
+func controllerStatusEqual(status1, status2 ngfAPI.ControllerStatus) bool {
 	if status1.ControllerName != status2.ControllerName {
 		return false
 	}
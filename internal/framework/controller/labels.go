@@ -8,5 +8,16 @@ const (
 	AppManagedByLabel = "app.kubernetes.io/managed-by"
 )
 
+// The following labels are added to each nginx resource when cost allocation is configured in the NginxProxy.
+const (
+	TeamLabel       = "gateway.nginx.org/team"
+	CostCenterLabel = "gateway.nginx.org/cost-center"
+)
+
+// DataPlaneSlotLabel is added to the NGINX Pods when blue/green image upgrades are enabled in the NginxProxy.
+// It distinguishes the Pods of the NGINX Deployment from the Pods of the Deployment that stages an upgrade,
+// so that the NGINX Services can select either of them.
+const DataPlaneSlotLabel = "gateway.nginx.org/data-plane-slot"
+
 // RestartedAnnotation is added to a Deployment or DaemonSet's PodSpec to trigger a rolling restart.
 const RestartedAnnotation = "kubectl.kubernetes.io/restartedAt"
@@ -27,20 +27,38 @@ const (
 const (
 	// Service is the Service kind.
 	Service = "Service"
+	// Secret is the Secret kind.
+	Secret = "Secret"
+	// ConfigMap is the ConfigMap kind.
+	ConfigMap = "ConfigMap"
 )
 
 // NGINX Gateway Fabric kinds.
 const (
 	// ClientSettingsPolicy is the ClientSettingsPolicy kind.
 	ClientSettingsPolicy = "ClientSettingsPolicy"
+	// DirectResponseFilter is the DirectResponseFilter kind.
+	DirectResponseFilter = "DirectResponseFilter"
+	// ErrorPagePolicy is the ErrorPagePolicy kind.
+	ErrorPagePolicy = "ErrorPagePolicy"
+	// GRPCRewriteFilter is the GRPCRewriteFilter kind.
+	GRPCRewriteFilter = "GRPCRewriteFilter"
+	// GRPCWebFilter is the GRPCWebFilter kind.
+	GRPCWebFilter = "GRPCWebFilter"
 	// ObservabilityPolicy is the ObservabilityPolicy kind.
 	ObservabilityPolicy = "ObservabilityPolicy"
 	// NginxProxy is the NginxProxy kind.
 	NginxProxy = "NginxProxy"
 	// SnippetsFilter is the SnippetsFilter kind.
 	SnippetsFilter = "SnippetsFilter"
+	// ProxySettingsPolicy is the ProxySettingsPolicy kind.
+	ProxySettingsPolicy = "ProxySettingsPolicy"
+	// TrafficSplitPolicy is the TrafficSplitPolicy kind.
+	TrafficSplitPolicy = "TrafficSplitPolicy"
 	// UpstreamSettingsPolicy is the UpstreamSettingsPolicy kind.
 	UpstreamSettingsPolicy = "UpstreamSettingsPolicy"
+	// WebSocketPolicy is the WebSocketPolicy kind.
+	WebSocketPolicy = "WebSocketPolicy"
 )
 
 // MustExtractGVK is a function that extracts the GroupVersionKind (GVK) of a client.object.